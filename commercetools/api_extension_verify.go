@@ -0,0 +1,236 @@
+package commercetools
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/labd/commercetools-go-sdk/platform"
+)
+
+// defaultExtensionTimeoutInMs is the timeout commercetools uses when the
+// extension has no timeout_in_ms set.
+const defaultExtensionTimeoutInMs = 2000
+
+// syntheticResourceID is the ID of the resource which is sent in the
+// verification requests, so the endpoint is able to recognize them.
+const syntheticResourceID = "00000000-0000-0000-0000-000000000000"
+
+func extensionVerifySchema() *schema.Schema {
+	return &schema.Schema{
+		Description: "Verify that the destination implements the API extension protocol before the " +
+			"extension is created or updated. A synthetic extension request is sent for every " +
+			"resource type and action of the triggers, using the authentication of the destination. " +
+			"The apply fails when the destination doesn't respond with a valid extension response " +
+			"within `timeout_in_ms`. Only HTTP and GoogleCloudFunction destinations can be verified",
+		Type:     schema.TypeList,
+		MaxItems: 1,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"url": {
+					Description: "URL to send the verification requests to instead of the destination " +
+						"url, for example a local stub of the extension",
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+				"sample_resource": {
+					Description: "JSON object which is sent as the resource in the verification " +
+						"requests. Defaults to a minimal resource with the ID " + syntheticResourceID,
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.StringIsJSON,
+				},
+			},
+		},
+	}
+}
+
+// extensionVerifyInput contains everything needed to verify an extension
+// destination.
+type extensionVerifyInput struct {
+	URL            string
+	Headers        map[string]string
+	Triggers       []platform.ExtensionTrigger
+	Timeout        time.Duration
+	SampleResource map[string]any
+}
+
+// expandExtensionVerifyInput returns nil if no verification is configured.
+func expandExtensionVerifyInput(d *schema.ResourceData, destination platform.Destination) (*extensionVerifyInput, error) {
+	items := d.Get("verify").([]any)
+	if len(items) == 0 {
+		return nil, nil
+	}
+
+	// An empty verify block results in a nil element
+	raw, _ := items[0].(map[string]any)
+
+	input := &extensionVerifyInput{
+		Headers:  map[string]string{},
+		Triggers: expandExtensionTriggers(d),
+		Timeout:  defaultExtensionTimeoutInMs * time.Millisecond,
+	}
+
+	if timeout := d.Get("timeout_in_ms").(int); timeout > 0 {
+		input.Timeout = time.Duration(timeout) * time.Millisecond
+	}
+
+	switch dst := destination.(type) {
+	case platform.HttpDestination:
+		input.URL = dst.Url
+		switch auth := dst.Authentication.(type) {
+		case platform.AuthorizationHeaderAuthentication:
+			input.Headers["Authorization"] = auth.HeaderValue
+		case platform.AzureFunctionsAuthentication:
+			input.Headers["x-functions-key"] = auth.Key
+		}
+	case platform.GoogleCloudFunctionDestination:
+		input.URL = dst.Url
+	default:
+		return nil, fmt.Errorf("verify is only supported for HTTP and GoogleCloudFunction destinations")
+	}
+
+	if val, ok := raw["url"].(string); ok && val != "" {
+		input.URL = val
+	}
+
+	if val, ok := raw["sample_resource"].(string); ok && val != "" {
+		if err := json.Unmarshal([]byte(val), &input.SampleResource); err != nil {
+			return nil, fmt.Errorf("invalid sample_resource: %w", err)
+		}
+	}
+
+	return input, nil
+}
+
+// verifyExtension sends a synthetic extension request for every resource type
+// and action of the triggers and checks that the destination responds with a
+// valid extension response. All failures are combined in the returned error.
+func verifyExtension(ctx context.Context, client *http.Client, input *extensionVerifyInput) error {
+	var failures []string
+	for _, trigger := range input.Triggers {
+		for _, action := range trigger.Actions {
+			if err := verifyExtensionRequest(ctx, client, input, trigger.ResourceTypeId, action); err != nil {
+				failures = append(failures, fmt.Sprintf("%s %s: %s", trigger.ResourceTypeId, action, err))
+			}
+		}
+	}
+
+	if len(failures) > 0 {
+		return fmt.Errorf(
+			"the extension destination %s did not pass verification:\n%s",
+			input.URL, strings.Join(failures, "\n"))
+	}
+	return nil
+}
+
+func verifyExtensionRequest(
+	ctx context.Context,
+	client *http.Client,
+	input *extensionVerifyInput,
+	resourceTypeID platform.ExtensionResourceTypeId,
+	action platform.ExtensionAction,
+) error {
+	obj := input.SampleResource
+	if obj == nil {
+		obj = map[string]any{
+			"id":             syntheticResourceID,
+			"version":        1,
+			"createdAt":      "1970-01-01T00:00:00.000Z",
+			"lastModifiedAt": "1970-01-01T00:00:00.000Z",
+		}
+	}
+
+	payload, err := json.Marshal(map[string]any{
+		"action": action,
+		"resource": map[string]any{
+			"typeId": resourceTypeID,
+			"id":     syntheticResourceID,
+			"obj":    obj,
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, input.Timeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, input.URL, bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	for key, value := range input.Headers {
+		req.Header.Set(key, value)
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		if ctx.Err() == context.DeadlineExceeded {
+			return fmt.Errorf("no response within %s", input.Timeout)
+		}
+		return err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	return validateExtensionResponse(resp.StatusCode, body)
+}
+
+// validateExtensionResponse checks that the response follows the API
+// extension protocol. A successful response (200 or 201) has an optional body
+// with a list of update actions, a failed response (400) has a list of errors.
+func validateExtensionResponse(statusCode int, body []byte) error {
+	var data struct {
+		Actions *[]map[string]any `json:"actions"`
+		Errors  *[]map[string]any `json:"errors"`
+	}
+
+	switch statusCode {
+	case http.StatusOK, http.StatusCreated:
+		if len(bytes.TrimSpace(body)) == 0 {
+			return nil
+		}
+		if err := json.Unmarshal(body, &data); err != nil {
+			return fmt.Errorf("invalid response body: %w", err)
+		}
+		if data.Actions == nil {
+			return fmt.Errorf("response body has no actions")
+		}
+		for _, action := range *data.Actions {
+			if _, ok := action["action"].(string); !ok {
+				return fmt.Errorf("response contains an update action without an action name")
+			}
+		}
+		return nil
+
+	case http.StatusBadRequest:
+		if err := json.Unmarshal(body, &data); err != nil {
+			return fmt.Errorf("invalid error response body: %w", err)
+		}
+		if data.Errors == nil || len(*data.Errors) == 0 {
+			return fmt.Errorf("error response has no errors")
+		}
+		for _, e := range *data.Errors {
+			if _, ok := e["code"].(string); !ok {
+				return fmt.Errorf("error response contains an error without a code")
+			}
+		}
+		return nil
+
+	default:
+		return fmt.Errorf("unexpected status code %d", statusCode)
+	}
+}
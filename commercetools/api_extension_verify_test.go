@@ -0,0 +1,182 @@
+package commercetools
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/labd/commercetools-go-sdk/platform"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateExtensionResponse(t *testing.T) {
+	testCases := []struct {
+		name       string
+		statusCode int
+		body       string
+		valid      bool
+	}{
+		{"empty success", http.StatusOK, "", true},
+		{"actions", http.StatusOK, `{"actions": [{"action": "setCustomField", "name": "x"}]}`, true},
+		{"no actions", http.StatusCreated, `{}`, false},
+		{"action without name", http.StatusOK, `{"actions": [{"name": "x"}]}`, false},
+		{"invalid json", http.StatusOK, `<html></html>`, false},
+		{"errors", http.StatusBadRequest, `{"errors": [{"code": "InvalidInput", "message": "x"}]}`, true},
+		{"no errors", http.StatusBadRequest, `{"errors": []}`, false},
+		{"error without code", http.StatusBadRequest, `{"errors": [{"message": "x"}]}`, false},
+		{"server error", http.StatusInternalServerError, "", false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := validateExtensionResponse(tc.statusCode, []byte(tc.body))
+			if tc.valid {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestVerifyExtension(t *testing.T) {
+	type request struct {
+		Action   string `json:"action"`
+		Resource struct {
+			TypeID string         `json:"typeId"`
+			ID     string         `json:"id"`
+			Obj    map[string]any `json:"obj"`
+		} `json:"resource"`
+	}
+
+	var requests []request
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body request
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		requests = append(requests, body)
+
+		if r.Header.Get("Authorization") != "Bearer secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		if body.Action == "Update" {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"errors": [{"code": "InvalidOperation", "message": "not allowed"}]}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"actions": []}`))
+	}))
+	defer server.Close()
+
+	input := &extensionVerifyInput{
+		URL:     server.URL,
+		Headers: map[string]string{"Authorization": "Bearer secret"},
+		Triggers: []platform.ExtensionTrigger{
+			{
+				ResourceTypeId: platform.ExtensionResourceTypeIdCart,
+				Actions:        []platform.ExtensionAction{platform.ExtensionActionCreate, platform.ExtensionActionUpdate},
+			},
+			{
+				ResourceTypeId: platform.ExtensionResourceTypeIdOrder,
+				Actions:        []platform.ExtensionAction{platform.ExtensionActionCreate},
+			},
+		},
+		Timeout:        time.Second,
+		SampleResource: map[string]any{"lineItems": []any{}},
+	}
+
+	err := verifyExtension(context.Background(), server.Client(), input)
+	assert.NoError(t, err)
+	require.Len(t, requests, 3)
+	assert.Equal(t, "Create", requests[0].Action)
+	assert.Equal(t, "cart", requests[0].Resource.TypeID)
+	assert.Equal(t, syntheticResourceID, requests[0].Resource.ID)
+	assert.Equal(t, map[string]any{"lineItems": []any{}}, requests[0].Resource.Obj)
+	assert.Equal(t, "Update", requests[1].Action)
+	assert.Equal(t, "order", requests[2].Resource.TypeID)
+
+	input.Headers = map[string]string{}
+	err = verifyExtension(context.Background(), server.Client(), input)
+	assert.ErrorContains(t, err, "cart Create: unexpected status code 401")
+	assert.ErrorContains(t, err, "order Create: unexpected status code 401")
+}
+
+func TestVerifyExtensionTimeout(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+		case <-time.After(300 * time.Millisecond):
+		}
+	}))
+	defer server.Close()
+
+	input := &extensionVerifyInput{
+		URL: server.URL,
+		Triggers: []platform.ExtensionTrigger{
+			{
+				ResourceTypeId: platform.ExtensionResourceTypeIdPayment,
+				Actions:        []platform.ExtensionAction{platform.ExtensionActionCreate},
+			},
+		},
+		Timeout: 50 * time.Millisecond,
+	}
+
+	err := verifyExtension(context.Background(), server.Client(), input)
+	assert.ErrorContains(t, err, "payment Create: no response within 50ms")
+}
+
+func TestExpandExtensionVerifyInput(t *testing.T) {
+	resourceDataMap := map[string]any{
+		"destination": []any{
+			map[string]any{
+				"type":                 "HTTP",
+				"url":                  "https://example.com/extension",
+				"azure_authentication": "AzureKey",
+			},
+		},
+		"trigger": []any{
+			map[string]any{
+				"resource_type_id": "cart",
+				"actions":          []any{"Create"},
+			},
+		},
+		"timeout_in_ms": 500,
+		"verify": []any{
+			map[string]any{
+				"url":             "http://localhost:8080",
+				"sample_resource": `{"totalPrice": {"centAmount": 100}}`,
+			},
+		},
+	}
+
+	d := schema.TestResourceDataRaw(t, resourceAPIExtension().Schema, resourceDataMap)
+	destination, err := expandExtensionDestination(d)
+	require.NoError(t, err)
+
+	input, err := expandExtensionVerifyInput(d, destination)
+	require.NoError(t, err)
+	assert.Equal(t, "http://localhost:8080", input.URL)
+	assert.Equal(t, map[string]string{"x-functions-key": "AzureKey"}, input.Headers)
+	assert.Equal(t, 500*time.Millisecond, input.Timeout)
+	assert.Len(t, input.Triggers, 1)
+	assert.Equal(t, map[string]any{"totalPrice": map[string]any{"centAmount": float64(100)}}, input.SampleResource)
+
+	delete(resourceDataMap, "verify")
+	d = schema.TestResourceDataRaw(t, resourceAPIExtension().Schema, resourceDataMap)
+	input, err = expandExtensionVerifyInput(d, destination)
+	assert.NoError(t, err)
+	assert.Nil(t, input)
+
+	_, err = expandExtensionVerifyInput(d, platform.AWSLambdaDestination{Arn: "arn"})
+	assert.NoError(t, err)
+
+	resourceDataMap["verify"] = []any{map[string]any{}}
+	d = schema.TestResourceDataRaw(t, resourceAPIExtension().Schema, resourceDataMap)
+	_, err = expandExtensionVerifyInput(d, platform.AWSLambdaDestination{Arn: "arn"})
+	assert.ErrorContains(t, err, "only supported for HTTP and GoogleCloudFunction")
+}
@@ -0,0 +1,167 @@
+package commercetools
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/labd/commercetools-go-sdk/platform"
+)
+
+// channelRole is a field which refers to channels that need a role. The field
+// can be nested, like value.0.supply_channel_id, and contains either a single
+// channel or a list of channels.
+type channelRole struct {
+	field string
+	role  platform.ChannelRoleEnum
+	byKey bool
+}
+
+// plannedChannelRoles holds the IDs and keys of the channels of which the
+// roles are changed in the current plan. Terraform plans a channel before the
+// resources which refer to it, so these resources can skip the channels of
+// which the roles are only known after apply.
+var plannedChannelRoles = struct {
+	sync.Mutex
+	refs map[string]bool
+}{refs: map[string]bool{}}
+
+// markChannelRolesPlanned is the CustomizeDiff of the channel resource which
+// records the channels of which the roles are changed.
+func markChannelRolesPlanned(_ context.Context, d *schema.ResourceDiff, _ any) error {
+	if d.Id() == "" || !d.HasChange("roles") {
+		return nil
+	}
+
+	plannedChannelRoles.Lock()
+	defer plannedChannelRoles.Unlock()
+	plannedChannelRoles.refs[d.Id()] = true
+	if key := d.Get("key").(string); key != "" {
+		plannedChannelRoles.refs[key] = true
+	}
+	return nil
+}
+
+func channelRolesPlanned(ref string) bool {
+	plannedChannelRoles.Lock()
+	defer plannedChannelRoles.Unlock()
+	return plannedChannelRoles.refs[ref]
+}
+
+// validateChannelRoles validates the roles of the channels referred to by the
+// fields. Validation is deferred to the API for values which are only known
+// after apply, for channels which don't exist yet, for example because they
+// are created in the same apply, and for channels of which the roles are
+// changed in the same apply. The latter are only detected when the field
+// refers to the channel resource, otherwise the channel might be planned
+// later.
+func validateChannelRoles(fields ...channelRole) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, d *schema.ResourceDiff, m any) error {
+		var errs []error
+		for _, f := range fields {
+			if (d.Id() != "" && !d.HasChange(f.field)) || !d.NewValueKnown(f.field) {
+				continue
+			}
+			refs := slices.DeleteFunc(channelRefs(d.Get(f.field)), channelRolesPlanned)
+			if len(refs) == 0 {
+				continue
+			}
+
+			channels, err := getChannels(ctx, getClient(m), f.byKey, refs)
+			if err != nil {
+				return err
+			}
+			errs = append(errs, channelRoleErrors(f, channels)...)
+		}
+		return errors.Join(errs...)
+	}
+}
+
+// channelRefs returns the non-empty channel keys or IDs of the value.
+func channelRefs(value any) []string {
+	var result []string
+	switch v := value.(type) {
+	case string:
+		if v != "" {
+			result = append(result, v)
+		}
+	case []any:
+		for _, ref := range expandStringArray(v) {
+			if ref != "" {
+				result = append(result, ref)
+			}
+		}
+	}
+	return result
+}
+
+// channelRoleErrors returns an error for each of the channels which doesn't
+// have the role.
+func channelRoleErrors(f channelRole, channels []platform.Channel) []error {
+	var errs []error
+	for _, channel := range channels {
+		if channelHasRole(channel, f.role) {
+			continue
+		}
+		roles := make([]string, len(channel.Roles))
+		for i, r := range channel.Roles {
+			roles[i] = string(r)
+		}
+		ref := channel.ID
+		if f.byKey {
+			ref = channel.Key
+		}
+		errs = append(errs, fmt.Errorf("%s: channel %q does not have the %s role, it has the roles [%s]",
+			f.field, ref, f.role, strings.Join(roles, ", ")))
+	}
+	return errs
+}
+
+func channelHasRole(channel platform.Channel, role platform.ChannelRoleEnum) bool {
+	for _, r := range channel.Roles {
+		if r == role {
+			return true
+		}
+	}
+	return false
+}
+
+// getChannels returns the channels with the keys or IDs, in the order of the
+// references. References for which no channel exists are skipped.
+func getChannels(ctx context.Context, client *platform.ByProjectKeyRequestBuilder, byKey bool, refs []string) ([]platform.Channel, error) {
+	field := "id"
+	if byKey {
+		field = "key"
+	}
+	quoted := make([]string, len(refs))
+	for i, ref := range refs {
+		quoted[i] = fmt.Sprintf("%q", ref)
+	}
+	result, err := client.Channels().Get().
+		Where([]string{fmt.Sprintf("%s in (%s)", field, strings.Join(quoted, ", "))}).
+		Limit(len(refs)).
+		Execute(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	byRef := make(map[string]platform.Channel, len(result.Results))
+	for _, channel := range result.Results {
+		if byKey {
+			byRef[channel.Key] = channel
+		} else {
+			byRef[channel.ID] = channel
+		}
+	}
+	channels := make([]platform.Channel, 0, len(refs))
+	for _, ref := range refs {
+		if channel, ok := byRef[ref]; ok {
+			channels = append(channels, channel)
+		}
+	}
+	return channels, nil
+}
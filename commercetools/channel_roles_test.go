@@ -0,0 +1,76 @@
+package commercetools
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/labd/commercetools-go-sdk/platform"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChannelRoleErrors(t *testing.T) {
+	channels := []platform.Channel{
+		{ID: "1", Key: "warehouse", Roles: []platform.ChannelRoleEnum{platform.ChannelRoleEnumInventorySupply}},
+		{ID: "2", Key: "web", Roles: []platform.ChannelRoleEnum{
+			platform.ChannelRoleEnumProductDistribution,
+			platform.ChannelRoleEnumInventorySupply,
+		}},
+	}
+
+	errs := channelRoleErrors(channelRole{
+		field: "distribution_channels",
+		role:  platform.ChannelRoleEnumProductDistribution,
+		byKey: true,
+	}, channels)
+	assert.Len(t, errs, 1)
+	assert.EqualError(t, errs[0],
+		`distribution_channels: channel "warehouse" does not have the ProductDistribution role, it has the roles [InventorySupply]`)
+
+	errs = channelRoleErrors(channelRole{
+		field: "value.0.distribution_channel_id",
+		role:  platform.ChannelRoleEnumProductDistribution,
+	}, channels)
+	assert.Len(t, errs, 1)
+	assert.EqualError(t, errs[0],
+		`value.0.distribution_channel_id: channel "1" does not have the ProductDistribution role, it has the roles [InventorySupply]`)
+
+	assert.Empty(t, channelRoleErrors(channelRole{
+		field: "supply_channels",
+		role:  platform.ChannelRoleEnumInventorySupply,
+		byKey: true,
+	}, channels))
+}
+
+func TestChannelRefs(t *testing.T) {
+	assert.Equal(t, []string{"web"}, channelRefs("web"))
+	assert.Empty(t, channelRefs(""))
+	assert.Equal(t, []string{"web", "warehouse"}, channelRefs([]any{"web", "", "warehouse"}))
+	assert.Empty(t, channelRefs(nil))
+}
+
+func TestMarkChannelRolesPlanned(t *testing.T) {
+	state := &terraform.InstanceState{
+		ID: "channel-id",
+		Attributes: map[string]string{
+			"id":      "channel-id",
+			"key":     "warehouse",
+			"roles.#": "1",
+			"roles.0": "InventorySupply",
+		},
+	}
+	config := func(roles ...any) *terraform.ResourceConfig {
+		return terraform.NewResourceConfigRaw(map[string]any{"key": "warehouse", "roles": roles})
+	}
+	t.Cleanup(func() { clear(plannedChannelRoles.refs) })
+
+	_, err := resourceChannel().Diff(context.Background(), state, config("InventorySupply"), nil)
+	require.NoError(t, err)
+	assert.False(t, channelRolesPlanned("warehouse"))
+
+	_, err = resourceChannel().Diff(context.Background(), state, config("InventorySupply", "ProductDistribution"), nil)
+	require.NoError(t, err)
+	assert.True(t, channelRolesPlanned("channel-id"))
+	assert.True(t, channelRolesPlanned("warehouse"))
+}
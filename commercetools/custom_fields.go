@@ -58,7 +58,9 @@ type SetCustomTypeAction interface {
 		platform.ShippingMethodSetCustomTypeAction |
 		platform.CustomerGroupSetCustomTypeAction |
 		platform.DiscountCodeSetCustomTypeAction |
-		platform.CartDiscountSetCustomTypeAction
+		platform.CartDiscountSetCustomTypeAction |
+		platform.CustomerSetCustomTypeAction |
+		platform.InventoryEntrySetCustomTypeAction
 }
 
 type SetCustomFieldAction interface {
@@ -68,7 +70,9 @@ type SetCustomFieldAction interface {
 		platform.ShippingMethodSetCustomFieldAction |
 		platform.CustomerGroupSetCustomFieldAction |
 		platform.DiscountCodeSetCustomFieldAction |
-		platform.CartDiscountSetCustomFieldAction
+		platform.CartDiscountSetCustomFieldAction |
+		platform.CustomerSetCustomFieldAction |
+		platform.InventoryEntrySetCustomFieldAction
 }
 
 func customFieldEncodeType(t *platform.Type, name string, value any) (any, error) {
@@ -0,0 +1,75 @@
+package commercetools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/labd/commercetools-go-sdk/platform"
+)
+
+// The sort order of a discount is unique among the discounts of the same type.
+// When no sort order is configured, for example because it is managed by a
+// commercetools_discount_priority resource, the discount gets a sort order
+// below all existing discounts.
+
+// lowestCartDiscountSortOrder returns the lowest sort order of the cart
+// discounts, or an empty string when there are none.
+func lowestCartDiscountSortOrder(ctx context.Context, client *platform.ByProjectKeyRequestBuilder) (string, error) {
+	result, err := client.CartDiscounts().Get().Sort([]string{"sortOrder asc"}).Limit(1).Execute(ctx)
+	if err != nil || len(result.Results) == 0 {
+		return "", err
+	}
+	return result.Results[0].SortOrder, nil
+}
+
+// lowestProductDiscountSortOrder returns the lowest sort order of the product
+// discounts, or an empty string when there are none.
+func lowestProductDiscountSortOrder(ctx context.Context, client *platform.ByProjectKeyRequestBuilder) (string, error) {
+	result, err := client.ProductDiscounts().Get().Sort([]string{"sortOrder asc"}).Limit(1).Execute(ctx)
+	if err != nil || len(result.Results) == 0 {
+		return "", err
+	}
+	return result.Results[0].SortOrder, nil
+}
+
+// sortOrderBelow returns half of the lowest sort order, or 0.5 when there is
+// no lowest sort order.
+func sortOrderBelow(lowest string) (string, error) {
+	if lowest == "" {
+		return "0.5", nil
+	}
+	r, ok := new(big.Rat).SetString(lowest)
+	if !ok || r.Sign() <= 0 {
+		return "", fmt.Errorf("invalid sort order %q", lowest)
+	}
+
+	// Halving adds at most one digit
+	digits := 1
+	if _, fraction, ok := strings.Cut(lowest, "."); ok {
+		digits += len(fraction)
+	}
+	result := new(big.Rat).Quo(r, big.NewRat(2, 1)).FloatString(digits)
+	return strings.TrimRight(result, "0"), nil
+}
+
+// isDuplicateSortOrderError returns true when the sort order is in use by
+// another discount.
+func isDuplicateSortOrderError(err error) bool {
+	switch e := err.(type) {
+	case platform.ErrorResponse:
+		for _, item := range e.Errors {
+			if duplicate, ok := item.(platform.DuplicateFieldError); ok && duplicate.Field == "sortOrder" {
+				return true
+			}
+		}
+	case platform.GenericRequestError:
+		var response platform.ErrorResponse
+		if json.Unmarshal(e.Content, &response) == nil {
+			return isDuplicateSortOrderError(response)
+		}
+	}
+	return false
+}
@@ -0,0 +1,47 @@
+package commercetools
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/labd/commercetools-go-sdk/platform"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSortOrderBelow(t *testing.T) {
+	testCases := []struct {
+		lowest   string
+		expected string
+	}{
+		{lowest: "", expected: "0.5"},
+		{lowest: "0.5", expected: "0.25"},
+		{lowest: "0.3", expected: "0.15"},
+		{lowest: "0.0001", expected: "0.00005"},
+		{lowest: "0.125", expected: "0.0625"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.lowest, func(t *testing.T) {
+			result, err := sortOrderBelow(tc.lowest)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, result)
+		})
+	}
+
+	_, err := sortOrderBelow("abc")
+	assert.Error(t, err)
+}
+
+func TestIsDuplicateSortOrderError(t *testing.T) {
+	assert.True(t, isDuplicateSortOrderError(platform.GenericRequestError{
+		StatusCode: http.StatusBadRequest,
+		Content: []byte(`{"statusCode": 400, "message": "duplicate", "errors": [` +
+			`{"code": "DuplicateField", "message": "duplicate", "field": "sortOrder", "duplicateValue": "0.5"}]}`),
+	}))
+	assert.False(t, isDuplicateSortOrderError(platform.GenericRequestError{
+		StatusCode: http.StatusBadRequest,
+		Content: []byte(`{"statusCode": 400, "message": "duplicate", "errors": [` +
+			`{"code": "DuplicateField", "message": "duplicate", "field": "key", "duplicateValue": "summer"}]}`),
+	}))
+	assert.False(t, isDuplicateSortOrderError(nil))
+}
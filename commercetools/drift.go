@@ -0,0 +1,62 @@
+package commercetools
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/labd/commercetools-go-sdk/history"
+
+	"github.com/labd/terraform-provider-commercetools/internal/utils"
+)
+
+// historyResourceTypes are the resource types of the History API of the
+// resources. Resources which are not recorded by the History API, like API
+// extensions and shipping methods, are not included.
+var historyResourceTypes = map[string]history.ChangeHistoryResourceType{
+	"commercetools_cart_discount":    history.ChangeHistoryResourceTypeCartDiscount,
+	"commercetools_category":         history.ChangeHistoryResourceTypeCategory,
+	"commercetools_channel":          history.ChangeHistoryResourceTypeChannel,
+	"commercetools_customer":         history.ChangeHistoryResourceTypeCustomer,
+	"commercetools_customer_group":   history.ChangeHistoryResourceTypeCustomerGroup,
+	"commercetools_discount_code":    history.ChangeHistoryResourceTypeDiscountCode,
+	"commercetools_inventory_entry":  history.ChangeHistoryResourceTypeInventoryEntry,
+	"commercetools_product_discount": history.ChangeHistoryResourceTypeProductDiscount,
+	"commercetools_product_type":     history.ChangeHistoryResourceTypeProductType,
+	"commercetools_shipping_zone":    history.ChangeHistoryResourceTypeZone,
+	"commercetools_store":            history.ChangeHistoryResourceTypeStore,
+	"commercetools_tax_category":     history.ChangeHistoryResourceTypeTaxCategory,
+	"commercetools_type":             history.ChangeHistoryResourceTypeType,
+}
+
+// withDriftWarnings reports who last modified the resource when a refresh
+// finds a newer version than the one in the state. It is a no-op unless
+// history_drift_warnings is enabled in the provider.
+func withDriftWarnings(name string, r *schema.Resource) {
+	resourceType, ok := historyResourceTypes[name]
+	if !ok || r.ReadContext == nil {
+		return
+	}
+
+	read := r.ReadContext
+	r.ReadContext = func(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+		stateVersion, _ := d.Get("version").(int)
+		diags := read(ctx, d, m)
+
+		data, ok := m.(*utils.ProviderData)
+		if !ok || diags.HasError() || d.Id() == "" {
+			return diags
+		}
+		remoteVersion, _ := d.Get("version").(int)
+		summary, detail := utils.LastModifiedBy(ctx, data.DriftReporter(), resourceType, d.Id(),
+			int64(stateVersion), int64(remoteVersion))
+		if summary != "" {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  summary,
+				Detail:   detail,
+			})
+		}
+		return diags
+	}
+}
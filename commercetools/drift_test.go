@@ -0,0 +1,77 @@
+package commercetools
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/labd/commercetools-go-sdk/history"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/labd/terraform-provider-commercetools/internal/utils"
+)
+
+func TestHistoryResourceTypes(t *testing.T) {
+	resources := New("test")().ResourcesMap
+	for name := range historyResourceTypes {
+		r, ok := resources[name]
+		require.True(t, ok, name)
+		assert.Contains(t, r.Schema, "version", name)
+	}
+}
+
+func TestWithDriftWarnings(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/my-project/channel/channel-id", r.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{
+			"limit": 1, "count": 1, "total": 1, "offset": 0,
+			"results": [{
+				"version": 3,
+				"previousVersion": 2,
+				"type": "ResourceUpdated",
+				"modifiedAt": "2024-01-01T12:00:00.000Z",
+				"modifiedBy": {"id": "user-1", "type": "user", "isPlatformClient": true},
+				"changes": [],
+				"resource": {"id": "channel-id", "typeId": "channel"}
+			}]
+		}`))
+	}))
+	defer server.Close()
+
+	client, err := history.NewClient(&history.ClientConfig{URL: server.URL})
+	require.NoError(t, err)
+	data := &utils.ProviderData{HistoryClient: client.WithProjectKeyValue("my-project")}
+
+	r := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"version": {Type: schema.TypeInt, Computed: true},
+		},
+		ReadContext: func(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+			_ = d.Set("version", 3)
+			return nil
+		},
+	}
+	withDriftWarnings("commercetools_channel", r)
+
+	read := func() diag.Diagnostics {
+		d := r.TestResourceData()
+		d.SetId("channel-id")
+		_ = d.Set("version", 2)
+		return r.ReadContext(context.Background(), d, data)
+	}
+
+	// Disabled
+	assert.Empty(t, read())
+
+	data.HistoryDriftWarnings = true
+	diags := read()
+	require.Len(t, diags, 1)
+	assert.Equal(t, diag.Warning, diags[0].Severity)
+	assert.Equal(t, "The channel was modified outside of Terraform", diags[0].Summary)
+	assert.Contains(t, diags[0].Detail, "Merchant Center user user-1")
+}
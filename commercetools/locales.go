@@ -0,0 +1,117 @@
+package commercetools
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/labd/terraform-provider-commercetools/internal/utils"
+)
+
+// withLocaleValidation adds validation of the locales of all localized string
+// fields of the resource against the project languages. It is a no-op unless
+// validate_locales is enabled in the provider.
+func withLocaleValidation(r *schema.Resource) {
+	if !hasLocalizedStrings(r.Schema) {
+		return
+	}
+
+	validate := func(ctx context.Context, d *schema.ResourceDiff, m any) error {
+		data, ok := m.(*utils.ProviderData)
+		if !ok || data.Locales == nil {
+			return nil
+		}
+		languages, err := data.Locales.Languages(ctx)
+		if err != nil {
+			return err
+		}
+
+		var errs []error
+		for _, key := range sortedSchemaKeys(r.Schema) {
+			walkLocalizedStrings(r.Schema[key], key, d.Get(key), func(path string, value map[string]any) {
+				locales := make([]string, 0, len(value))
+				for locale := range value {
+					locales = append(locales, locale)
+				}
+				sort.Strings(locales)
+				for _, locale := range locales {
+					if problem := utils.CheckLocale(locale, languages); problem != "" {
+						errs = append(errs, fmt.Errorf("%s: %s", path, problem))
+					}
+				}
+			})
+		}
+		return errors.Join(errs...)
+	}
+
+	if r.CustomizeDiff == nil {
+		r.CustomizeDiff = validate
+	} else {
+		r.CustomizeDiff = customdiff.All(r.CustomizeDiff, validate)
+	}
+}
+
+// isLocalizedString reports whether the field is a localized string. These are
+// the TypeLocalizedString fields which validate their keys as language tags.
+func isLocalizedString(s *schema.Schema) bool {
+	return s.Type == TypeLocalizedString && s.ValidateDiagFunc != nil &&
+		reflect.ValueOf(s.ValidateDiagFunc).Pointer() == reflect.ValueOf(validateLocalizedStringKey).Pointer()
+}
+
+func hasLocalizedStrings(fields map[string]*schema.Schema) bool {
+	for _, s := range fields {
+		if isLocalizedString(s) {
+			return true
+		}
+		if elem, ok := s.Elem.(*schema.Resource); ok && hasLocalizedStrings(elem.Schema) {
+			return true
+		}
+	}
+	return false
+}
+
+// walkLocalizedStrings calls fn for every localized string in the value of the
+// field, including those in nested blocks.
+func walkLocalizedStrings(s *schema.Schema, path string, value any, fn func(path string, value map[string]any)) {
+	if isLocalizedString(s) {
+		if m, ok := value.(map[string]any); ok && len(m) > 0 {
+			fn(path, m)
+		}
+		return
+	}
+
+	elem, ok := s.Elem.(*schema.Resource)
+	if !ok {
+		return
+	}
+	var items []any
+	switch v := value.(type) {
+	case []any:
+		items = v
+	case *schema.Set:
+		items = v.List()
+	}
+	for i, item := range items {
+		obj, ok := item.(map[string]any)
+		if !ok {
+			continue
+		}
+		for _, key := range sortedSchemaKeys(elem.Schema) {
+			walkLocalizedStrings(elem.Schema[key], fmt.Sprintf("%s.%d.%s", path, i, key), obj[key], fn)
+		}
+	}
+}
+
+func sortedSchemaKeys(fields map[string]*schema.Schema) []string {
+	result := make([]string, 0, len(fields))
+	for key := range fields {
+		result = append(result, key)
+	}
+	sort.Strings(result)
+	return result
+}
@@ -0,0 +1,41 @@
+package commercetools
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHasLocalizedStrings(t *testing.T) {
+	assert.True(t, hasLocalizedStrings(resourceCategory().Schema))
+	assert.True(t, hasLocalizedStrings(resourceProductType().Schema))
+	assert.False(t, hasLocalizedStrings(resourceInventoryEntry().Schema))
+}
+
+func TestWalkLocalizedStrings(t *testing.T) {
+	s := resourceProductType().Schema
+	value := []any{
+		map[string]any{
+			"name":  "color",
+			"label": map[string]any{"en": "Color", "nl_NL": "Kleur"},
+		},
+		map[string]any{
+			"name":  "size",
+			"label": map[string]any{},
+		},
+	}
+
+	found := map[string]map[string]any{}
+	walkLocalizedStrings(s["attribute"], "attribute", value, func(path string, value map[string]any) {
+		found[path] = value
+	})
+	assert.Equal(t, map[string]map[string]any{
+		"attribute.0.label": {"en": "Color", "nl_NL": "Kleur"},
+	}, found)
+
+	found = map[string]map[string]any{}
+	walkLocalizedStrings(s["name"], "name", "Product", func(path string, value map[string]any) {
+		found[path] = value
+	})
+	assert.Empty(t, found)
+}
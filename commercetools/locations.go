@@ -0,0 +1,88 @@
+package commercetools
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+
+	"github.com/labd/terraform-provider-commercetools/internal/iso3166"
+)
+
+// validateCountryCode checks if a country string is a valid ISO 3166-1 alpha-2
+// code, suggesting the code for common mistakes like lowercase codes, alpha-3
+// codes and country names.
+func validateCountryCode(val any, key string) (warns []string, errs []error) {
+	if problem := iso3166.CheckCountry(val.(string)); problem != "" {
+		errs = append(errs, fmt.Errorf("%q %s", key, problem))
+	}
+	return
+}
+
+// locationStateWarning returns a warning when the state is not one of the ISO
+// 3166-2 subdivisions of the country. The state may be the name of the
+// subdivision or its code. commercetools accepts any state, so a state which
+// is not known, for example a local or English name, doesn't prevent the
+// change. States of countries for which no subdivisions are known are not
+// validated.
+func locationStateWarning(field, country, state string) diag.Diagnostics {
+	if country == "" || state == "" {
+		return nil
+	}
+	if problem := iso3166.CheckState(country, state); problem != "" {
+		return diag.Diagnostics{{
+			Severity: diag.Warning,
+			Summary:  "Unknown state",
+			Detail: fmt.Sprintf("%q %s. The state is used as is, make sure it matches the state "+
+				"of the addresses.", field, problem),
+		}}
+	}
+	return nil
+}
+
+// shippingZoneLocationWarnings returns the warnings for the states of the
+// locations which are added to the shipping zone. Existing locations are not
+// validated again.
+func shippingZoneLocationWarnings(prior, config cty.Value) diag.Diagnostics {
+	existing := map[[2]string]bool{}
+	for _, location := range shippingZoneLocations(prior) {
+		existing[location] = true
+	}
+
+	var diags diag.Diagnostics
+	for _, location := range shippingZoneLocations(config) {
+		if !existing[location] {
+			diags = append(diags, locationStateWarning("location.state", location[0], location[1])...)
+		}
+	}
+	return diags
+}
+
+// shippingZoneLocations returns the country and state of the locations of the
+// shipping zone. Unknown values are returned as empty strings.
+func shippingZoneLocations(val cty.Value) [][2]string {
+	if val.IsNull() || !val.IsKnown() {
+		return nil
+	}
+	locations := val.GetAttr("location")
+	if locations.IsNull() || !locations.IsKnown() {
+		return nil
+	}
+
+	var result [][2]string
+	for it := locations.ElementIterator(); it.Next(); {
+		_, location := it.Element()
+		result = append(result, [2]string{ctyString(location, "country"), ctyString(location, "state")})
+	}
+	return result
+}
+
+// taxCategoryRateLocationWarnings returns the warnings for the state of the tax
+// rate when its location is changed.
+func taxCategoryRateLocationWarnings(prior, config cty.Value) diag.Diagnostics {
+	country, state := ctyString(config, "country"), ctyString(config, "state")
+	if country == ctyString(prior, "country") && state == ctyString(prior, "state") {
+		return nil
+	}
+	return locationStateWarning("state", country, state)
+}
@@ -0,0 +1,67 @@
+package commercetools
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateCountryCode(t *testing.T) {
+	_, errs := validateCountryCode("DE", "country")
+	assert.Empty(t, errs)
+
+	_, errs = validateCountryCode("UK", "country")
+	assert.EqualError(t, errs[0], `"country" "UK" is not an ISO 3166-1 alpha-2 country code, did you mean "GB"?`)
+}
+
+func TestLocationStateWarning(t *testing.T) {
+	assert.Empty(t, locationStateWarning("state", "US", "New York"))
+	assert.Empty(t, locationStateWarning("state", "US", "NY"))
+	assert.Empty(t, locationStateWarning("state", "US", ""))
+	assert.Empty(t, locationStateWarning("state", "", "New York"))
+
+	diags := locationStateWarning("state", "US", "New Yrok")
+	require.Len(t, diags, 1)
+	assert.Equal(t, diag.Warning, diags[0].Severity)
+	assert.Contains(t, diags[0].Detail, `"state" "New Yrok" is not an ISO 3166-2 subdivision of US, did you mean "New York"?`)
+
+	// English names of subdivisions are accepted by commercetools, so these
+	// only give a warning
+	for country, state := range map[string]string{"DE": "Bavaria", "CH": "Zurich"} {
+		diags := locationStateWarning("state", country, state)
+		require.Len(t, diags, 1, state)
+		assert.Equal(t, diag.Warning, diags[0].Severity, state)
+	}
+}
+
+func TestShippingZoneLocationWarnings(t *testing.T) {
+	location := func(country, state string) cty.Value {
+		return cty.ObjectVal(map[string]cty.Value{"country": cty.StringVal(country), "state": cty.StringVal(state)})
+	}
+	zone := func(locations ...cty.Value) cty.Value {
+		return cty.ObjectVal(map[string]cty.Value{"location": cty.SetVal(locations)})
+	}
+
+	prior := zone(location("DE", "Bavaria"))
+	config := zone(location("DE", "Bavaria"), location("US", "New Yrok"))
+	diags := shippingZoneLocationWarnings(prior, config)
+	require.Len(t, diags, 1)
+	assert.Contains(t, diags[0].Detail, `"New Yrok"`)
+
+	assert.Empty(t, shippingZoneLocationWarnings(config, config))
+	assert.Len(t, shippingZoneLocationWarnings(cty.NullVal(config.Type()), config), 2)
+}
+
+func TestTaxCategoryRateLocationWarnings(t *testing.T) {
+	rate := func(country, state string) cty.Value {
+		return cty.ObjectVal(map[string]cty.Value{"country": cty.StringVal(country), "state": cty.StringVal(state)})
+	}
+
+	assert.Empty(t, taxCategoryRateLocationWarnings(rate("DE", "Bavaria"), rate("DE", "Bavaria")))
+	assert.Len(t, taxCategoryRateLocationWarnings(cty.NullVal(rate("", "").Type()), rate("DE", "Bavaria")), 1)
+	assert.Len(t, taxCategoryRateLocationWarnings(rate("DE", "Bayern"), rate("DE", "Bavaria")), 1)
+	assert.Empty(t, taxCategoryRateLocationWarnings(rate("DE", "Bavaria"), cty.UnknownVal(rate("", "").Type())))
+}
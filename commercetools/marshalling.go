@@ -1,9 +1,14 @@
 package commercetools
 
 import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
 	"time"
 
-	"fmt"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/labd/commercetools-go-sdk/platform"
 )
 
@@ -18,59 +23,205 @@ func expandTime(input string) (time.Time, error) {
 	return time.Parse(time.RFC3339, input)
 }
 
-func flattenTypedMoney(val platform.TypedMoney) map[string]any {
+// typedMoneyElem returns the schema of a money value. The money is in cent
+// precision, unless precise_amount is set in which case it is in high
+// precision.
+func typedMoneyElem() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"currency_code": {
+				Description:  "The currency code compliant to [ISO 4217](https://en.wikipedia.org/wiki/ISO_4217)",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: ValidateCurrencyCode,
+			},
+			"cent_amount": {
+				Description: "The amount in cents (the smallest indivisible unit of the currency). Required " +
+					"unless precise_amount is set, for high precision money this is the precise amount rounded to cents",
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+			"precise_amount": {
+				Description: "The amount in 1 / (10 ^ fraction_digits) of the currency, for example 123456 with " +
+					"fraction_digits 4 is 12.3456. When set the money is stored in high precision and " +
+					"fraction_digits is required",
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"fraction_digits": {
+				Description: "The number of fraction digits of the precise amount, which must be greater than " +
+					"the default fraction digits of the currency. For money in cent precision this is the number " +
+					"of default fraction digits for the given currency, like 2 for EUR or 0 for JPY",
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// validateTypedMoney checks that each money block of the configuration has
+// exactly one of cent_amount and precise_amount. The cent_amount is computed
+// for high precision money, so a money block without an amount would
+// otherwise be sent with an amount of 0.
+func validateTypedMoney(_ context.Context, d *schema.ResourceDiff, _ any) error {
+	return typedMoneyConfigError(d.GetRawConfig(), nil)
+}
+
+func typedMoneyConfigError(val cty.Value, path []string) error {
+	if val.IsNull() || !val.IsKnown() {
+		return nil
+	}
+
+	ty := val.Type()
+	switch {
+	case ty.IsObjectType():
+		if ty.HasAttribute("cent_amount") && ty.HasAttribute("precise_amount") {
+			cent, precise := val.GetAttr("cent_amount"), val.GetAttr("precise_amount")
+			if !cent.IsKnown() || !precise.IsKnown() {
+				return nil
+			}
+			if cent.IsNull() == precise.IsNull() {
+				return fmt.Errorf("%s: exactly one of cent_amount or precise_amount must be set",
+					strings.Join(path, "."))
+			}
+			return nil
+		}
+		for name := range ty.AttributeTypes() {
+			if err := typedMoneyConfigError(val.GetAttr(name), append(path, name)); err != nil {
+				return err
+			}
+		}
+	case val.CanIterateElements():
+		i := 0
+		for it := val.ElementIterator(); it.Next(); i++ {
+			_, item := it.Element()
+			if err := typedMoneyConfigError(item, append(path, strconv.Itoa(i))); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func flattenTypedMoney(val platform.TypedMoney) (map[string]any, error) {
 	switch v := val.(type) {
 	case platform.HighPrecisionMoney:
 		return map[string]any{
-			"currency_code": v.CurrencyCode,
-			"cent_amount":   v.CentAmount,
-		}
-	case platform.Money:
-		return map[string]any{
-			"currency_code": v.CurrencyCode,
-			"cent_amount":   v.CentAmount,
-		}
+			"currency_code":   v.CurrencyCode,
+			"cent_amount":     v.CentAmount,
+			"precise_amount":  v.PreciseAmount,
+			"fraction_digits": v.FractionDigits,
+		}, nil
 	case platform.CentPrecisionMoney:
 		return map[string]any{
-			"currency_code": v.CurrencyCode,
-			"cent_amount":   v.CentAmount,
-		}
+			"currency_code":   v.CurrencyCode,
+			"cent_amount":     v.CentAmount,
+			"precise_amount":  0,
+			"fraction_digits": v.FractionDigits,
+		}, nil
+	case platform.Money:
+		return map[string]any{
+			"currency_code":  v.CurrencyCode,
+			"cent_amount":    v.CentAmount,
+			"precise_amount": 0,
+		}, nil
 	}
-	panic(fmt.Sprintf("Unknown money type: %T", val))
+	return nil, fmt.Errorf("unknown money type: %T", val)
 }
 
-func expandTypedMoney(d map[string]any) []platform.Money {
-	input := d["money"].([]any)
-	var result []platform.Money
+func flattenManyTypedMoney(values []platform.TypedMoney) ([]map[string]any, error) {
+	result := make([]map[string]any, len(values))
+	for i, money := range values {
+		item, err := flattenTypedMoney(money)
+		if err != nil {
+			return nil, err
+		}
+		result[i] = item
+	}
+	return result, nil
+}
 
+// expandTypedMoneyDraft returns the money of the value as drafts, which are in
+// high precision when precise_amount is set.
+func expandTypedMoneyDraft(d map[string]any) ([]platform.TypedMoneyDraft, error) {
+	input, _ := d["money"].([]any)
+	result := make([]platform.TypedMoneyDraft, 0, len(input))
 	for _, raw := range input {
-		i := raw.(map[string]any)
-		priceCurrencyCode := i["currency_code"].(string)
+		money, err := expandTypedMoneyDraftItem(raw.(map[string]any))
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, money)
+	}
+	return result, nil
+}
+
+func expandTypedMoneyDraftItem(i map[string]any) (platform.TypedMoneyDraft, error) {
+	currencyCode, _ := i["currency_code"].(string)
 
-		result = append(result, platform.Money{
-			CurrencyCode: priceCurrencyCode,
-			CentAmount:   i["cent_amount"].(int),
-		})
+	if preciseAmount, _ := i["precise_amount"].(int); preciseAmount != 0 {
+		fractionDigits, _ := i["fraction_digits"].(int)
+		if fractionDigits == 0 {
+			return nil, fmt.Errorf(
+				"fraction_digits is required for the precise_amount %d of %s", preciseAmount, currencyCode)
+		}
+		return platform.HighPrecisionMoneyDraft{
+			CurrencyCode:   currencyCode,
+			PreciseAmount:  preciseAmount,
+			FractionDigits: fractionDigits,
+		}, nil
 	}
 
-	return result
+	centAmount, _ := i["cent_amount"].(int)
+	return platform.Money{
+		CurrencyCode: currencyCode,
+		CentAmount:   centAmount,
+	}, nil
 }
 
-func expandTypedMoneyDraft(d map[string]any) []platform.TypedMoneyDraft {
-	input := d["money"].([]any)
-	var result []platform.TypedMoneyDraft
-
-	for _, raw := range input {
-		i := raw.(map[string]any)
-		priceCurrencyCode := i["currency_code"].(string)
+// remoteMoney is a money value as returned by the API. The SDK decodes some
+// money values as CentPrecisionMoney or Money, which drops the precise amount
+// of high precision money.
+type remoteMoney struct {
+	Type           string `json:"type"`
+	CurrencyCode   string `json:"currencyCode"`
+	CentAmount     int    `json:"centAmount"`
+	FractionDigits int    `json:"fractionDigits"`
+	PreciseAmount  int    `json:"preciseAmount"`
+}
 
-		result = append(result, platform.Money{
-			CurrencyCode: priceCurrencyCode,
-			CentAmount:   i["cent_amount"].(int),
-		})
+func (m remoteMoney) typed() platform.TypedMoney {
+	if m.Type == "highPrecision" {
+		return platform.HighPrecisionMoney{
+			CurrencyCode:   m.CurrencyCode,
+			CentAmount:     m.CentAmount,
+			FractionDigits: m.FractionDigits,
+			PreciseAmount:  m.PreciseAmount,
+		}
+	}
+	return platform.CentPrecisionMoney{
+		CurrencyCode:   m.CurrencyCode,
+		CentAmount:     m.CentAmount,
+		FractionDigits: m.FractionDigits,
 	}
+}
 
-	return result
+// draft returns the money as it was created, for example to remove a shipping
+// rate which requires the exact draft.
+func (m remoteMoney) draft() platform.TypedMoneyDraft {
+	if m.Type == "highPrecision" {
+		return platform.HighPrecisionMoneyDraft{
+			CurrencyCode:   m.CurrencyCode,
+			FractionDigits: m.FractionDigits,
+			PreciseAmount:  m.PreciseAmount,
+		}
+	}
+	return platform.Money{
+		CurrencyCode: m.CurrencyCode,
+		CentAmount:   m.CentAmount,
+	}
 }
 
 func expandLocalizedString(val any) platform.LocalizedString {
@@ -85,20 +236,3 @@ func expandLocalizedString(val any) platform.LocalizedString {
 	}
 	return result
 }
-
-func expandMoneyDraft(d map[string]any) []platform.Money {
-	input := d["money"].([]any)
-	var result []platform.Money
-	for _, raw := range input {
-		data := raw.(map[string]any)
-		item := platform.Money{}
-		if currencyCode, ok := data["currency_code"].(string); ok {
-			item.CurrencyCode = currencyCode
-		}
-		if centAmount, ok := data["cent_amount"].(int); ok {
-			item.CentAmount = centAmount
-		}
-		result = append(result, item)
-	}
-	return result
-}
@@ -0,0 +1,219 @@
+package commercetools
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/labd/commercetools-go-sdk/platform"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFlattenTypedMoney(t *testing.T) {
+	testCases := []struct {
+		name     string
+		input    platform.TypedMoney
+		expected map[string]any
+	}{
+		{
+			name: "high precision",
+			input: platform.HighPrecisionMoney{
+				CurrencyCode:   "EUR",
+				CentAmount:     1235,
+				PreciseAmount:  123456,
+				FractionDigits: 4,
+			},
+			expected: map[string]any{
+				"currency_code":   "EUR",
+				"cent_amount":     1235,
+				"precise_amount":  123456,
+				"fraction_digits": 4,
+			},
+		},
+		{
+			name: "cent precision",
+			input: platform.CentPrecisionMoney{
+				CurrencyCode:   "JPY",
+				CentAmount:     500,
+				FractionDigits: 0,
+			},
+			expected: map[string]any{
+				"currency_code":   "JPY",
+				"cent_amount":     500,
+				"precise_amount":  0,
+				"fraction_digits": 0,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			result, err := flattenTypedMoney(tc.input)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, result)
+		})
+	}
+
+	_, err := flattenTypedMoney(nil)
+	assert.Error(t, err)
+}
+
+func TestExpandTypedMoneyDraft(t *testing.T) {
+	result, err := expandTypedMoneyDraft(map[string]any{
+		"money": []any{
+			map[string]any{
+				"currency_code":   "EUR",
+				"cent_amount":     1235,
+				"precise_amount":  123456,
+				"fraction_digits": 4,
+			},
+			map[string]any{
+				"currency_code":   "USD",
+				"cent_amount":     1000,
+				"precise_amount":  0,
+				"fraction_digits": 2,
+			},
+		},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []platform.TypedMoneyDraft{
+		platform.HighPrecisionMoneyDraft{
+			CurrencyCode:   "EUR",
+			PreciseAmount:  123456,
+			FractionDigits: 4,
+		},
+		platform.Money{
+			CurrencyCode: "USD",
+			CentAmount:   1000,
+		},
+	}, result)
+
+	_, err = expandTypedMoneyDraft(map[string]any{
+		"money": []any{
+			map[string]any{
+				"currency_code":  "EUR",
+				"precise_amount": 123456,
+			},
+		},
+	})
+	assert.ErrorContains(t, err, "fraction_digits is required")
+}
+
+func TestRemoteMoney(t *testing.T) {
+	var money []remoteMoney
+	err := json.Unmarshal([]byte(`[
+		{"type": "highPrecision", "currencyCode": "EUR", "centAmount": 1235, "preciseAmount": 123456, "fractionDigits": 4},
+		{"type": "centPrecision", "currencyCode": "USD", "centAmount": 1000, "fractionDigits": 2}
+	]`), &money)
+	require.NoError(t, err)
+	require.Len(t, money, 2)
+
+	assert.Equal(t, platform.HighPrecisionMoney{
+		CurrencyCode:   "EUR",
+		CentAmount:     1235,
+		PreciseAmount:  123456,
+		FractionDigits: 4,
+	}, money[0].typed())
+	assert.Equal(t, platform.HighPrecisionMoneyDraft{
+		CurrencyCode:   "EUR",
+		PreciseAmount:  123456,
+		FractionDigits: 4,
+	}, money[0].draft())
+
+	assert.Equal(t, platform.CentPrecisionMoney{
+		CurrencyCode:   "USD",
+		CentAmount:     1000,
+		FractionDigits: 2,
+	}, money[1].typed())
+	assert.Equal(t, platform.Money{
+		CurrencyCode: "USD",
+		CentAmount:   1000,
+	}, money[1].draft())
+}
+
+func TestRemoteCartDiscountValue(t *testing.T) {
+	var cartDiscount remoteCartDiscount
+	err := json.Unmarshal([]byte(`{
+		"id": "a1b2c3",
+		"version": 2,
+		"value": {
+			"type": "absolute",
+			"money": [
+				{"type": "highPrecision", "currencyCode": "EUR", "centAmount": 1235, "preciseAmount": 123456, "fractionDigits": 4}
+			]
+		}
+	}`), &cartDiscount)
+	require.NoError(t, err)
+	assert.Equal(t, "a1b2c3", cartDiscount.ID)
+
+	value, err := flattenCartDiscountValue(cartDiscount.Value, cartDiscount.Money)
+	require.NoError(t, err)
+	assert.Equal(t, []map[string]any{{
+		"type": "absolute",
+		"money": []map[string]any{{
+			"currency_code":   "EUR",
+			"cent_amount":     1235,
+			"precise_amount":  123456,
+			"fraction_digits": 4,
+		}},
+	}}, value)
+}
+
+func TestShippingRateDraftMarshal(t *testing.T) {
+	var shippingRate remoteShippingRate
+	err := json.Unmarshal([]byte(`{
+		"price": {"type": "highPrecision", "currencyCode": "EUR", "centAmount": 4322, "preciseAmount": 43215, "fractionDigits": 3},
+		"tiers": [
+			{"type": "CartValue", "minimumCentAmount": 20000, "price": {"type": "centPrecision", "currencyCode": "EUR", "centAmount": 2000, "fractionDigits": 2}},
+			{"type": "CartScore", "score": 0, "priceFunction": {"currencyCode": "EUR", "function": "x + 1"}}
+		]
+	}`), &shippingRate)
+	require.NoError(t, err)
+
+	draft := shippingRate.draft()
+	assert.Equal(t, "EUR", draft.currencyCode())
+
+	data, err := json.Marshal(shippingMethodRemoveShippingRateAction{
+		Zone:         platform.ZoneResourceIdentifier{Key: stringRef("de")},
+		ShippingRate: *draft,
+	})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{
+		"action": "removeShippingRate",
+		"zone": {"key": "de", "typeId": "zone"},
+		"shippingRate": {
+			"price": {"type": "highPrecision", "currencyCode": "EUR", "preciseAmount": 43215, "fractionDigits": 3},
+			"tiers": [
+				{"type": "CartValue", "minimumCentAmount": 20000, "price": {"currencyCode": "EUR", "centAmount": 2000}},
+				{"type": "CartScore", "score": 0, "priceFunction": {"currencyCode": "EUR", "function": "x + 1"}}
+			]
+		}
+	}`, string(data))
+}
+
+func TestTypedMoneyConfigError(t *testing.T) {
+	money := func(centAmount, preciseAmount cty.Value) cty.Value {
+		return cty.ObjectVal(map[string]cty.Value{
+			"value": cty.ListVal([]cty.Value{cty.ObjectVal(map[string]cty.Value{
+				"type": cty.StringVal("absolute"),
+				"money": cty.ListVal([]cty.Value{cty.ObjectVal(map[string]cty.Value{
+					"currency_code":   cty.StringVal("EUR"),
+					"cent_amount":     centAmount,
+					"precise_amount":  preciseAmount,
+					"fraction_digits": cty.NullVal(cty.Number),
+				})}),
+			})}),
+		})
+	}
+
+	assert.NoError(t, typedMoneyConfigError(money(cty.NumberIntVal(0), cty.NullVal(cty.Number)), nil))
+	assert.NoError(t, typedMoneyConfigError(money(cty.NullVal(cty.Number), cty.NumberIntVal(123456)), nil))
+	assert.NoError(t, typedMoneyConfigError(money(cty.UnknownVal(cty.Number), cty.NullVal(cty.Number)), nil))
+
+	err := typedMoneyConfigError(money(cty.NullVal(cty.Number), cty.NullVal(cty.Number)), nil)
+	assert.EqualError(t, err, "value.0.money.0: exactly one of cent_amount or precise_amount must be set")
+
+	err = typedMoneyConfigError(money(cty.NumberIntVal(1235), cty.NumberIntVal(123456)), nil)
+	assert.EqualError(t, err, "value.0.money.0: exactly one of cent_amount or precise_amount must be set")
+}
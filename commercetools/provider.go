@@ -11,7 +11,9 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/labd/commercetools-go-sdk/ctutils"
+	"github.com/labd/commercetools-go-sdk/history"
 	"github.com/labd/commercetools-go-sdk/platform"
+	"golang.org/x/oauth2"
 	"golang.org/x/oauth2/clientcredentials"
 
 	"github.com/labd/terraform-provider-commercetools/internal/utils"
@@ -61,15 +63,39 @@ func New(version string) func() *schema.Provider {
 					Optional:    true,
 					Description: "The authentication URL of the commercetools platform. https://docs.commercetools.com/http-api-authorization",
 				},
+				// The Import API setting is only used by the
+				// terraform-plugin-framework provider, but the schema must be
+				// identical for both.
+				"import_api_url": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "The URL of the commercetools Import API. Defaults to the import host in the region of the `api_url`. https://docs.commercetools.com/import-export/",
+				},
+				"history_api_url": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "The URL of the commercetools History API. Defaults to the history host in the region of the `api_url`. https://docs.commercetools.com/api/history/change-history",
+				},
+				"history_drift_warnings": {
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Description: "When enabled, resources which were modified outside of Terraform report who last modified them in a warning. This uses the History API and requires the `view_audit_log` scope. Resources which the History API does not record, like API clients, API extensions, shipping methods and subscriptions, never report a warning.",
+				},
+				"validate_locales": {
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Description: "When enabled, the locales of all localized strings are validated against the languages of the project during plan. This requires the `view_project_settings` scope.",
+				},
 			},
 			ResourcesMap: map[string]*schema.Resource{
 				"commercetools_api_client":         resourceAPIClient(),
 				"commercetools_api_extension":      resourceAPIExtension(),
 				"commercetools_cart_discount":      resourceCartDiscount(),
 				"commercetools_channel":            resourceChannel(),
-				"commercetools_custom_object":      resourceCustomObject(),
+				"commercetools_customer":           resourceCustomer(),
 				"commercetools_customer_group":     resourceCustomerGroup(),
 				"commercetools_discount_code":      resourceDiscountCode(),
+				"commercetools_inventory_entry":    resourceInventoryEntry(),
 				"commercetools_product_type":       resourceProductType(),
 				"commercetools_shipping_method":    resourceShippingMethod(),
 				"commercetools_shipping_zone_rate": resourceShippingZoneRate(),
@@ -88,6 +114,10 @@ func New(version string) func() *schema.Provider {
 				// "commercetools_subscription":       resourceSubscription(),
 			},
 		}
+		for name, r := range p.ResourcesMap {
+			withLocaleValidation(r)
+			withDriftWarnings(name, r)
+		}
 		p.ConfigureContextFunc = providerConfigure(version)
 		return p
 	}
@@ -136,7 +166,41 @@ func providerConfigure(version string) func(context.Context, *schema.ResourceDat
 		if err != nil {
 			return nil, diag.FromErr(err)
 		}
-		return client.WithProjectKey(projectKey), nil
+
+		rawHTTPClient := oauth2Config.Client(context.WithValue(context.Background(), oauth2.HTTPClient, &http.Client{
+			Transport: ctutils.DebugTransport,
+		}))
+
+		data := &utils.ProviderData{
+			ProjectKey: projectKey,
+			Client:     client.WithProjectKey(projectKey),
+			Mutex:      ctMutexKV,
+			RawClient: utils.NewRawClient(rawHTTPClient, apiURL, projectKey,
+				fmt.Sprintf("terraform-provider-commercetools/%s", version)),
+		}
+		if d.Get("history_drift_warnings").(bool) {
+			historyApiURL := getDefault(d, "history_api_url", "CTP_HISTORY_API_URL")
+			if historyApiURL == "" {
+				historyApiURL = utils.HistoryApiURL(apiURL)
+			}
+			if historyApiURL != "" {
+				historyClient, err := history.NewClient(&history.ClientConfig{
+					URL:         historyApiURL,
+					Credentials: oauth2Config,
+					UserAgent:   fmt.Sprintf("terraform-provider-commercetools/%s", version),
+					HTTPClient:  httpClient,
+				})
+				if err != nil {
+					return nil, diag.FromErr(err)
+				}
+				data.HistoryClient = historyClient.WithProjectKeyValue(projectKey)
+				data.HistoryDriftWarnings = true
+			}
+		}
+		if d.Get("validate_locales").(bool) {
+			data.Locales = utils.NewLocaleValidator(data.Client, apiURL, projectKey)
+		}
+		return data, nil
 	}
 }
 
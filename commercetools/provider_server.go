@@ -0,0 +1,65 @@
+package commercetools
+
+import (
+	"context"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/go-cty/cty/msgpack"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// planWarnings returns the warnings for the planned change of a resource from
+// its prior state and its configuration. Both are null when the resource is
+// created or destroyed respectively.
+var planWarnings = map[string]func(prior, config cty.Value) diag.Diagnostics{
+	"commercetools_shipping_zone":     shippingZoneLocationWarnings,
+	"commercetools_tax_category_rate": taxCategoryRateLocationWarnings,
+}
+
+// NewProviderServer returns the protocol 5 server of the provider. The SDK
+// can't return warnings from a CustomizeDiff, so the server adds the
+// planWarnings of a resource to its planned change.
+func NewProviderServer(p *schema.Provider) func() tfprotov5.ProviderServer {
+	return func() tfprotov5.ProviderServer {
+		return &providerServer{ProviderServer: p.GRPCProvider(), provider: p}
+	}
+}
+
+type providerServer struct {
+	tfprotov5.ProviderServer
+	provider *schema.Provider
+}
+
+func (s *providerServer) PlanResourceChange(ctx context.Context, req *tfprotov5.PlanResourceChangeRequest) (*tfprotov5.PlanResourceChangeResponse, error) {
+	resp, err := s.ProviderServer.PlanResourceChange(ctx, req)
+	warnings, ok := planWarnings[req.TypeName]
+	if err != nil || !ok {
+		return resp, err
+	}
+
+	ty := s.provider.ResourcesMap[req.TypeName].CoreConfigSchema().ImpliedType()
+	prior, config := decodeDynamicValue(req.PriorState, ty), decodeDynamicValue(req.Config, ty)
+	for _, warning := range warnings(prior, config) {
+		resp.Diagnostics = append(resp.Diagnostics, &tfprotov5.Diagnostic{
+			Severity: tfprotov5.DiagnosticSeverityWarning,
+			Summary:  warning.Summary,
+			Detail:   warning.Detail,
+		})
+	}
+	return resp, nil
+}
+
+// decodeDynamicValue returns the value, or a null value when it can't be
+// decoded. The SDK reports the errors for values which can't be decoded.
+func decodeDynamicValue(value *tfprotov5.DynamicValue, ty cty.Type) cty.Value {
+	if value == nil {
+		return cty.NullVal(ty)
+	}
+	val, err := msgpack.Unmarshal(value.MsgPack, ty)
+	if err != nil {
+		return cty.NullVal(ty)
+	}
+	return val
+}
@@ -0,0 +1,43 @@
+package commercetools
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/go-cty/cty/msgpack"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProviderServerPlanWarnings(t *testing.T) {
+	p := New("testing")()
+	ty := p.ResourcesMap["commercetools_tax_category_rate"].CoreConfigSchema().ImpliedType()
+
+	attributes := map[string]cty.Value{}
+	for name, attributeType := range ty.AttributeTypes() {
+		attributes[name] = cty.NullVal(attributeType)
+	}
+	attributes["tax_category_id"] = cty.StringVal("tax-category")
+	attributes["name"] = cty.StringVal("Bavaria")
+	attributes["amount"] = cty.NumberFloatVal(0.19)
+	attributes["included_in_price"] = cty.True
+	attributes["country"] = cty.StringVal("DE")
+	attributes["state"] = cty.StringVal("Bavaria")
+	config, err := msgpack.Marshal(cty.ObjectVal(attributes), ty)
+	require.NoError(t, err)
+	prior, err := msgpack.Marshal(cty.NullVal(ty), ty)
+	require.NoError(t, err)
+
+	resp, err := NewProviderServer(p)().PlanResourceChange(context.Background(), &tfprotov5.PlanResourceChangeRequest{
+		TypeName:         "commercetools_tax_category_rate",
+		PriorState:       &tfprotov5.DynamicValue{MsgPack: prior},
+		ProposedNewState: &tfprotov5.DynamicValue{MsgPack: config},
+		Config:           &tfprotov5.DynamicValue{MsgPack: config},
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.Diagnostics, 1)
+	assert.Equal(t, tfprotov5.DiagnosticSeverityWarning, resp.Diagnostics[0].Severity)
+	assert.Contains(t, resp.Diagnostics[0].Detail, `"state" "Bavaria" is not an ISO 3166-2 subdivision of DE`)
+}
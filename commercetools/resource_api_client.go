@@ -2,6 +2,10 @@ package commercetools
 
 import (
 	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"regexp"
 	"sort"
 	"strings"
 	"time"
@@ -9,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/labd/commercetools-go-sdk/platform"
 
 	"github.com/labd/terraform-provider-commercetools/internal/utils"
@@ -19,10 +24,20 @@ func resourceAPIClient() *schema.Resource {
 		Description: "Create a new API client. Note that Commercetools might return slightly different scopes, " +
 			"resulting in a new API client being created everytime Terraform is run. In this case, " +
 			"fix your scopes accordingly to match what is returned by Commercetools.\n\n" +
+			"With a `rotation` block the API client is replaced by a new API client with the same settings " +
+			"whenever the `version` of the block changes, for example by using the `unix` attribute of a " +
+			"`time_rotating` resource. The replacement is created before the previous API client is " +
+			"deleted, and the `id` refers to the replacement afterwards. With `keep_previous` the " +
+			"`previous_client_id` and `previous_secret` remain available until the next rotation.\n\n" +
+			"With a `store_scoped` block the API client gets the store specific scopes " +
+			"`<permission>:<project key>:<store key>` for each of the permissions and stores, which " +
+			"restricts it to the data of those stores.\n\n" +
 			"Also see the [API client HTTP API documentation](https://docs.commercetools.com//http-api-projects-api-clients).",
 		CreateContext: resourceAPIClientCreate,
 		ReadContext:   resourceAPIClientRead,
+		UpdateContext: resourceAPIClientUpdate,
 		DeleteContext: resourceAPIClientDelete,
+		CustomizeDiff: resourceAPIClientRotation,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -34,59 +49,161 @@ func resourceAPIClient() *schema.Resource {
 				ForceNew:    true,
 			},
 			"scope": {
-				Description: "A list of the [OAuth scopes](https://docs.commercetools.com/http-api-authorization.html#scopes)",
-				Type:        schema.TypeSet,
-				Elem:        &schema.Schema{Type: schema.TypeString},
-				Required:    true,
-				ForceNew:    true,
+				Description: "A list of the [OAuth scopes](https://docs.commercetools.com/http-api-authorization.html#scopes). " +
+					"The scopes created by `store_scoped` are not included",
+				Type:         schema.TypeSet,
+				Elem:         &schema.Schema{Type: schema.TypeString},
+				Optional:     true,
+				ForceNew:     true,
+				AtLeastOneOf: []string{"scope", "store_scoped"},
+			},
+			"store_scoped": {
+				Description: "Restrict the API client to stores with " +
+					"[store specific scopes](https://docs.commercetools.com/api/scopes#composable-commerce-store-specific-scopes)",
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"store_keys": {
+							Description: "The keys of the stores the API client is restricted to",
+							Type:        schema.TypeSet,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Required:    true,
+							ForceNew:    true,
+							MinItems:    1,
+						},
+						"permissions": {
+							Description: "The permissions to grant for each of the stores, for example `manage_orders` " +
+								"or `view_customers`",
+							Type: schema.TypeSet,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+								ValidateFunc: validation.StringMatch(storePermissionPattern,
+									"must be a permission like manage_orders, without project or store key"),
+							},
+							Required: true,
+							ForceNew: true,
+							MinItems: 1,
+						},
+					},
+				},
+			},
+			"access_token_validity_seconds": {
+				Description: "Expiration time in seconds for each access token obtained by the API client. " +
+					"When not set the default of commercetools applies",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"refresh_token_validity_seconds": {
+				Description: "Inactivity expiration time in seconds for each refresh token obtained by the API " +
+					"client. When not set the default of commercetools applies",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"delete_days_after_last_usage": {
+				Description:  "Number of days after which commercetools deletes the API client when it is not used",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"rotation": {
+				Description: "Replace the API client when the version changes",
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"version": {
+							Description: "Changing the version replaces the API client, for example the `unix` " +
+								"attribute of a `time_rotating` resource",
+							Type:     schema.TypeInt,
+							Required: true,
+						},
+						"keep_previous": {
+							Description: "Keep the previous API client after it has been replaced, until the " +
+								"next rotation or until this is disabled",
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+					},
+				},
 			},
 			"secret": {
 				Type:      schema.TypeString,
 				Computed:  true,
 				Sensitive: true,
 			},
+			"client_id": {
+				Description: "The ID of the API client, which is the same as the `id`",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"rotated_at": {
+				Description: "The creation time of the current API client",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"previous_client_id": {
+				Description: "The ID of the replaced API client during the overlap of a rotation",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"previous_secret": {
+				Description: "The secret of the replaced API client during the overlap of a rotation",
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+			},
+			"last_used_at": {
+				Description: "The date the API client was last used to obtain an access token",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"expires_at": {
+				Description: "The time at which commercetools deletes the API client",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
 		},
 	}
 }
 
-func resourceAPIClientCreate(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
-	name := d.Get("name").(string)
-	scopes := d.Get("scope").(*schema.Set).List()
-
-	scopeParts := make([]string, 0)
-	for i := 0; i < len(scopes); i++ {
-		scopeParts = append(scopeParts, scopes[i].(string))
-	}
-
-	draft := platform.ApiClientDraft{
-		Name:  name,
-		Scope: strings.Join(scopeParts, " "),
-	}
-
-	client := getClient(m)
-
-	var apiClient *platform.ApiClient
+// apiClientDraft adds the fields to the draft which are not supported by the
+// SDK yet.
+type apiClientDraft struct {
+	platform.ApiClientDraft
+	DeleteDaysAfterLastUsage *int `json:"deleteDaysAfterLastUsage,omitempty"`
+}
 
-	err := retry.RetryContext(ctx, 20*time.Second, func() *retry.RetryError {
-		var err error
-		apiClient, err = client.ApiClients().Post(draft).Execute(ctx)
-		return utils.ProcessRemoteError(err)
-	})
+// apiClientResponse is the API client as returned by the API, see
+// apiClientDraft.
+type apiClientResponse struct {
+	platform.ApiClient
+	DeleteDaysAfterLastUsage *int `json:"deleteDaysAfterLastUsage,omitempty"`
+}
 
+func resourceAPIClientCreate(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	apiClient, err := createAPIClient(ctx, d, m)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
 	d.SetId(apiClient.ID)
+	_ = d.Set("client_id", apiClient.ID)
 	_ = d.Set("secret", apiClient.Secret)
 
 	return resourceAPIClientRead(ctx, d, m)
 }
 
 func resourceAPIClientRead(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
-	client := getClient(m)
-	apiClient, err := client.ApiClients().WithId(d.Id()).Get().Execute(ctx)
-
+	apiClient, err := getAPIClient(ctx, m, d.Id())
 	if err != nil {
 		if utils.IsResourceNotFoundError(err) {
 			d.SetId("")
@@ -95,21 +212,260 @@ func resourceAPIClientRead(ctx context.Context, d *schema.ResourceData, m any) d
 		return diag.FromErr(err)
 	}
 
-	d.SetId(apiClient.ID)
+	_ = d.Set("client_id", apiClient.ID)
 	_ = d.Set("name", apiClient.Name)
-	scopes := strings.Split(apiClient.Scope, " ")
+	scopes, complete := splitStoreScopes(strings.Split(apiClient.Scope, " "),
+		storeScopes(getProjectKey(m), d.Get("store_scoped").([]any)))
 	sort.Strings(scopes)
 	_ = d.Set("scope", scopes)
+	if !complete {
+		// Some store scopes are missing, which results in a new API client
+		_ = d.Set("store_scoped", nil)
+	}
+	_ = d.Set("access_token_validity_seconds", apiClient.AccessTokenValiditySeconds)
+	_ = d.Set("refresh_token_validity_seconds", apiClient.RefreshTokenValiditySeconds)
+	if apiClient.DeleteDaysAfterLastUsage != nil {
+		_ = d.Set("delete_days_after_last_usage", apiClient.DeleteDaysAfterLastUsage)
+	}
+
+	_ = d.Set("rotated_at", "")
+	if apiClient.CreatedAt != nil {
+		_ = d.Set("rotated_at", apiClient.CreatedAt.Format(time.RFC3339))
+	}
+	_ = d.Set("last_used_at", "")
+	if apiClient.LastUsedAt != nil {
+		date := apiClient.LastUsedAt
+		_ = d.Set("last_used_at", fmt.Sprintf("%04d-%02d-%02d", date.Year, date.Month, date.Day))
+	}
+	_ = d.Set("expires_at", "")
+	if apiClient.DeleteAt != nil {
+		_ = d.Set("expires_at", apiClient.DeleteAt.Format(time.RFC3339))
+	}
+
+	// The previous API client might have been deleted by commercetools
+	// already, for example by delete_days_after_last_usage.
+	if previousID := d.Get("previous_client_id").(string); previousID != "" {
+		if _, err := getAPIClient(ctx, m, previousID); err != nil {
+			if !utils.IsResourceNotFoundError(err) {
+				return diag.FromErr(err)
+			}
+			_ = d.Set("previous_client_id", "")
+			_ = d.Set("previous_secret", "")
+		}
+	}
 	return nil
 }
 
+// resourceAPIClientUpdate rotates the API client. All other fields force a
+// new API client.
+func resourceAPIClientUpdate(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	plan := d.GetRawPlan()
+	oldPrevious, newPrevious := d.GetChange("previous_client_id")
+	previousID := oldPrevious.(string)
+
+	if !plan.IsNull() && !plan.GetAttr("client_id").IsKnown() {
+		apiClient, err := createAPIClient(ctx, d, m)
+		if err != nil {
+			// Workaround invalid state to be written, see
+			// https://github.com/hashicorp/terraform-plugin-sdk/issues/476
+			d.Partial(true)
+			return diag.FromErr(err)
+		}
+
+		// Only one previous API client is kept
+		if previousID != "" {
+			if err := deleteAPIClient(ctx, m, previousID); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+
+		currentID, _ := d.GetChange("client_id")
+		currentSecret, _ := d.GetChange("secret")
+		d.SetId(apiClient.ID)
+		_ = d.Set("client_id", apiClient.ID)
+		_ = d.Set("secret", apiClient.Secret)
+		_ = d.Set("previous_client_id", currentID)
+		_ = d.Set("previous_secret", currentSecret)
+
+		if !rotationKeepPrevious(d) {
+			if err := deleteAPIClient(ctx, m, currentID.(string)); err != nil {
+				return diag.FromErr(err)
+			}
+			_ = d.Set("previous_client_id", "")
+			_ = d.Set("previous_secret", "")
+		}
+		return resourceAPIClientRead(ctx, d, m)
+	}
+
+	if previousID != "" && newPrevious.(string) == "" {
+		if err := deleteAPIClient(ctx, m, previousID); err != nil {
+			return diag.FromErr(err)
+		}
+		_ = d.Set("previous_client_id", "")
+		_ = d.Set("previous_secret", "")
+	}
+	return resourceAPIClientRead(ctx, d, m)
+}
+
 func resourceAPIClientDelete(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
-	client := getClient(m)
+	if previousID := d.Get("previous_client_id").(string); previousID != "" {
+		if err := deleteAPIClient(ctx, m, previousID); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	return diag.FromErr(deleteAPIClient(ctx, m, d.Id()))
+}
+
+// resourceAPIClientRotation plans a new API client when the version of the
+// rotation changes, and the removal of the previous API client when it is no
+// longer kept.
+func resourceAPIClientRotation(_ context.Context, d *schema.ResourceDiff, _ any) error {
+	if d.Id() == "" {
+		return nil
+	}
+
+	oldRotation, newRotation := d.GetChange("rotation")
+	oldVersion, wasRotated := rotationVersion(oldRotation.([]any))
+	newVersion, isRotated := rotationVersion(newRotation.([]any))
+	if wasRotated && isRotated && oldVersion != newVersion {
+		for _, key := range []string{"client_id", "secret", "rotated_at", "previous_client_id",
+			"previous_secret", "last_used_at", "expires_at"} {
+			if err := d.SetNewComputed(key); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	if d.Get("previous_client_id").(string) != "" && !rotationKeepPrevious(d) {
+		if err := d.SetNew("previous_client_id", ""); err != nil {
+			return err
+		}
+		return d.SetNew("previous_secret", "")
+	}
+	return nil
+}
+
+// rotationVersion returns the version of the rotation block, and false when
+// there is no rotation block.
+func rotationVersion(rotation []any) (int, bool) {
+	if len(rotation) == 0 || rotation[0] == nil {
+		return 0, false
+	}
+	return rotation[0].(map[string]any)["version"].(int), true
+}
+
+type resourceGetter interface {
+	Get(key string) any
+}
+
+func rotationKeepPrevious(d resourceGetter) bool {
+	rotation := d.Get("rotation").([]any)
+	if len(rotation) == 0 || rotation[0] == nil {
+		return false
+	}
+	return rotation[0].(map[string]any)["keep_previous"].(bool)
+}
+
+// storePermissionPattern matches the permission of a store specific scope,
+// like manage_orders.
+var storePermissionPattern = regexp.MustCompile(`^(manage|view)_[a-z_]+$`)
+
+// storeScopes returns the scopes of the store_scoped block, which are the
+// permissions for each of the stores in the form
+// <permission>:<project key>:<store key>.
+func storeScopes(projectKey string, storeScoped []any) []string {
+	if len(storeScoped) == 0 || storeScoped[0] == nil {
+		return nil
+	}
+	raw := storeScoped[0].(map[string]any)
+	permissions := expandStringArray(raw["permissions"].(*schema.Set).List())
+	storeKeys := expandStringArray(raw["store_keys"].(*schema.Set).List())
+	sort.Strings(permissions)
+	sort.Strings(storeKeys)
+
+	result := make([]string, 0, len(permissions)*len(storeKeys))
+	for _, permission := range permissions {
+		for _, storeKey := range storeKeys {
+			result = append(result, fmt.Sprintf("%s:%s:%s", permission, projectKey, storeKey))
+		}
+	}
+	return result
+}
+
+// splitStoreScopes returns the scopes of the API client which are not store
+// scopes, and whether all store scopes were found.
+func splitStoreScopes(scopes, storeScopes []string) ([]string, bool) {
+	remaining := make(map[string]bool, len(storeScopes))
+	for _, scope := range storeScopes {
+		remaining[scope] = true
+	}
+
+	result := make([]string, 0, len(scopes))
+	for _, scope := range scopes {
+		if scope == "" {
+			continue
+		}
+		if _, ok := remaining[scope]; ok {
+			delete(remaining, scope)
+			continue
+		}
+		result = append(result, scope)
+	}
+	return result, len(remaining) == 0
+}
+
+func createAPIClient(ctx context.Context, d *schema.ResourceData, m any) (*platform.ApiClient, error) {
+	scopes := d.Get("scope").(*schema.Set).List()
+	scopeParts := make([]string, 0)
+	for i := 0; i < len(scopes); i++ {
+		scopeParts = append(scopeParts, scopes[i].(string))
+	}
+	scopeParts = append(scopeParts, storeScopes(getProjectKey(m), d.Get("store_scoped").([]any))...)
 
+	draft := apiClientDraft{
+		ApiClientDraft: platform.ApiClientDraft{
+			Name:  d.Get("name").(string),
+			Scope: strings.Join(scopeParts, " "),
+		},
+	}
+	if val, ok := d.GetOk("access_token_validity_seconds"); ok {
+		draft.AccessTokenValiditySeconds = intRef(val)
+	}
+	if val, ok := d.GetOk("refresh_token_validity_seconds"); ok {
+		draft.RefreshTokenValiditySeconds = intRef(val)
+	}
+	if val, ok := d.GetOk("delete_days_after_last_usage"); ok {
+		draft.DeleteDaysAfterLastUsage = intRef(val)
+	}
+
+	var apiClient platform.ApiClient
 	err := retry.RetryContext(ctx, 20*time.Second, func() *retry.RetryError {
-		_, err := client.ApiClients().WithId(d.Id()).Delete().Execute(ctx)
+		err := getRawClient(m).Do(ctx, http.MethodPost, "/api-clients", draft, &apiClient)
 		return utils.ProcessRemoteError(err)
 	})
+	if err != nil {
+		return nil, err
+	}
+	return &apiClient, nil
+}
 
-	return diag.FromErr(err)
+func getAPIClient(ctx context.Context, m any, id string) (*apiClientResponse, error) {
+	var apiClient apiClientResponse
+	err := getRawClient(m).Do(ctx, http.MethodGet, "/api-clients/"+url.PathEscape(id), nil, &apiClient)
+	if err != nil {
+		return nil, err
+	}
+	return &apiClient, nil
+}
+
+func deleteAPIClient(ctx context.Context, m any, id string) error {
+	client := getClient(m)
+	return retry.RetryContext(ctx, 20*time.Second, func() *retry.RetryError {
+		_, err := client.ApiClients().WithId(id).Delete().Execute(ctx)
+		if utils.IsResourceNotFoundError(err) {
+			return nil
+		}
+		return utils.ProcessRemoteError(err)
+	})
 }
@@ -0,0 +1,121 @@
+package commercetools
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAPIClientRotationSettings(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceAPIClient().Schema, map[string]any{
+		"name":  "rotated",
+		"scope": []any{"manage_project:my-project"},
+		"rotation": []any{
+			map[string]any{
+				"version":       1729209600,
+				"keep_previous": true,
+			},
+		},
+	})
+	version, ok := rotationVersion(d.Get("rotation").([]any))
+	assert.True(t, ok)
+	assert.Equal(t, 1729209600, version)
+	assert.True(t, rotationKeepPrevious(d))
+
+	d = schema.TestResourceDataRaw(t, resourceAPIClient().Schema, map[string]any{
+		"name":  "static",
+		"scope": []any{"manage_project:my-project"},
+	})
+	_, ok = rotationVersion(d.Get("rotation").([]any))
+	assert.False(t, ok)
+	assert.False(t, rotationKeepPrevious(d))
+}
+
+func TestAPIClientRotationDiff(t *testing.T) {
+	state := &terraform.InstanceState{
+		ID: "client-2",
+		Attributes: map[string]string{
+			"id":                       "client-2",
+			"name":                     "rotated",
+			"scope.#":                  "1",
+			"scope.0":                  "manage_project:my-project",
+			"rotation.#":               "1",
+			"rotation.0.version":       "1",
+			"rotation.0.keep_previous": "true",
+			"client_id":                "client-2",
+			"secret":                   "secret-2",
+			"previous_client_id":       "client-1",
+			"previous_secret":          "secret-1",
+		},
+	}
+	config := func(version int, keepPrevious bool) *terraform.ResourceConfig {
+		return terraform.NewResourceConfigRaw(map[string]any{
+			"name":  "rotated",
+			"scope": []any{"manage_project:my-project"},
+			"rotation": []any{
+				map[string]any{"version": version, "keep_previous": keepPrevious},
+			},
+		})
+	}
+
+	diff, err := resourceAPIClient().Diff(context.Background(), state, config(1, true), nil)
+	require.NoError(t, err)
+	assert.Nil(t, diff)
+
+	diff, err = resourceAPIClient().Diff(context.Background(), state, config(2, true), nil)
+	require.NoError(t, err)
+	assert.True(t, diff.Attributes["client_id"].NewComputed)
+	assert.True(t, diff.Attributes["previous_client_id"].NewComputed)
+	assert.False(t, diff.RequiresNew())
+
+	diff, err = resourceAPIClient().Diff(context.Background(), state, config(1, false), nil)
+	require.NoError(t, err)
+	assert.Nil(t, diff.Attributes["client_id"])
+	assert.Equal(t, "", diff.Attributes["previous_client_id"].New)
+}
+
+func TestAPIClientStoreScopes(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceAPIClient().Schema, map[string]any{
+		"name": "store client",
+		"store_scoped": []any{
+			map[string]any{
+				"store_keys":  []any{"nl", "be"},
+				"permissions": []any{"view_customers", "manage_orders"},
+			},
+		},
+	})
+
+	scopes := storeScopes("my-project", d.Get("store_scoped").([]any))
+	assert.Equal(t, []string{
+		"manage_orders:my-project:be",
+		"manage_orders:my-project:nl",
+		"view_customers:my-project:be",
+		"view_customers:my-project:nl",
+	}, scopes)
+	assert.Empty(t, storeScopes("my-project", nil))
+
+	remaining, complete := splitStoreScopes([]string{
+		"manage_orders:my-project:be",
+		"manage_orders:my-project:nl",
+		"view_products:my-project",
+		"view_customers:my-project:be",
+		"view_customers:my-project:nl",
+	}, scopes)
+	assert.Equal(t, []string{"view_products:my-project"}, remaining)
+	assert.True(t, complete)
+
+	remaining, complete = splitStoreScopes([]string{"manage_orders:my-project:nl"}, scopes)
+	assert.Empty(t, remaining)
+	assert.False(t, complete)
+}
+
+func TestAPIClientStorePermission(t *testing.T) {
+	assert.True(t, storePermissionPattern.MatchString("manage_orders"))
+	assert.True(t, storePermissionPattern.MatchString("view_shopping_lists"))
+	assert.False(t, storePermissionPattern.MatchString("manage_orders:my-project"))
+	assert.False(t, storePermissionPattern.MatchString("create_anonymous_token"))
+}
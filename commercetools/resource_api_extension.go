@@ -2,16 +2,20 @@ package commercetools
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"log"
+	"net/http"
 	"strings"
 	"time"
 
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/labd/commercetools-go-sdk/platform"
 
+	"github.com/labd/terraform-provider-commercetools/internal/predicate"
 	"github.com/labd/terraform-provider-commercetools/internal/utils"
 )
 
@@ -25,6 +29,7 @@ func resourceAPIExtension() *schema.Resource {
 		ReadContext:   resourceAPIExtensionRead,
 		UpdateContext: resourceAPIExtensionUpdate,
 		DeleteContext: resourceAPIExtensionDelete,
+		CustomizeDiff: resourceAPIExtensionValidate,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -62,12 +67,14 @@ func resourceAPIExtension() *schema.Resource {
 							Optional: true,
 						},
 						"azure_authentication": {
-							Type:     schema.TypeString,
-							Optional: true,
+							Type:             schema.TypeString,
+							Optional:         true,
+							DiffSuppressFunc: suppressHashedSecretDiff,
 						},
 						"authorization_header": {
-							Type:     schema.TypeString,
-							Optional: true,
+							Type:             schema.TypeString,
+							Optional:         true,
+							DiffSuppressFunc: suppressHashedSecretDiff,
 						},
 
 						// AWSLambda specific fields
@@ -80,9 +87,19 @@ func resourceAPIExtension() *schema.Resource {
 							Optional: true,
 						},
 						"access_secret": {
-							Type:      schema.TypeString,
-							Optional:  true,
-							Sensitive: true,
+							Type:             schema.TypeString,
+							Optional:         true,
+							Sensitive:        true,
+							DiffSuppressFunc: suppressHashedSecretDiff,
+						},
+
+						"secret_version": {
+							Description: "Version of the secrets of the destination. When set, the " +
+								"`authorization_header`, `azure_authentication` and `access_secret` are stored " +
+								"in the state as a hash, and are sent to commercetools again when the version " +
+								"is changed, for example after rotating them",
+							Type:     schema.TypeInt,
+							Optional: true,
 						},
 					},
 				},
@@ -106,9 +123,13 @@ func resourceAPIExtension() *schema.Resource {
 							Elem:        &schema.Schema{Type: schema.TypeString},
 						},
 						"condition": {
-							Description: "Valid predicate that controls the conditions under which the API Extension is called.",
-							Type:        schema.TypeString,
-							Optional:    true,
+							Description: "Valid predicate that controls the conditions under which the API Extension is called. " +
+								"The predicate is validated against the fields of the resource type. The " +
+								"`commercetools_api_extension_condition` data source can be used to test the condition " +
+								"against a sample resource",
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validateExtensionCondition,
 						},
 					},
 				},
@@ -118,6 +139,7 @@ func resourceAPIExtension() *schema.Resource {
 				Type:        schema.TypeInt,
 				Optional:    true,
 			},
+			"verify": extensionVerifySchema(),
 			"version": {
 				Type:     schema.TypeInt,
 				Computed: true,
@@ -141,6 +163,51 @@ func validateDestinationType(val any, key string) (warns []string, errs []error)
 	return
 }
 
+// validateExtensionCondition validates the syntax of the condition. Conditions
+// which use constructs the parser doesn't support, like geo location
+// predicates, are left to the API to validate.
+func validateExtensionCondition(val any, key string) (warns []string, errs []error) {
+	if v := val.(string); v != "" {
+		if _, err := predicate.Parse(v); err != nil && !errors.Is(err, predicate.ErrUnsupported) {
+			errs = append(errs, fmt.Errorf("%q is not a valid predicate: %w", key, err))
+		}
+	}
+	return
+}
+
+// resourceAPIExtensionValidate validates the trigger conditions against the
+// fields of the resource type of the trigger.
+func resourceAPIExtensionValidate(_ context.Context, d *schema.ResourceDiff, _ any) error {
+	for i, raw := range d.Get("trigger").([]any) {
+		trigger, ok := raw.(map[string]any)
+		if !ok {
+			continue
+		}
+		key := fmt.Sprintf("trigger.%d", i)
+		if !d.NewValueKnown(key+".condition") || !d.NewValueKnown(key+".resource_type_id") {
+			continue
+		}
+
+		condition := trigger["condition"].(string)
+		s, ok := predicate.ExtensionSchema(trigger["resource_type_id"].(string))
+		if condition == "" || !ok {
+			continue
+		}
+
+		p, err := predicate.Parse(condition)
+		if errors.Is(err, predicate.ErrUnsupported) {
+			continue
+		}
+		if err == nil {
+			err = p.Validate(s)
+		}
+		if err != nil {
+			return fmt.Errorf("%s.condition is not valid: %w", key, err)
+		}
+	}
+	return nil
+}
+
 func validateExtensionDestination(draft platform.ExtensionDraft) error {
 
 	switch t := draft.Destination.(type) {
@@ -183,6 +250,10 @@ func resourceAPIExtensionCreate(ctx context.Context, d *schema.ResourceData, m a
 		return diag.FromErr(err)
 	}
 
+	if err := resourceAPIExtensionVerify(ctx, d, destination); err != nil {
+		return diag.FromErr(err)
+	}
+
 	var extension *platform.Extension
 	err = retry.RetryContext(ctx, 20*time.Second, func() *retry.RetryError {
 		var err error
@@ -266,6 +337,23 @@ func resourceAPIExtensionUpdate(ctx context.Context, d *schema.ResourceData, m a
 			&platform.ExtensionSetTimeoutInMsAction{TimeoutInMs: &newTimeout})
 	}
 
+	if d.HasChanges("destination", "trigger", "timeout_in_ms", "verify") {
+		destination, err := expandExtensionDestination(d)
+		if err == nil {
+			err = resourceAPIExtensionVerify(ctx, d, destination)
+		}
+		if err != nil {
+			// Workaround invalid state to be written, see
+			// https://github.com/hashicorp/terraform-plugin-sdk/issues/476
+			d.Partial(true)
+			return diag.FromErr(err)
+		}
+	}
+
+	if len(input.Actions) == 0 {
+		return resourceAPIExtensionRead(ctx, d, m)
+	}
+
 	err := retry.RetryContext(ctx, 20*time.Second, func() *retry.RetryError {
 		_, err := client.Extensions().WithId(d.Id()).Post(input).Execute(ctx)
 		return utils.ProcessRemoteError(err)
@@ -295,11 +383,22 @@ func resourceAPIExtensionDelete(ctx context.Context, d *schema.ResourceData, m a
 // Helper methods
 //
 
+// resourceAPIExtensionVerify verifies the destination when the verify block is
+// set.
+func resourceAPIExtensionVerify(ctx context.Context, d *schema.ResourceData, destination platform.Destination) error {
+	input, err := expandExtensionVerifyInput(d, destination)
+	if err != nil || input == nil {
+		return err
+	}
+	return verifyExtension(ctx, &http.Client{}, input)
+}
+
 func expandExtensionDestination(d *schema.ResourceData) (platform.Destination, error) {
 	input, err := elementFromList(d, "destination")
 	if err != nil {
 		return nil, err
 	}
+	configuredExtensionSecrets(d, input)
 
 	switch strings.ToLower(input["type"].(string)) {
 	case "googlecloudfunction":
@@ -327,6 +426,42 @@ func expandExtensionDestination(d *schema.ResourceData) (platform.Destination, e
 	}
 }
 
+// extensionSecretKeys are the fields of the destination which are stored as a
+// hash when the secret_version is set.
+var extensionSecretKeys = []string{"authorization_header", "azure_authentication", "access_secret"}
+
+// suppressHashedSecretDiff suppresses the diff of a secret when the state
+// contains the hash of the configured value.
+func suppressHashedSecretDiff(_, old, new string, d *schema.ResourceData) bool {
+	if d.Get("destination.0.secret_version").(int) == 0 {
+		return false
+	}
+	return old == utils.HashSecret(new)
+}
+
+// configuredExtensionSecrets replaces the secrets of the destination with the
+// configured values. When the diff of a secret is suppressed the planned
+// value is the hash from the state, which should never be sent to
+// commercetools. The configuration is only available when applying changes.
+func configuredExtensionSecrets(d *schema.ResourceData, input map[string]any) {
+	config := d.GetRawConfig()
+	if config.IsNull() || !config.IsKnown() {
+		return
+	}
+	destination := config.GetAttr("destination")
+	if destination.IsNull() || !destination.IsKnown() || destination.LengthInt() == 0 {
+		return
+	}
+
+	values := destination.Index(cty.NumberIntVal(0))
+	for _, key := range extensionSecretKeys {
+		value := values.GetAttr(key)
+		if value.IsKnown() && !value.IsNull() {
+			input[key] = value.AsString()
+		}
+	}
+}
+
 func expandExtensionDestinationAuthentication(destInput map[string]any) (platform.HttpDestinationAuthentication, error) {
 	authKeys := [2]string{"authorization_header", "azure_authentication"}
 	count := 0
@@ -358,7 +493,7 @@ func expandExtensionDestinationAuthentication(destInput map[string]any) (platfor
 
 // flattenExtensionDestination flattens the destination returned by
 // commercetools to write it in the state file.
-func flattenExtensionDestination(dst platform.Destination, d *schema.ResourceData) []map[string]string {
+func flattenExtensionDestination(dst platform.Destination, d *schema.ResourceData) []map[string]any {
 	// Special handling is required here since the destination contains a secret
 	// value which is returned as a masked value by the commercetools API. This means
 	// we need to extract the value from the current raw state file. However, when
@@ -375,13 +510,23 @@ func flattenExtensionDestination(dst platform.Destination, d *schema.ResourceDat
 		current, _ = expandExtensionDestination(d)
 	}
 
+	// With a secret version only the hash of the secrets is stored
+	secretVersion := d.Get("destination.0.secret_version").(int)
+	stateSecret := func(value string) string {
+		if secretVersion != 0 {
+			return utils.HashSecret(value)
+		}
+		return value
+	}
+
 	// A destination is either GoogleCloudFunction, HTTP or AWSLambda
 	switch d := dst.(type) {
 
 	case platform.GoogleCloudFunctionDestination:
-		return []map[string]string{{
-			"type": "GoogleCloudFunction",
-			"url":  d.Url,
+		return []map[string]any{{
+			"type":           "GoogleCloudFunction",
+			"url":            d.Url,
+			"secret_version": secretVersion,
 		}}
 
 	// For the HTTP Destination there are two specific authentication types:
@@ -402,10 +547,11 @@ func flattenExtensionDestination(dst platform.Destination, d *schema.ResourceDat
 				}
 			}
 
-			return []map[string]string{{
+			return []map[string]any{{
 				"type":                 "HTTP",
 				"url":                  d.Url,
-				"authorization_header": secretValue,
+				"authorization_header": stateSecret(secretValue),
+				"secret_version":       secretVersion,
 			}}
 
 		case platform.AzureFunctionsAuthentication:
@@ -419,17 +565,19 @@ func flattenExtensionDestination(dst platform.Destination, d *schema.ResourceDat
 					}
 				}
 			}
-			return []map[string]string{{
+			return []map[string]any{{
 				"type":                 "HTTP",
 				"url":                  d.Url,
-				"azure_authentication": secretValue,
+				"azure_authentication": stateSecret(secretValue),
+				"secret_version":       secretVersion,
 			}}
 
 		default:
 			log.Println("Unexpected authentication type")
-			return []map[string]string{{
-				"type": "HTTP",
-				"url":  d.Url,
+			return []map[string]any{{
+				"type":           "HTTP",
+				"url":            d.Url,
+				"secret_version": secretVersion,
 			}}
 		}
 
@@ -444,15 +592,16 @@ func flattenExtensionDestination(dst platform.Destination, d *schema.ResourceDat
 			}
 		}
 
-		return []map[string]string{{
-			"type":          "awslambda",
-			"access_key":    d.AccessKey,
-			"access_secret": secretValue,
-			"arn":           d.Arn,
+		return []map[string]any{{
+			"type":           "awslambda",
+			"access_key":     d.AccessKey,
+			"access_secret":  stateSecret(secretValue),
+			"arn":            d.Arn,
+			"secret_version": secretVersion,
 		}}
 
 	default:
-		return []map[string]string{}
+		return []map[string]any{}
 	}
 }
 
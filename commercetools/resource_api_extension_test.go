@@ -47,6 +47,46 @@ func TestAPIExtensionExpandExtensionDestination(t *testing.T) {
 	assert.Equal(t, lambdaDestination.AccessSecret, "****abc/")
 }
 
+func TestAPIExtensionFlattenHashedSecret(t *testing.T) {
+	destination := map[string]any{
+		"type":           "AWSLambda",
+		"arn":            "arn:aws:lambda:eu-west-1:111111111:function:api_extensions",
+		"access_key":     "ABCSDF123123123",
+		"access_secret":  "secret",
+		"secret_version": 1,
+	}
+	d := schema.TestResourceDataRaw(t, resourceAPIExtension().Schema, map[string]any{
+		"version":     1,
+		"destination": []any{destination},
+	})
+
+	result := flattenExtensionDestination(platform.AWSLambdaDestination{
+		Arn:          "arn:aws:lambda:eu-west-1:111111111:function:api_extensions",
+		AccessKey:    "ABCSDF123123123",
+		AccessSecret: "****cret",
+	}, d)
+	assert.Equal(t, []map[string]any{{
+		"type":           "awslambda",
+		"arn":            "arn:aws:lambda:eu-west-1:111111111:function:api_extensions",
+		"access_key":     "ABCSDF123123123",
+		"access_secret":  "sha256:2bb80d537b1da3e38bd30361aa855686bde0eacd7162fef6a25fe97bf527a25b",
+		"secret_version": 1,
+	}}, result)
+
+	hash := result[0]["access_secret"].(string)
+	assert.True(t, suppressHashedSecretDiff("destination.0.access_secret", hash, "secret", d))
+	assert.False(t, suppressHashedSecretDiff("destination.0.access_secret", hash, "rotated", d))
+
+	destination["secret_version"] = 0
+	d = schema.TestResourceDataRaw(t, resourceAPIExtension().Schema, map[string]any{
+		"version":     1,
+		"destination": []any{destination},
+	})
+	assert.False(t, suppressHashedSecretDiff("destination.0.access_secret", hash, "secret", d))
+	result = flattenExtensionDestination(platform.AWSLambdaDestination{AccessSecret: "****cret"}, d)
+	assert.Equal(t, "secret", result[0]["access_secret"])
+}
+
 func TestAPIExtensionExpandExtensionDestinationAuthentication(t *testing.T) {
 	var input = map[string]any{
 		"authorization_header": "12345",
@@ -93,6 +133,18 @@ func TestExpandExtensionTriggers(t *testing.T) {
 	assert.Len(t, triggers[0].Actions, 2)
 }
 
+func TestValidateExtensionCondition(t *testing.T) {
+	_, errs := validateExtensionCondition(`lineItems(quantity > 1) and country = "NL"`, "condition")
+	assert.Empty(t, errs)
+
+	_, errs = validateExtensionCondition(`country = `, "condition")
+	assert.Len(t, errs, 1)
+
+	// Geo location predicates are valid, but not supported by the parser
+	_, errs = validateExtensionCondition(`shippingAddress(geoLocation within circle(13.37, 52.52, 1000))`, "condition")
+	assert.Empty(t, errs)
+}
+
 func TestAccAPIExtension_basic(t *testing.T) {
 	name := fmt.Sprintf("extension_%s", acctest.RandString(5))
 	timeoutInMs := acctest.RandIntRange(200, 1800)
@@ -2,11 +2,16 @@ package commercetools
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"net/http"
+	"net/url"
 	"time"
 
+	"github.com/elliotchance/pie/v2"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/labd/commercetools-go-sdk/ctutils"
 	"github.com/labd/commercetools-go-sdk/platform"
@@ -16,11 +21,21 @@ import (
 func resourceCartDiscount() *schema.Resource {
 	return &schema.Resource{
 		Description: "Cart discounts are used to change the prices of different elements within a cart.\n\n" +
+			"The roles of the channels of a gift line item are validated during plan. Channels of which the " +
+			"roles are changed in the same apply are validated by the API, when they are referred to through " +
+			"the `commercetools_channel` resource.\n\n" +
 			"See also the [Cart Discount API Documentation](https://docs.commercetools.com/api/projects/cartDiscounts)",
 		CreateContext: resourceCartDiscountCreate,
 		ReadContext:   resourceCartDiscountRead,
 		UpdateContext: resourceCartDiscountUpdate,
 		DeleteContext: resourceCartDiscountDelete,
+		CustomizeDiff: customdiff.All(
+			validateChannelRoles(
+				channelRole{field: "value.0.supply_channel_id", role: platform.ChannelRoleEnumInventorySupply},
+				channelRole{field: "value.0.distribution_channel_id", role: platform.ChannelRoleEnumProductDistribution},
+			),
+			validateTypedMoney,
+		),
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -70,24 +85,11 @@ func resourceCartDiscount() *schema.Resource {
 							Optional:    true,
 						},
 						"money": {
-							Description: "Absolute discount specific fields",
-							Type:        schema.TypeList,
-							Optional:    true,
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"currency_code": {
-										Description:  "The currency code compliant to [ISO 4217](https://en.wikipedia.org/wiki/ISO_4217)",
-										Type:         schema.TypeString,
-										Required:     true,
-										ValidateFunc: ValidateCurrencyCode,
-									},
-									"cent_amount": {
-										Description: "The amount in cents (the smallest indivisible unit of the currency)",
-										Type:        schema.TypeInt,
-										Required:    true,
-									},
-								},
-							},
+							Description: "Absolute and fixed discount specific fields. The money is in high " +
+								"precision when precise_amount is set",
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem:     typedMoneyElem(),
 						},
 						"product_id": {
 							Description: "ResourceIdentifier of a Product. Required when value type is giftLineItem",
@@ -128,7 +130,7 @@ func resourceCartDiscount() *schema.Resource {
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"type": {
-							Description:  "Supports lineItems, customLineItems, multiBuyLineItems, multiBuyCustomLineItems, shipping or totalPrice",
+							Description:  "Supports lineItems, customLineItems, multiBuyLineItems, multiBuyCustomLineItems, shipping, totalPrice or pattern",
 							Type:         schema.TypeString,
 							Required:     true,
 							ValidateFunc: validateTargetType,
@@ -152,28 +154,47 @@ func resourceCartDiscount() *schema.Resource {
 							Optional: true,
 						},
 						"max_occurrence": {
-							Description: "MultiBuyLineItems or MultiBuyCustomLineItems target specific fields. " +
+							Description: "MultiBuyLineItems, MultiBuyCustomLineItems or Pattern target specific fields. " +
 								"If set for another target the value will be ignored",
 							Type:     schema.TypeInt,
 							Optional: true,
 						},
 						"selection_mode": {
-							Description: "MultiBuyLineItems or MultiBuyCustomLineItems target specific fields. " +
+							Description: "MultiBuyLineItems, MultiBuyCustomLineItems or Pattern target specific fields. " +
 								"Can be either Cheapest or MostExpensive. " +
 								"If set for another target the value will be ignored",
 							Type:         schema.TypeString,
 							Optional:     true,
 							ValidateFunc: validateSelectionMode,
 						},
+						"trigger_pattern": {
+							Description: "Pattern target specific fields. The units in the cart that must match the " +
+								"pattern for the discount to apply. When empty the target pattern is used as trigger. " +
+								"If set for another target the value will be ignored",
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem:     patternComponentElem(),
+						},
+						"target_pattern": {
+							Description: "Pattern target specific fields. The units in the cart that are discounted " +
+								"when the trigger pattern matches. " +
+								"If set for another target the value will be ignored",
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem:     patternComponentElem(),
+						},
 					},
 				},
 			},
 			"sort_order": {
 				Description: "The string must contain a number between 0 and 1. All matching cart discounts are " +
 					"applied to a cart in the order defined by this field. A discount with greater sort order is " +
-					"prioritized higher than a discount with lower sort order. The sort order is unambiguous among all cart discounts",
+					"prioritized higher than a discount with lower sort order. The sort order is unambiguous among all cart discounts. " +
+					"When not set the discount gets a sort order below all existing cart discounts, which is useful when " +
+					"the sort order is managed by a `commercetools_discount_priority` resource",
 				Type:     schema.TypeString,
-				Required: true,
+				Optional: true,
+				Computed: true,
 			},
 			"is_active": {
 				Description: "Only active discount can be applied to the cart",
@@ -210,6 +231,19 @@ func resourceCartDiscount() *schema.Resource {
 				Type:     schema.TypeInt,
 				Computed: true,
 			},
+			"stores": {
+				Description: "Keys of the stores the cart discount applies to. If empty the cart discount " +
+					"applies to all stores",
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"discount_group_id": {
+				Description: "ID of the [DiscountGroup](https://docs.commercetools.com/api/projects/discount-groups) " +
+					"the cart discount belongs to. The cart discount is then applied with the sort order of the group",
+				Type:     schema.TypeString,
+				Optional: true,
+			},
 			"custom": CustomFieldSchema(),
 		},
 	}
@@ -237,7 +271,56 @@ func validateTargetType(val any, key string) (warns []string, errs []error) {
 		"shipping",
 		"totalPrice",
 		"multiBuyLineItems",
-		"multiBuyCustomLineItems":
+		"multiBuyCustomLineItems",
+		"pattern":
+		return
+	default:
+		errs = append(errs, fmt.Errorf("%q not a valid value for %q", val, key))
+	}
+	return
+}
+
+func patternComponentElem() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"type": {
+				Description:  "Supports CountOnLineItemUnits or CountOnCustomLineItemUnits",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validatePatternComponentType,
+			},
+			"predicate": {
+				Description: "A valid [LineItem](https://docs.commercetools.com/api/projects/predicates#lineitem-field-identifiers) " +
+					"or [CustomLineItem](https://docs.commercetools.com/api/projects/predicates#customlineitem-field-identifiers) " +
+					"target predicate, depending on the type",
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"min_count": {
+				Description: "The minimum number of units that must match the predicate",
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     1,
+			},
+			"max_count": {
+				Description: "The maximum number of units that can match the predicate",
+				Type:        schema.TypeInt,
+				Optional:    true,
+			},
+			"exclude_count": {
+				Description: "The number of units that match the predicate but are excluded from the pattern",
+				Type:        schema.TypeInt,
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func validatePatternComponentType(val any, key string) (warns []string, errs []error) {
+	switch val {
+	case
+		"CountOnLineItemUnits",
+		"CountOnCustomLineItemUnits":
 		return
 	default:
 		errs = append(errs, fmt.Errorf("%q not a valid value for %q", val, key))
@@ -303,6 +386,7 @@ func resourceCartDiscountCreate(ctx context.Context, d *schema.ResourceData, m a
 		RequiresDiscountCode: ctutils.BoolRef(d.Get("requires_discount_code").(bool)),
 		Custom:               custom,
 		StackingMode:         &stackingMode,
+		Stores:               expandStoreKeys(d.Get("stores").(*schema.Set)),
 	}
 
 	key := stringRef(d.Get("key"))
@@ -331,10 +415,31 @@ func resourceCartDiscountCreate(ctx context.Context, d *schema.ResourceData, m a
 		draft.ValidUntil = &validUntil
 	}
 
-	var cartDiscount *platform.CartDiscount
+	// The cart discount is created with the raw client, since the SDK doesn't
+	// support discount groups
+	input := cartDiscountDraft{
+		CartDiscountDraft: draft,
+		DiscountGroup:     expandDiscountGroup(d),
+	}
+
+	var cartDiscount remoteCartDiscount
+	allocateSortOrder := d.Get("sort_order").(string) == ""
 	err = retry.RetryContext(ctx, 1*time.Minute, func() *retry.RetryError {
-		var err error
-		cartDiscount, err = client.CartDiscounts().Post(draft).Execute(ctx)
+		if allocateSortOrder {
+			lowest, err := lowestCartDiscountSortOrder(ctx, client)
+			if err != nil {
+				return utils.ProcessRemoteError(err)
+			}
+			if input.SortOrder, err = sortOrderBelow(lowest); err != nil {
+				return retry.NonRetryableError(err)
+			}
+		}
+
+		err := getRawClient(m).Do(ctx, http.MethodPost, "/cart-discounts", input, &cartDiscount)
+		if allocateSortOrder && isDuplicateSortOrderError(err) {
+			// Another discount got the same sort order in the meantime
+			return retry.RetryableError(err)
+		}
 		return utils.ProcessRemoteError(err)
 	})
 
@@ -342,10 +447,6 @@ func resourceCartDiscountCreate(ctx context.Context, d *schema.ResourceData, m a
 		return diag.FromErr(err)
 	}
 
-	if cartDiscount == nil {
-		return diag.Errorf("No cart discount created")
-	}
-
 	d.SetId(cartDiscount.ID)
 	_ = d.Set("version", cartDiscount.Version)
 
@@ -353,8 +454,11 @@ func resourceCartDiscountCreate(ctx context.Context, d *schema.ResourceData, m a
 }
 
 func resourceCartDiscountRead(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
-	client := getClient(m)
-	cartDiscount, err := client.CartDiscounts().WithId(d.Id()).Get().Execute(ctx)
+	// The cart discount is retrieved with the raw client, since the SDK drops
+	// the precise amount of high precision money in absolute values and
+	// doesn't support discount groups
+	var cartDiscount remoteCartDiscount
+	err := getRawClient(m).Do(ctx, http.MethodGet, "/cart-discounts/"+url.PathEscape(d.Id()), nil, &cartDiscount)
 	if err != nil {
 		if utils.IsResourceNotFoundError(err) {
 			d.SetId("")
@@ -367,7 +471,11 @@ func resourceCartDiscountRead(ctx context.Context, d *schema.ResourceData, m any
 	_ = d.Set("key", cartDiscount.Key)
 	_ = d.Set("name", cartDiscount.Name)
 	_ = d.Set("description", cartDiscount.Description)
-	_ = d.Set("value", flattenCartDiscountValue(cartDiscount.Value))
+	value, err := flattenCartDiscountValue(cartDiscount.Value, cartDiscount.Money)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	_ = d.Set("value", value)
 	_ = d.Set("predicate", cartDiscount.CartPredicate)
 	_ = d.Set("target", flattenCartDiscountTarget(cartDiscount.Target))
 	_ = d.Set("sort_order", cartDiscount.SortOrder)
@@ -376,6 +484,8 @@ func resourceCartDiscountRead(ctx context.Context, d *schema.ResourceData, m any
 	_ = d.Set("valid_until", flattenTime(cartDiscount.ValidUntil))
 	_ = d.Set("requires_discount_code", cartDiscount.RequiresDiscountCode)
 	_ = d.Set("stacking_mode", cartDiscount.StackingMode)
+	_ = d.Set("stores", flattenStoreKeys(cartDiscount.Stores))
+	_ = d.Set("discount_group_id", cartDiscount.DiscountGroupID)
 	_ = d.Set("custom", flattenCustomFields(cartDiscount.Custom))
 	return nil
 }
@@ -494,6 +604,16 @@ func resourceCartDiscountUpdate(ctx context.Context, d *schema.ResourceData, m a
 			&platform.CartDiscountChangeRequiresDiscountCodeAction{RequiresDiscountCode: newRequiresDiscountCode})
 	}
 
+	if d.HasChange("stores") {
+		input.Actions = append(input.Actions, expandCartDiscountStoreActions(d)...)
+	}
+
+	if d.HasChange("discount_group_id") {
+		input.Actions = append(
+			input.Actions,
+			cartDiscountSetDiscountGroupAction{DiscountGroup: expandDiscountGroup(d)})
+	}
+
 	if d.HasChange("stacking_mode") {
 		newStackingMode, err := expandCartDiscountStackingMode(d)
 		if err != nil {
@@ -543,30 +663,166 @@ func resourceCartDiscountDelete(ctx context.Context, d *schema.ResourceData, m a
 	return nil
 }
 
-func flattenCartDiscountValue(val platform.CartDiscountValue) []map[string]any {
+// remoteCartDiscount is a cart discount as returned by the API, including the
+// money of absolute and fixed values in high precision.
+type remoteCartDiscount struct {
+	platform.CartDiscount
+	Money           []platform.TypedMoney
+	DiscountGroupID string
+}
+
+func (r *remoteCartDiscount) UnmarshalJSON(data []byte) error {
+	if err := json.Unmarshal(data, &r.CartDiscount); err != nil {
+		return err
+	}
+
+	var extra struct {
+		Value struct {
+			Money []remoteMoney `json:"money"`
+		} `json:"value"`
+		Target struct {
+			Type string `json:"type"`
+			cartDiscountPatternTarget
+		} `json:"target"`
+		DiscountGroup *struct {
+			ID string `json:"id"`
+		} `json:"discountGroup"`
+	}
+	if err := json.Unmarshal(data, &extra); err != nil {
+		return err
+	}
+	r.Money = pie.Map(extra.Value.Money, remoteMoney.typed)
+	if extra.DiscountGroup != nil {
+		r.DiscountGroupID = extra.DiscountGroup.ID
+	}
+
+	// The SDK doesn't know the pattern target and leaves the target empty
+	if r.Target == nil && extra.Target.Type == "pattern" {
+		r.Target = extra.Target.cartDiscountPatternTarget
+	}
+	return nil
+}
+
+// cartDiscountDraft is the CartDiscountDraft of the SDK with the discount
+// group, which the SDK doesn't support yet.
+type cartDiscountDraft struct {
+	platform.CartDiscountDraft
+	DiscountGroup *discountGroupResourceIdentifier
+}
+
+func (obj cartDiscountDraft) MarshalJSON() ([]byte, error) {
+	data, err := json.Marshal(obj.CartDiscountDraft)
+	if err != nil || obj.DiscountGroup == nil {
+		return data, err
+	}
+
+	raw := make(map[string]any)
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return nil, err
+	}
+	raw["discountGroup"] = obj.DiscountGroup
+	return json.Marshal(raw)
+}
+
+type discountGroupResourceIdentifier struct {
+	ID string `json:"id"`
+}
+
+func (obj discountGroupResourceIdentifier) MarshalJSON() ([]byte, error) {
+	type Alias discountGroupResourceIdentifier
+	return json.Marshal(struct {
+		TypeID string `json:"typeId"`
+		*Alias
+	}{TypeID: "discount-group", Alias: (*Alias)(&obj)})
+}
+
+// cartDiscountSetDiscountGroupAction adds the cart discount to the discount
+// group, or removes it from its discount group when empty.
+type cartDiscountSetDiscountGroupAction struct {
+	DiscountGroup *discountGroupResourceIdentifier `json:"discountGroup,omitempty"`
+}
+
+func (obj cartDiscountSetDiscountGroupAction) MarshalJSON() ([]byte, error) {
+	type Alias cartDiscountSetDiscountGroupAction
+	return json.Marshal(struct {
+		Action string `json:"action"`
+		*Alias
+	}{Action: "setDiscountGroup", Alias: (*Alias)(&obj)})
+}
+
+func expandDiscountGroup(d *schema.ResourceData) *discountGroupResourceIdentifier {
+	id := d.Get("discount_group_id").(string)
+	if id == "" {
+		return nil
+	}
+	return &discountGroupResourceIdentifier{ID: id}
+}
+
+// expandCartDiscountStoreActions returns the actions to update the stores. The
+// stores are replaced when all stores are removed, otherwise the stores are
+// added and removed individually.
+func expandCartDiscountStoreActions(d *schema.ResourceData) []platform.CartDiscountUpdateAction {
+	oldValue, newValue := d.GetChange("stores")
+	oldStores := oldValue.(*schema.Set)
+	newStores := newValue.(*schema.Set)
+
+	if newStores.Len() == 0 || oldStores.Len() == 0 {
+		return []platform.CartDiscountUpdateAction{
+			&platform.CartDiscountSetStoresAction{Stores: expandStoreKeys(newStores)},
+		}
+	}
+
+	var result []platform.CartDiscountUpdateAction
+	for _, store := range expandStoreKeys(oldStores.Difference(newStores)) {
+		result = append(result, &platform.CartDiscountRemoveStoreAction{Store: store})
+	}
+	for _, store := range expandStoreKeys(newStores.Difference(oldStores)) {
+		result = append(result, &platform.CartDiscountAddStoreAction{Store: store})
+	}
+	return result
+}
+
+// cartDiscountValueAbsoluteDraft is the CartDiscountValueAbsoluteDraft of the
+// SDK with typed money, so the money can be set in high precision.
+type cartDiscountValueAbsoluteDraft struct {
+	Money []platform.TypedMoneyDraft `json:"money"`
+}
+
+func (obj cartDiscountValueAbsoluteDraft) MarshalJSON() ([]byte, error) {
+	type Alias cartDiscountValueAbsoluteDraft
+	return json.Marshal(struct {
+		Type string `json:"type"`
+		*Alias
+	}{Type: "absolute", Alias: (*Alias)(&obj)})
+}
+
+// flattenCartDiscountValue flattens the value. The money of absolute and fixed
+// values is passed separately, since the SDK drops the precise amount of high
+// precision money.
+func flattenCartDiscountValue(val platform.CartDiscountValue, money []platform.TypedMoney) ([]map[string]any, error) {
 	if val == nil {
-		return []map[string]any{}
+		return []map[string]any{}, nil
 	}
 
 	switch v := val.(type) {
 	case platform.CartDiscountValueAbsolute:
-		manyMoney := make([]map[string]any, len(v.Money))
-		for i, money := range v.Money {
-			manyMoney[i] = flattenTypedMoney(money)
+		manyMoney, err := flattenManyTypedMoney(money)
+		if err != nil {
+			return nil, err
 		}
 		return []map[string]any{{
 			"type":  "absolute",
 			"money": manyMoney,
-		}}
+		}}, nil
 	case platform.CartDiscountValueFixed:
-		manyMoney := make([]map[string]any, len(v.Money))
-		for i, money := range v.Money {
-			manyMoney[i] = flattenTypedMoney(money)
+		manyMoney, err := flattenManyTypedMoney(money)
+		if err != nil {
+			return nil, err
 		}
 		return []map[string]any{{
 			"type":  "fixed",
 			"money": manyMoney,
-		}}
+		}}, nil
 	case platform.CartDiscountValueGiftLineItem:
 		var supplyChannelID string
 		if v.SupplyChannel != nil {
@@ -584,14 +840,14 @@ func flattenCartDiscountValue(val platform.CartDiscountValue) []map[string]any {
 			"distribution_channel_id": distributionChannelID,
 			"product_id":              v.Product.ID,
 			"variant_id":              v.VariantId,
-		}}
+		}}, nil
 	case platform.CartDiscountValueRelative:
 		return []map[string]any{{
 			"type":      "relative",
 			"permyriad": v.Permyriad,
-		}}
+		}}, nil
 	}
-	panic("Unable to flatten cart discount value")
+	return nil, fmt.Errorf("unable to flatten cart discount value %T", val)
 }
 
 func expandCartDiscountValue(d *schema.ResourceData) (platform.CartDiscountValueDraft, error) {
@@ -602,12 +858,18 @@ func expandCartDiscountValue(d *schema.ResourceData) (platform.CartDiscountValue
 			Permyriad: value["permyriad"].(int),
 		}, nil
 	case "absolute":
-		money := expandTypedMoney(value)
-		return platform.CartDiscountValueAbsoluteDraft{
+		money, err := expandTypedMoneyDraft(value)
+		if err != nil {
+			return nil, err
+		}
+		return cartDiscountValueAbsoluteDraft{
 			Money: money,
 		}, nil
 	case "fixed":
-		money := expandTypedMoneyDraft(value)
+		money, err := expandTypedMoneyDraft(value)
+		if err != nil {
+			return nil, err
+		}
 		return platform.CartDiscountValueFixedDraft{
 			Money: money,
 		}, nil
@@ -674,6 +936,14 @@ func flattenCartDiscountTarget(val platform.CartDiscountTarget) []map[string]any
 		return []map[string]any{{
 			"type": "totalPrice",
 		}}
+	case cartDiscountPatternTarget:
+		return []map[string]any{{
+			"type":            "pattern",
+			"trigger_pattern": flattenPatternComponents(v.TriggerPattern),
+			"target_pattern":  flattenPatternComponents(v.TargetPattern),
+			"max_occurrence":  v.MaxOccurrence,
+			"selection_mode":  v.SelectionMode,
+		}}
 	}
 
 	panic("Unable to flatten cart discount target")
@@ -735,12 +1005,88 @@ func expandCartDiscountTarget(d *schema.ResourceData) (platform.CartDiscountTarg
 		return platform.CartDiscountShippingCostTarget{}, nil
 	case "totalPrice":
 		return platform.CartDiscountTotalPriceTarget{}, nil
+	case "pattern":
+		selectionMode, err := expandSelectionMode(input["selection_mode"].(string))
+		if err != nil {
+			return nil, err
+		}
+		target := cartDiscountPatternTarget{
+			TriggerPattern: expandPatternComponents(input["trigger_pattern"]),
+			TargetPattern:  expandPatternComponents(input["target_pattern"]),
+			SelectionMode:  selectionMode,
+		}
+		if len(target.TargetPattern) == 0 {
+			return nil, fmt.Errorf("target type pattern requires at least one target_pattern")
+		}
+		maxOccurrence := input["max_occurrence"].(int)
+		if maxOccurrence > 0 {
+			target.MaxOccurrence = &maxOccurrence
+		}
+		return target, nil
 	default:
 		return nil, fmt.Errorf("target type %s not implemented", input["type"])
 	}
 
 }
 
+// cartDiscountPatternTarget discounts the units matching the target pattern
+// when the units in the cart match the trigger pattern. The target is not
+// available in the SDK.
+type cartDiscountPatternTarget struct {
+	TriggerPattern []patternComponent     `json:"triggerPattern"`
+	TargetPattern  []patternComponent     `json:"targetPattern"`
+	MaxOccurrence  *int                   `json:"maxOccurrence,omitempty"`
+	SelectionMode  platform.SelectionMode `json:"selectionMode"`
+}
+
+func (obj cartDiscountPatternTarget) MarshalJSON() ([]byte, error) {
+	type Alias cartDiscountPatternTarget
+	return json.Marshal(struct {
+		Type string `json:"type"`
+		*Alias
+	}{Type: "pattern", Alias: (*Alias)(&obj)})
+}
+
+// patternComponent is a CountOnLineItemUnits or CountOnCustomLineItemUnits
+// component of a pattern target.
+type patternComponent struct {
+	Type         string `json:"type"`
+	Predicate    string `json:"predicate"`
+	MinCount     *int   `json:"minCount,omitempty"`
+	MaxCount     *int   `json:"maxCount,omitempty"`
+	ExcludeCount *int   `json:"excludeCount,omitempty"`
+}
+
+func expandPatternComponents(input any) []patternComponent {
+	items, _ := input.([]any)
+	result := make([]patternComponent, 0, len(items))
+	for _, raw := range items {
+		item := raw.(map[string]any)
+		result = append(result, patternComponent{
+			Type:         item["type"].(string),
+			Predicate:    item["predicate"].(string),
+			MinCount:     intNilIfEmpty(intRef(item["min_count"])),
+			MaxCount:     intNilIfEmpty(intRef(item["max_count"])),
+			ExcludeCount: intNilIfEmpty(intRef(item["exclude_count"])),
+		})
+	}
+	return result
+}
+
+func flattenPatternComponents(components []patternComponent) []map[string]any {
+	result := make([]map[string]any, len(components))
+	for i, c := range components {
+		result[i] = map[string]any{
+			"type":          c.Type,
+			"predicate":     c.Predicate,
+			"min_count":     c.MinCount,
+			"max_count":     c.MaxCount,
+			"exclude_count": c.ExcludeCount,
+		}
+	}
+	return result
+}
+
 func expandCartDiscountStackingMode(d *schema.ResourceData) (platform.StackingMode, error) {
 	switch d.Get("stacking_mode").(string) {
 	case "Stacking":
@@ -62,3 +62,59 @@ func testAccCartDiscountAbsoluteConfig(identifier string) string {
 		"identifier": identifier,
 	})
 }
+
+func TestAccCartDiscountAbsolute_highPrecision(t *testing.T) {
+	resourceName := "commercetools_cart_discount.absolute"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckCartDiscountDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCartDiscountAbsoluteHighPrecisionConfig(123456),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "value.0.money.0.currency_code", "EUR"),
+					resource.TestCheckResourceAttr(resourceName, "value.0.money.0.precise_amount", "123456"),
+					resource.TestCheckResourceAttr(resourceName, "value.0.money.0.fraction_digits", "4"),
+					resource.TestCheckResourceAttr(resourceName, "value.0.money.0.cent_amount", "1235"),
+				),
+			},
+			{
+				Config: testAccCartDiscountAbsoluteHighPrecisionConfig(123411),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "value.0.money.0.precise_amount", "123411"),
+					resource.TestCheckResourceAttr(resourceName, "value.0.money.0.cent_amount", "1234"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCartDiscountAbsoluteHighPrecisionConfig(preciseAmount int) string {
+	return hclTemplate(`
+		resource "commercetools_cart_discount" "absolute" {
+			name = {
+				en = "absolute name"
+			}
+			sort_order             = "0.9"
+			predicate              = "1=1"
+
+			target {
+				type      = "lineItems"
+				predicate = "1=1"
+			}
+
+			value {
+				type      = "absolute"
+				money {
+					currency_code   = "EUR"
+					precise_amount  = {{ .preciseAmount }}
+					fraction_digits = 4
+				}
+			}
+		}
+	`, map[string]any{
+		"preciseAmount": preciseAmount,
+	})
+}
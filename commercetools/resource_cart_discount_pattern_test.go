@@ -0,0 +1,135 @@
+package commercetools
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/labd/commercetools-go-sdk/platform"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAccCartDiscountPattern(t *testing.T) {
+	resourceName := "commercetools_cart_discount.pattern"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckCartDiscountDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCartDiscountPatternConfig(1),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "target.0.type", "pattern"),
+					resource.TestCheckResourceAttr(resourceName, "target.0.selection_mode", "Cheapest"),
+					resource.TestCheckResourceAttr(resourceName, "target.0.max_occurrence", "1"),
+					resource.TestCheckResourceAttr(resourceName, "target.0.trigger_pattern.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "target.0.trigger_pattern.0.type", "CountOnLineItemUnits"),
+					resource.TestCheckResourceAttr(resourceName, "target.0.trigger_pattern.0.predicate", "sku = \"shirt\""),
+					resource.TestCheckResourceAttr(resourceName, "target.0.trigger_pattern.0.min_count", "2"),
+					resource.TestCheckResourceAttr(resourceName, "target.0.trigger_pattern.1.min_count", "1"),
+					resource.TestCheckResourceAttr(resourceName, "target.0.target_pattern.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "target.0.target_pattern.0.predicate", "sku = \"trousers\""),
+					resource.TestCheckResourceAttr(resourceName, "target.0.target_pattern.0.max_count", "1"),
+				),
+			},
+			{
+				Config: testAccCartDiscountPatternConfig(2),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "target.0.type", "pattern"),
+					resource.TestCheckResourceAttr(resourceName, "target.0.max_occurrence", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCartDiscountPatternConfig(maxOccurrence int) string {
+	return hclTemplate(`
+		resource "commercetools_cart_discount" "pattern" {
+			name = {
+				en = "pattern name"
+			}
+			sort_order = "0.9"
+			predicate  = "1=1"
+
+			target {
+				type           = "pattern"
+				selection_mode = "Cheapest"
+				max_occurrence = {{ .maxOccurrence }}
+
+				trigger_pattern {
+					type      = "CountOnLineItemUnits"
+					predicate = "sku = \"shirt\""
+					min_count = 2
+				}
+
+				trigger_pattern {
+					type      = "CountOnLineItemUnits"
+					predicate = "sku = \"trousers\""
+				}
+
+				target_pattern {
+					type      = "CountOnLineItemUnits"
+					predicate = "sku = \"trousers\""
+					max_count = 1
+				}
+			}
+
+			value {
+				type      = "relative"
+				permyriad = 5000
+			}
+		}
+	`, map[string]any{
+		"maxOccurrence": maxOccurrence,
+	})
+}
+
+func TestCartDiscountPatternTarget(t *testing.T) {
+	data := `{
+		"type": "pattern",
+		"triggerPattern": [
+			{"type": "CountOnLineItemUnits", "predicate": "sku = \"shirt\"", "minCount": 2}
+		],
+		"targetPattern": [
+			{"type": "CountOnCustomLineItemUnits", "predicate": "1 = 1", "minCount": 1, "excludeCount": 1}
+		],
+		"maxOccurrence": 1,
+		"selectionMode": "MostExpensive"
+	}`
+
+	var cartDiscount remoteCartDiscount
+	err := json.Unmarshal([]byte(`{"id": "a1b2c3", "target": `+data+`}`), &cartDiscount)
+	require.NoError(t, err)
+
+	target, ok := cartDiscount.Target.(cartDiscountPatternTarget)
+	require.True(t, ok)
+	assert.Equal(t, platform.SelectionModeMostExpensive, target.SelectionMode)
+
+	result, err := json.Marshal(target)
+	require.NoError(t, err)
+	assert.JSONEq(t, data, string(result))
+
+	flattened := flattenCartDiscountTarget(target)
+	assert.Equal(t, "pattern", flattened[0]["type"])
+	assert.Equal(t, []map[string]any{{
+		"type":          "CountOnCustomLineItemUnits",
+		"predicate":     "1 = 1",
+		"min_count":     intRef(1),
+		"max_count":     (*int)(nil),
+		"exclude_count": intRef(1),
+	}}, flattened[0]["target_pattern"])
+
+	components := expandPatternComponents([]any{
+		map[string]any{
+			"type":          "CountOnLineItemUnits",
+			"predicate":     "sku = \"shirt\"",
+			"min_count":     2,
+			"max_count":     0,
+			"exclude_count": 0,
+		},
+	})
+	assert.Equal(t, target.TriggerPattern, components)
+}
@@ -0,0 +1,120 @@
+package commercetools
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/labd/commercetools-go-sdk/platform"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAccCartDiscountStores(t *testing.T) {
+	resourceName := "commercetools_cart_discount.stores"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckCartDiscountDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCartDiscountStoresConfig(`[commercetools_store.brand_a.key]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "stores.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "stores.*", "tf-acc-brand-a"),
+				),
+			},
+			{
+				Config: testAccCartDiscountStoresConfig(`[commercetools_store.brand_a.key, commercetools_store.brand_b.key]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "stores.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "stores.*", "tf-acc-brand-b"),
+				),
+			},
+			{
+				Config: testAccCartDiscountStoresConfig(`[commercetools_store.brand_b.key]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "stores.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "stores.*", "tf-acc-brand-b"),
+				),
+			},
+			{
+				Config: testAccCartDiscountStoresConfig(`[]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "stores.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCartDiscountStoresConfig(stores string) string {
+	return hclTemplate(`
+		resource "commercetools_store" "brand_a" {
+			key  = "tf-acc-brand-a"
+			name = {
+				en = "Brand A"
+			}
+		}
+
+		resource "commercetools_store" "brand_b" {
+			key  = "tf-acc-brand-b"
+			name = {
+				en = "Brand B"
+			}
+		}
+
+		resource "commercetools_cart_discount" "stores" {
+			name = {
+				en = "stores name"
+			}
+			sort_order = "0.62"
+			predicate  = "1=1"
+			stores     = {{ .stores }}
+
+			target {
+				type      = "lineItems"
+				predicate = "1=1"
+			}
+
+			value {
+				type      = "relative"
+				permyriad = 1000
+			}
+		}
+	`, map[string]any{
+		"stores": stores,
+	})
+}
+
+func TestCartDiscountDraftDiscountGroup(t *testing.T) {
+	key := "brand-a"
+	draft := cartDiscountDraft{
+		CartDiscountDraft: platform.CartDiscountDraft{
+			Name:          platform.LocalizedString{"en": "name"},
+			CartPredicate: "1=1",
+			SortOrder:     "0.5",
+			Stores:        []platform.StoreResourceIdentifier{{Key: &key}},
+		},
+		DiscountGroup: &discountGroupResourceIdentifier{ID: "group-id"},
+	}
+
+	data, err := json.Marshal(draft)
+	require.NoError(t, err)
+
+	var result map[string]any
+	require.NoError(t, json.Unmarshal(data, &result))
+	assert.Equal(t, map[string]any{"typeId": "discount-group", "id": "group-id"}, result["discountGroup"])
+	assert.Equal(t, []any{map[string]any{"typeId": "store", "key": "brand-a"}}, result["stores"])
+
+	var cartDiscount remoteCartDiscount
+	err = json.Unmarshal([]byte(`{
+		"id": "a1b2c3",
+		"stores": [{"typeId": "store", "key": "brand-a"}],
+		"discountGroup": {"typeId": "discount-group", "id": "group-id"}
+	}`), &cartDiscount)
+	require.NoError(t, err)
+	assert.Equal(t, "group-id", cartDiscount.DiscountGroupID)
+	assert.Equal(t, []string{"brand-a"}, flattenStoreKeys(cartDiscount.Stores))
+}
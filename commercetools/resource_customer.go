@@ -0,0 +1,498 @@
+package commercetools
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/labd/commercetools-go-sdk/platform"
+
+	"github.com/labd/terraform-provider-commercetools/internal/utils"
+)
+
+func resourceCustomer() *schema.Resource {
+	return &schema.Resource{
+		Description: "A Customer is a person purchasing or planning to purchase your products. This resource is " +
+			"intended to manage test fixtures such as B2B buyers or customers with predefined addresses. " +
+			"Email verification and password resets are not handled by this resource.\n\n" +
+			"See also the [Customers API Documentation](https://docs.commercetools.com/api/projects/customers)",
+		CreateContext: resourceCustomerCreate,
+		ReadContext:   resourceCustomerRead,
+		UpdateContext: resourceCustomerUpdate,
+		DeleteContext: resourceCustomerDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		CustomizeDiff: resourceCustomerValidate,
+		Schema: map[string]*schema.Schema{
+			"key": {
+				Description: "User-defined unique identifier for the Customer",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"version": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"email": {
+				Description: "Email address of the Customer. Must be unique for the project or the stores the " +
+					"customer is assigned to",
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"password": {
+				Description: "Password of the Customer. The password is only sent when the customer is created, " +
+					"later changes are ignored. The password is kept in the Terraform state, so make sure the " +
+					"state is stored securely. When no password is given the customer is created with the " +
+					"`ExternalAuth` authentication mode",
+				Type:      schema.TypeString,
+				Optional:  true,
+				Sensitive: true,
+				DiffSuppressFunc: func(_, _, _ string, d *schema.ResourceData) bool {
+					return d.Id() != ""
+				},
+			},
+			"customer_number": {
+				Description: "User-defined unique identifier for the Customer. Once set it cannot be changed",
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+			},
+			"external_id": {
+				Description: "Optional identifier for use in external systems like CRM or ERP",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"first_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"middle_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"last_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"title": {
+				Description: "Title of the Customer, for example 'Dr.'",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"salutation": {
+				Description: "Salutation of the Customer, for example 'Mr.' or 'Mrs.'",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"company_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"vat_id": {
+				Description: "Individual VAT ID of the Customer",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"is_email_verified": {
+				Description: "Whether the email address of the Customer is verified. This value can only be " +
+					"set when the customer is created, changing it recreates the customer",
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+				ForceNew: true,
+			},
+			"address": customerAddressSchema(),
+			"default_shipping_address_key": {
+				Description: "Key of the address to use as the default shipping address",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"default_billing_address_key": {
+				Description: "Key of the address to use as the default billing address",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"customer_group_id": {
+				Description: "ID of the customer group the Customer belongs to",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"stores": {
+				Description: "Keys of the stores the Customer is assigned to. If empty the Customer is a " +
+					"global customer",
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"custom": CustomFieldSchema(),
+		},
+	}
+}
+
+// customerAddressSchema returns the address schema with support for multiple
+// addresses. The key is required to be able to track address changes and to
+// select the default shipping and billing address.
+func customerAddressSchema() *schema.Schema {
+	s := AddressFieldSchema()
+	s.MaxItems = 0
+	s.Description = "Addresses of the Customer. Each address requires a unique key"
+
+	key := s.Elem.(*schema.Resource).Schema["key"]
+	key.Optional = false
+	key.Required = true
+	return s
+}
+
+func resourceCustomerValidate(_ context.Context, d *schema.ResourceDiff, _ any) error {
+	keys := map[string]bool{}
+	for _, raw := range d.Get("address").([]any) {
+		address, ok := raw.(map[string]any)
+		if !ok {
+			continue
+		}
+		key := address["key"].(string)
+		if key == "" {
+			continue
+		}
+		if keys[key] {
+			return fmt.Errorf("address key %q is used multiple times", key)
+		}
+		keys[key] = true
+	}
+
+	for _, field := range []string{"default_shipping_address_key", "default_billing_address_key"} {
+		if !d.NewValueKnown(field) || !d.NewValueKnown("address") {
+			continue
+		}
+		key := d.Get(field).(string)
+		if key != "" && !keys[key] {
+			return fmt.Errorf("%s %q does not match any address key", field, key)
+		}
+	}
+	return nil
+}
+
+func resourceCustomerCreate(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	client := getClient(m)
+
+	custom, err := CreateCustomFieldDraft(ctx, client, d)
+	if err != nil {
+		// Workaround invalid state to be written, see
+		// https://github.com/hashicorp/terraform-plugin-sdk/issues/476
+		d.Partial(true)
+		return diag.FromErr(err)
+	}
+
+	addresses := expandCustomerAddresses(d.Get("address").([]any))
+
+	draft := platform.CustomerDraft{
+		Key:             nilIfEmpty(stringRef(d.Get("key"))),
+		CustomerNumber:  nilIfEmpty(stringRef(d.Get("customer_number"))),
+		ExternalId:      nilIfEmpty(stringRef(d.Get("external_id"))),
+		Email:           d.Get("email").(string),
+		FirstName:       nilIfEmpty(stringRef(d.Get("first_name"))),
+		MiddleName:      nilIfEmpty(stringRef(d.Get("middle_name"))),
+		LastName:        nilIfEmpty(stringRef(d.Get("last_name"))),
+		Title:           nilIfEmpty(stringRef(d.Get("title"))),
+		Salutation:      nilIfEmpty(stringRef(d.Get("salutation"))),
+		CompanyName:     nilIfEmpty(stringRef(d.Get("company_name"))),
+		VatId:           nilIfEmpty(stringRef(d.Get("vat_id"))),
+		IsEmailVerified: boolRef(d.Get("is_email_verified")),
+		Addresses:       addresses,
+		Stores:          expandStoreKeys(d.Get("stores").(*schema.Set)),
+		Custom:          custom,
+	}
+
+	authenticationMode := platform.AuthenticationModeExternalAuth
+	if password := nilIfEmpty(stringRef(d.Get("password"))); password != nil {
+		draft.Password = password
+		authenticationMode = platform.AuthenticationModePassword
+	}
+	draft.AuthenticationMode = &authenticationMode
+
+	if key := d.Get("default_shipping_address_key").(string); key != "" {
+		draft.DefaultShippingAddress = customerAddressIndex(addresses, key)
+	}
+	if key := d.Get("default_billing_address_key").(string); key != "" {
+		draft.DefaultBillingAddress = customerAddressIndex(addresses, key)
+	}
+	if id := d.Get("customer_group_id").(string); id != "" {
+		draft.CustomerGroup = &platform.CustomerGroupResourceIdentifier{ID: &id}
+	}
+
+	var result *platform.CustomerSignInResult
+	err = retry.RetryContext(ctx, 20*time.Second, func() *retry.RetryError {
+		var err error
+		result, err = client.Customers().Post(draft).Execute(ctx)
+		return utils.ProcessRemoteError(err)
+	})
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(result.Customer.ID)
+	_ = d.Set("version", result.Customer.Version)
+	return resourceCustomerRead(ctx, d, m)
+}
+
+func resourceCustomerRead(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	client := getClient(m)
+	customer, err := client.Customers().WithId(d.Id()).Get().Execute(ctx)
+	if err != nil {
+		if utils.IsResourceNotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	d.SetId(customer.ID)
+	_ = d.Set("version", customer.Version)
+	_ = d.Set("key", customer.Key)
+	_ = d.Set("email", customer.Email)
+	_ = d.Set("customer_number", customer.CustomerNumber)
+	_ = d.Set("external_id", customer.ExternalId)
+	_ = d.Set("first_name", customer.FirstName)
+	_ = d.Set("middle_name", customer.MiddleName)
+	_ = d.Set("last_name", customer.LastName)
+	_ = d.Set("title", customer.Title)
+	_ = d.Set("salutation", customer.Salutation)
+	_ = d.Set("company_name", customer.CompanyName)
+	_ = d.Set("vat_id", customer.VatId)
+	_ = d.Set("is_email_verified", customer.IsEmailVerified)
+	_ = d.Set("address", flattenCustomerAddresses(customer.Addresses))
+	_ = d.Set("default_shipping_address_key", customerAddressKey(customer.Addresses, customer.DefaultShippingAddressId))
+	_ = d.Set("default_billing_address_key", customerAddressKey(customer.Addresses, customer.DefaultBillingAddressId))
+	if customer.CustomerGroup != nil {
+		_ = d.Set("customer_group_id", customer.CustomerGroup.ID)
+	} else {
+		_ = d.Set("customer_group_id", "")
+	}
+	_ = d.Set("stores", flattenStoreKeys(customer.Stores))
+	_ = d.Set("custom", flattenCustomFields(customer.Custom))
+	return nil
+}
+
+func resourceCustomerUpdate(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	client := getClient(m)
+
+	input := platform.CustomerUpdate{
+		Version: d.Get("version").(int),
+		Actions: []platform.CustomerUpdateAction{},
+	}
+
+	if d.HasChange("key") {
+		newKey := d.Get("key").(string)
+		input.Actions = append(
+			input.Actions,
+			&platform.CustomerSetKeyAction{Key: nilIfEmpty(&newKey)})
+	}
+
+	if d.HasChange("email") {
+		input.Actions = append(
+			input.Actions,
+			&platform.CustomerChangeEmailAction{Email: d.Get("email").(string)})
+	}
+
+	if d.HasChange("external_id") {
+		input.Actions = append(
+			input.Actions,
+			&platform.CustomerSetExternalIdAction{ExternalId: nilIfEmpty(stringRef(d.Get("external_id")))})
+	}
+
+	if d.HasChange("first_name") {
+		input.Actions = append(
+			input.Actions,
+			&platform.CustomerSetFirstNameAction{FirstName: nilIfEmpty(stringRef(d.Get("first_name")))})
+	}
+
+	if d.HasChange("middle_name") {
+		input.Actions = append(
+			input.Actions,
+			&platform.CustomerSetMiddleNameAction{MiddleName: nilIfEmpty(stringRef(d.Get("middle_name")))})
+	}
+
+	if d.HasChange("last_name") {
+		input.Actions = append(
+			input.Actions,
+			&platform.CustomerSetLastNameAction{LastName: nilIfEmpty(stringRef(d.Get("last_name")))})
+	}
+
+	if d.HasChange("title") {
+		input.Actions = append(
+			input.Actions,
+			&platform.CustomerSetTitleAction{Title: nilIfEmpty(stringRef(d.Get("title")))})
+	}
+
+	if d.HasChange("salutation") {
+		input.Actions = append(
+			input.Actions,
+			&platform.CustomerSetSalutationAction{Salutation: nilIfEmpty(stringRef(d.Get("salutation")))})
+	}
+
+	if d.HasChange("company_name") {
+		input.Actions = append(
+			input.Actions,
+			&platform.CustomerSetCompanyNameAction{CompanyName: nilIfEmpty(stringRef(d.Get("company_name")))})
+	}
+
+	if d.HasChange("vat_id") {
+		input.Actions = append(
+			input.Actions,
+			&platform.CustomerSetVatIdAction{VatId: nilIfEmpty(stringRef(d.Get("vat_id")))})
+	}
+
+	if d.HasChange("address") {
+		o, n := d.GetChange("address")
+		actions := customerAddressUpdateActions(o.([]any), n.([]any))
+		input.Actions = append(input.Actions, actions...)
+	}
+
+	if d.HasChanges("address", "default_shipping_address_key") {
+		input.Actions = append(
+			input.Actions,
+			&platform.CustomerSetDefaultShippingAddressAction{
+				AddressKey: nilIfEmpty(stringRef(d.Get("default_shipping_address_key"))),
+			})
+	}
+
+	if d.HasChanges("address", "default_billing_address_key") {
+		input.Actions = append(
+			input.Actions,
+			&platform.CustomerSetDefaultBillingAddressAction{
+				AddressKey: nilIfEmpty(stringRef(d.Get("default_billing_address_key"))),
+			})
+	}
+
+	if d.HasChange("customer_group_id") {
+		action := &platform.CustomerSetCustomerGroupAction{}
+		if id := d.Get("customer_group_id").(string); id != "" {
+			action.CustomerGroup = &platform.CustomerGroupResourceIdentifier{ID: &id}
+		}
+		input.Actions = append(input.Actions, action)
+	}
+
+	if d.HasChange("stores") {
+		input.Actions = append(
+			input.Actions,
+			&platform.CustomerSetStoresAction{
+				Stores: expandStoreKeys(d.Get("stores").(*schema.Set)),
+			})
+	}
+
+	if d.HasChange("custom") {
+		actions, err := CustomFieldUpdateActions[platform.CustomerSetCustomTypeAction, platform.CustomerSetCustomFieldAction](ctx, client, d)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		for i := range actions {
+			input.Actions = append(input.Actions, actions[i].(platform.CustomerUpdateAction))
+		}
+	}
+
+	err := retry.RetryContext(ctx, 20*time.Second, func() *retry.RetryError {
+		_, err := client.Customers().WithId(d.Id()).Post(input).Execute(ctx)
+		return utils.ProcessRemoteError(err)
+	})
+	if err != nil {
+		// Workaround invalid state to be written, see
+		// https://github.com/hashicorp/terraform-plugin-sdk/issues/476
+		d.Partial(true)
+		return diag.FromErr(err)
+	}
+
+	return resourceCustomerRead(ctx, d, m)
+}
+
+func resourceCustomerDelete(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	client := getClient(m)
+	version := d.Get("version").(int)
+	err := retry.RetryContext(ctx, 20*time.Second, func() *retry.RetryError {
+		_, err := client.Customers().WithId(d.Id()).Delete().Version(version).Execute(ctx)
+		return utils.ProcessRemoteError(err)
+	})
+	return diag.FromErr(err)
+}
+
+func expandCustomerAddresses(input []any) []platform.BaseAddress {
+	result := make([]platform.BaseAddress, 0, len(input))
+	for _, raw := range input {
+		if address := CreateAddressFieldDraftRaw(raw.(map[string]any)); address != nil {
+			result = append(result, *address)
+		}
+	}
+	return result
+}
+
+func flattenCustomerAddresses(addresses []platform.Address) []map[string]any {
+	result := make([]map[string]any, 0, len(addresses))
+	for i := range addresses {
+		result = append(result, flattenAddress(&addresses[i])...)
+	}
+	return result
+}
+
+// customerAddressIndex returns the position of the address with the given key,
+// which is how the CustomerDraft references default addresses.
+func customerAddressIndex(addresses []platform.BaseAddress, key string) *int {
+	for i := range addresses {
+		if addresses[i].Key != nil && *addresses[i].Key == key {
+			return intRef(i)
+		}
+	}
+	return nil
+}
+
+// customerAddressKey returns the key of the address with the given id
+func customerAddressKey(addresses []platform.Address, id *string) string {
+	if id == nil {
+		return ""
+	}
+	for _, address := range addresses {
+		if address.ID != nil && *address.ID == *id && address.Key != nil {
+			return *address.Key
+		}
+	}
+	return ""
+}
+
+// customerAddressUpdateActions compares the addresses by key and returns the
+// actions to remove, change and add addresses.
+func customerAddressUpdateActions(old, new []any) []platform.CustomerUpdateAction {
+	oldLookup := createLookup(old, "key")
+	newLookup := createLookup(new, "key")
+
+	var result []platform.CustomerUpdateAction
+	for _, raw := range old {
+		key := raw.(map[string]any)["key"].(string)
+		if _, exists := newLookup[key]; !exists {
+			result = append(result, &platform.CustomerRemoveAddressAction{AddressKey: &key})
+		}
+	}
+
+	for _, raw := range new {
+		data := raw.(map[string]any)
+		key := data["key"].(string)
+		address := CreateAddressFieldDraftRaw(data)
+
+		current, exists := oldLookup[key]
+		if !exists {
+			result = append(result, &platform.CustomerAddAddressAction{Address: *address})
+			continue
+		}
+
+		if !reflect.DeepEqual(CreateAddressFieldDraftRaw(current.(map[string]any)), address) {
+			result = append(result, &platform.CustomerChangeAddressAction{
+				AddressKey: &key,
+				Address:    *address,
+			})
+		}
+	}
+	return result
+}
@@ -0,0 +1,173 @@
+package commercetools
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/labd/commercetools-go-sdk/platform"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCustomerAddressUpdateActions(t *testing.T) {
+	old := []any{
+		map[string]any{"key": "home", "country": "NL", "city": "Utrecht"},
+		map[string]any{"key": "office", "country": "NL", "city": "Amsterdam"},
+	}
+	new := []any{
+		map[string]any{"key": "home", "country": "NL", "city": "Rotterdam"},
+		map[string]any{"key": "warehouse", "country": "DE", "city": "Berlin"},
+	}
+
+	actions := customerAddressUpdateActions(old, new)
+	assert.EqualValues(t, []platform.CustomerUpdateAction{
+		&platform.CustomerRemoveAddressAction{AddressKey: stringRef("office")},
+		&platform.CustomerChangeAddressAction{
+			AddressKey: stringRef("home"),
+			Address: platform.BaseAddress{
+				Key:     stringRef("home"),
+				Country: "NL",
+				City:    stringRef("Rotterdam"),
+			},
+		},
+		&platform.CustomerAddAddressAction{
+			Address: platform.BaseAddress{
+				Key:     stringRef("warehouse"),
+				Country: "DE",
+				City:    stringRef("Berlin"),
+			},
+		},
+	}, actions)
+}
+
+func TestCustomerAddressKey(t *testing.T) {
+	addresses := []platform.Address{
+		{ID: stringRef("a1"), Key: stringRef("home"), Country: "NL"},
+		{ID: stringRef("a2"), Key: stringRef("office"), Country: "NL"},
+	}
+
+	assert.Equal(t, "office", customerAddressKey(addresses, stringRef("a2")))
+	assert.Equal(t, "", customerAddressKey(addresses, stringRef("a3")))
+	assert.Equal(t, "", customerAddressKey(addresses, nil))
+	assert.Equal(t, intRef(1), customerAddressIndex(expandCustomerAddresses([]any{
+		map[string]any{"key": "home", "country": "NL"},
+		map[string]any{"key": "office", "country": "NL"},
+	}), "office"))
+}
+
+func TestAccCustomer_basic(t *testing.T) {
+	resourceName := "commercetools_customer.buyer"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckCustomerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCustomerConfig("Rotterdam"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "email", "buyer@example.com"),
+					resource.TestCheckResourceAttr(resourceName, "key", "b2b-buyer"),
+					resource.TestCheckResourceAttr(resourceName, "address.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "default_shipping_address_key", "warehouse"),
+					resource.TestCheckResourceAttr(resourceName, "default_billing_address_key", "office"),
+					resource.TestCheckResourceAttr(resourceName, "is_email_verified", "true"),
+					resource.TestCheckResourceAttrPair(resourceName, "customer_group_id", "commercetools_customer_group.buyers", "id"),
+					func(s *terraform.State) error {
+						result, err := testGetCustomer(s, resourceName)
+						if err != nil {
+							return err
+						}
+						assert.NotNil(t, result.CustomerGroup)
+						assert.EqualValues(t, platform.AuthenticationModePassword, result.AuthenticationMode)
+						assert.Len(t, result.Addresses, 2)
+						return nil
+					},
+				),
+			},
+			{
+				Config: testAccCustomerConfig("Utrecht"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "address.0.city", "Utrecht"),
+					resource.TestCheckResourceAttr(resourceName, "default_shipping_address_key", "warehouse"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCustomerConfig(city string) string {
+	return hclTemplate(`
+		resource "commercetools_customer_group" "buyers" {
+			key  = "b2b-buyers"
+			name = "B2B buyers"
+		}
+
+		resource "commercetools_customer" "buyer" {
+			key               = "b2b-buyer"
+			email             = "buyer@example.com"
+			password          = "s3cr3t-p4ssw0rd"
+			first_name        = "Jane"
+			last_name         = "Doe"
+			company_name      = "Lab Digital"
+			is_email_verified = true
+			customer_group_id = commercetools_customer_group.buyers.id
+
+			address {
+				key         = "warehouse"
+				country     = "NL"
+				city        = "{{ .city }}"
+				street_name = "Reykjavikstraat"
+			}
+
+			address {
+				key         = "office"
+				country     = "NL"
+				city        = "Utrecht"
+				postal_code = "3543 KH"
+			}
+
+			default_shipping_address_key = "warehouse"
+			default_billing_address_key  = "office"
+		}`,
+		map[string]any{
+			"city": city,
+		})
+}
+
+func testAccCheckCustomerDestroy(s *terraform.State) error {
+	client := getClient(testAccProvider.Meta())
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "commercetools_customer" {
+			continue
+		}
+		response, err := client.Customers().WithId(rs.Primary.ID).Get().Execute(context.Background())
+		if err == nil {
+			if response != nil && response.ID == rs.Primary.ID {
+				return fmt.Errorf("customer (%s) still exists", rs.Primary.ID)
+			}
+			return nil
+		}
+		if newErr := checkApiResult(err); newErr != nil {
+			return newErr
+		}
+	}
+	return nil
+}
+
+func testGetCustomer(s *terraform.State, identifier string) (*platform.Customer, error) {
+	rs, ok := s.RootModule().Resources[identifier]
+	if !ok {
+		return nil, fmt.Errorf("Customer not found")
+	}
+
+	client := getClient(testAccProvider.Meta())
+	result, err := client.Customers().WithId(rs.Primary.ID).Get().Execute(context.Background())
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
@@ -0,0 +1,257 @@
+package commercetools
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/labd/commercetools-go-sdk/platform"
+
+	"github.com/labd/terraform-provider-commercetools/internal/utils"
+)
+
+func resourceInventoryEntry() *schema.Resource {
+	return &schema.Resource{
+		Description: "Inventory allows you to track stock quantities per SKU and optionally per supply channel.\n\n" +
+			"Changes to the quantity on stock are applied as a delta (addQuantity/removeQuantity) to the " +
+			"current stock, so stock changes caused by orders placed in the meantime are not overwritten.\n\n" +
+			"See also the [Inventory API Documentation](https://docs.commercetools.com/api/projects/inventory)",
+		CreateContext: resourceInventoryEntryCreate,
+		ReadContext:   resourceInventoryEntryRead,
+		UpdateContext: resourceInventoryEntryUpdate,
+		DeleteContext: resourceInventoryEntryDelete,
+		CustomizeDiff: validateChannelRoles(
+			channelRole{field: "supply_channel_id", role: platform.ChannelRoleEnumInventorySupply},
+		),
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"key": {
+				Description: "User-defined unique identifier for the InventoryEntry",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"version": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"sku": {
+				Description: "ProductVariant sku of the InventoryEntry",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"supply_channel_id": {
+				Description: "ID of a channel with the `InventorySupply` role, which is validated during plan. " +
+					"When the roles of the channel are changed in the same apply, refer to the " +
+					"`commercetools_channel` resource so the validation is left to the API",
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"quantity_on_stock": {
+				Description: "Overall amount of stock",
+				Type:        schema.TypeInt,
+				Required:    true,
+			},
+			"available_quantity": {
+				Description: "Available amount of stock, this is the quantity on stock minus the reserved quantity",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+			"restockable_in_days": {
+				Description:  "How often the InventoryEntry is restocked (in days)",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"expected_delivery": {
+				Description:      "Date and time of the next restock",
+				Type:             schema.TypeString,
+				Optional:         true,
+				DiffSuppressFunc: diffSuppressDateString,
+				ValidateFunc:     validation.IsRFC3339Time,
+			},
+			"custom": CustomFieldSchema(),
+		},
+	}
+}
+
+func resourceInventoryEntryCreate(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	client := getClient(m)
+
+	custom, err := CreateCustomFieldDraft(ctx, client, d)
+	if err != nil {
+		// Workaround invalid state to be written, see
+		// https://github.com/hashicorp/terraform-plugin-sdk/issues/476
+		d.Partial(true)
+		return diag.FromErr(err)
+	}
+
+	draft := platform.InventoryEntryDraft{
+		Key:             nilIfEmpty(stringRef(d.Get("key"))),
+		Sku:             d.Get("sku").(string),
+		QuantityOnStock: d.Get("quantity_on_stock").(int),
+		Custom:          custom,
+	}
+
+	if id := d.Get("supply_channel_id").(string); id != "" {
+		draft.SupplyChannel = &platform.ChannelResourceIdentifier{ID: &id}
+	}
+
+	if val, ok := d.GetOk("restockable_in_days"); ok {
+		draft.RestockableInDays = intRef(val)
+	}
+
+	if val := d.Get("expected_delivery").(string); val != "" {
+		expectedDelivery, err := expandTime(val)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		draft.ExpectedDelivery = &expectedDelivery
+	}
+
+	var entry *platform.InventoryEntry
+	err = retry.RetryContext(ctx, 20*time.Second, func() *retry.RetryError {
+		var err error
+		entry, err = client.Inventory().Post(draft).Execute(ctx)
+		return utils.ProcessRemoteError(err)
+	})
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(entry.ID)
+	_ = d.Set("version", entry.Version)
+	return resourceInventoryEntryRead(ctx, d, m)
+}
+
+func resourceInventoryEntryRead(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	client := getClient(m)
+	entry, err := client.Inventory().WithId(d.Id()).Get().Execute(ctx)
+	if err != nil {
+		if utils.IsResourceNotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	d.SetId(entry.ID)
+	_ = d.Set("version", entry.Version)
+	_ = d.Set("key", entry.Key)
+	_ = d.Set("sku", entry.Sku)
+	_ = d.Set("quantity_on_stock", entry.QuantityOnStock)
+	_ = d.Set("available_quantity", entry.AvailableQuantity)
+	_ = d.Set("restockable_in_days", entry.RestockableInDays)
+	_ = d.Set("expected_delivery", flattenTime(entry.ExpectedDelivery))
+	if entry.SupplyChannel != nil {
+		_ = d.Set("supply_channel_id", entry.SupplyChannel.ID)
+	} else {
+		_ = d.Set("supply_channel_id", "")
+	}
+	_ = d.Set("custom", flattenCustomFields(entry.Custom))
+	return nil
+}
+
+func resourceInventoryEntryUpdate(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	client := getClient(m)
+
+	input := platform.InventoryEntryUpdate{
+		Version: d.Get("version").(int),
+		Actions: []platform.InventoryEntryUpdateAction{},
+	}
+
+	if d.HasChange("key") {
+		newKey := d.Get("key").(string)
+		input.Actions = append(
+			input.Actions,
+			&platform.InventoryEntrySetKeyAction{Key: nilIfEmpty(&newKey)})
+	}
+
+	if d.HasChange("supply_channel_id") {
+		action := &platform.InventoryEntrySetSupplyChannelAction{}
+		if id := d.Get("supply_channel_id").(string); id != "" {
+			action.SupplyChannel = &platform.ChannelResourceIdentifier{ID: &id}
+		}
+		input.Actions = append(input.Actions, action)
+	}
+
+	if d.HasChange("quantity_on_stock") {
+		o, n := d.GetChange("quantity_on_stock")
+		if action := inventoryQuantityAction(o.(int), n.(int)); action != nil {
+			input.Actions = append(input.Actions, action)
+		}
+	}
+
+	if d.HasChange("restockable_in_days") {
+		action := &platform.InventoryEntrySetRestockableInDaysAction{}
+		if val, ok := d.GetOk("restockable_in_days"); ok {
+			action.RestockableInDays = intRef(val)
+		}
+		input.Actions = append(input.Actions, action)
+	}
+
+	if d.HasChange("expected_delivery") {
+		action := &platform.InventoryEntrySetExpectedDeliveryAction{}
+		if val := d.Get("expected_delivery").(string); val != "" {
+			expectedDelivery, err := expandTime(val)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			action.ExpectedDelivery = &expectedDelivery
+		}
+		input.Actions = append(input.Actions, action)
+	}
+
+	if d.HasChange("custom") {
+		actions, err := CustomFieldUpdateActions[platform.InventoryEntrySetCustomTypeAction, platform.InventoryEntrySetCustomFieldAction](ctx, client, d)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		for i := range actions {
+			input.Actions = append(input.Actions, actions[i].(platform.InventoryEntryUpdateAction))
+		}
+	}
+
+	err := retry.RetryContext(ctx, 20*time.Second, func() *retry.RetryError {
+		_, err := client.Inventory().WithId(d.Id()).Post(input).Execute(ctx)
+		return utils.ProcessRemoteError(err)
+	})
+	if err != nil {
+		// Workaround invalid state to be written, see
+		// https://github.com/hashicorp/terraform-plugin-sdk/issues/476
+		d.Partial(true)
+		return diag.FromErr(err)
+	}
+
+	return resourceInventoryEntryRead(ctx, d, m)
+}
+
+func resourceInventoryEntryDelete(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
+	client := getClient(m)
+	version := d.Get("version").(int)
+	err := retry.RetryContext(ctx, 20*time.Second, func() *retry.RetryError {
+		_, err := client.Inventory().WithId(d.Id()).Delete().Version(version).Execute(ctx)
+		return utils.ProcessRemoteError(err)
+	})
+	return diag.FromErr(err)
+}
+
+// inventoryQuantityAction returns the action to move the quantity on stock
+// from the current (live) value to the new value. We use a relative change
+// instead of changeQuantity so stock movements caused by orders placed between
+// the refresh and the apply are preserved.
+func inventoryQuantityAction(current, new int) platform.InventoryEntryUpdateAction {
+	switch delta := new - current; {
+	case delta > 0:
+		return &platform.InventoryEntryAddQuantityAction{Quantity: delta}
+	case delta < 0:
+		return &platform.InventoryEntryRemoveQuantityAction{Quantity: -delta}
+	}
+	return nil
+}
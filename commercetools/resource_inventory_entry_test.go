@@ -0,0 +1,123 @@
+package commercetools
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/labd/commercetools-go-sdk/platform"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInventoryQuantityAction(t *testing.T) {
+	cases := []struct {
+		current  int
+		new      int
+		expected platform.InventoryEntryUpdateAction
+	}{
+		{10, 15, &platform.InventoryEntryAddQuantityAction{Quantity: 5}},
+		{10, 3, &platform.InventoryEntryRemoveQuantityAction{Quantity: 7}},
+		{10, 10, nil},
+	}
+
+	for _, c := range cases {
+		t.Run(fmt.Sprintf("%d to %d", c.current, c.new), func(t *testing.T) {
+			assert.Equal(t, c.expected, inventoryQuantityAction(c.current, c.new))
+		})
+	}
+}
+
+func TestAccInventoryEntry_basic(t *testing.T) {
+	resourceName := "commercetools_inventory_entry.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckInventoryEntryDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInventoryEntryConfig(100, 3),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "sku", "1001"),
+					resource.TestCheckResourceAttr(resourceName, "quantity_on_stock", "100"),
+					resource.TestCheckResourceAttr(resourceName, "restockable_in_days", "3"),
+					resource.TestCheckResourceAttrPair(resourceName, "supply_channel_id", "commercetools_channel.warehouse", "id"),
+				),
+			},
+			{
+				Config: testAccInventoryEntryConfig(40, 5),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "quantity_on_stock", "40"),
+					resource.TestCheckResourceAttr(resourceName, "restockable_in_days", "5"),
+					func(s *terraform.State) error {
+						result, err := testGetInventoryEntry(s, resourceName)
+						if err != nil {
+							return err
+						}
+						assert.Equal(t, 40, result.QuantityOnStock)
+						assert.Equal(t, 40, result.AvailableQuantity)
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func testAccInventoryEntryConfig(quantity, restockableInDays int) string {
+	return hclTemplate(`
+		resource "commercetools_channel" "warehouse" {
+			key   = "inventory-warehouse"
+			roles = ["InventorySupply"]
+		}
+
+		resource "commercetools_inventory_entry" "test" {
+			key                 = "inventory-1001"
+			sku                 = "1001"
+			supply_channel_id   = commercetools_channel.warehouse.id
+			quantity_on_stock   = {{ .quantity }}
+			restockable_in_days = {{ .restockable_in_days }}
+			expected_delivery   = "2030-01-01T00:00:00Z"
+		}`,
+		map[string]any{
+			"quantity":            quantity,
+			"restockable_in_days": restockableInDays,
+		})
+}
+
+func testAccCheckInventoryEntryDestroy(s *terraform.State) error {
+	client := getClient(testAccProvider.Meta())
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "commercetools_inventory_entry" {
+			continue
+		}
+		response, err := client.Inventory().WithId(rs.Primary.ID).Get().Execute(context.Background())
+		if err == nil {
+			if response != nil && response.ID == rs.Primary.ID {
+				return fmt.Errorf("inventory entry (%s) still exists", rs.Primary.ID)
+			}
+			return nil
+		}
+		if newErr := checkApiResult(err); newErr != nil {
+			return newErr
+		}
+	}
+	return nil
+}
+
+func testGetInventoryEntry(s *terraform.State, identifier string) (*platform.InventoryEntry, error) {
+	rs, ok := s.RootModule().Resources[identifier]
+	if !ok {
+		return nil, fmt.Errorf("InventoryEntry not found")
+	}
+
+	client := getClient(testAccProvider.Meta())
+	result, err := client.Inventory().WithId(rs.Primary.ID).Get().Execute(context.Background())
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
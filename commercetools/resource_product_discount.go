@@ -2,11 +2,15 @@ package commercetools
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"net/http"
+	"net/url"
 	"time"
 
+	"github.com/elliotchance/pie/v2"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/labd/commercetools-go-sdk/platform"
 	"github.com/labd/terraform-provider-commercetools/internal/utils"
@@ -20,6 +24,7 @@ func resourceProductDiscount() *schema.Resource {
 		ReadContext:   resourceProductDiscountRead,
 		UpdateContext: resourceProductDiscountUpdate,
 		DeleteContext: resourceProductDiscountDelete,
+		CustomizeDiff: validateTypedMoney,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -63,30 +68,11 @@ func resourceProductDiscount() *schema.Resource {
 							Optional:    true,
 						},
 						"money": {
-							Description: "Absolute discount specific fields",
-							Type:        schema.TypeList,
-							Optional:    true,
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"currency_code": {
-										Description:  "The currency code compliant to [ISO 4217](https://en.wikipedia.org/wiki/ISO_4217)",
-										Type:         schema.TypeString,
-										Required:     true,
-										ValidateFunc: ValidateCurrencyCode,
-									},
-									"cent_amount": {
-										Description: "The amount in cents (the smallest indivisible unit of the currency)",
-										Type:        schema.TypeInt,
-										Required:    true,
-									},
-									"fraction_digits": {
-										Description: "The number of default fraction digits for the given currency, like 2 for EUR or 0 for JPY",
-										Type:        schema.TypeInt,
-										Optional:    true,
-										Computed:    true,
-									},
-								},
-							},
+							Description: "Absolute discount specific fields. The money is in high " +
+								"precision when precise_amount is set",
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem:     typedMoneyElem(),
 						},
 					},
 				},
@@ -99,9 +85,12 @@ func resourceProductDiscount() *schema.Resource {
 			"sort_order": {
 				Description: "The string must contain a number between 0 and 1. All matching product discounts are " +
 					"applied to a product in the order defined by this field. A discount with greater sort order is " +
-					"prioritized higher than a discount with lower sort order. The sort order is unambiguous among all product discounts",
+					"prioritized higher than a discount with lower sort order. The sort order is unambiguous among all product discounts. " +
+					"When not set the discount gets a sort order below all existing product discounts, which is useful when " +
+					"the sort order is managed by a `commercetools_discount_priority` resource",
 				Type:     schema.TypeString,
-				Required: true,
+				Optional: true,
+				Computed: true,
 			},
 			"is_active": {
 				Description: "When set the product discount is applied to products matching the predicate",
@@ -164,9 +153,24 @@ func resourceProductDiscountCreate(ctx context.Context, d *schema.ResourceData,
 	}
 
 	var productDiscount *platform.ProductDiscount
+	allocateSortOrder := d.Get("sort_order").(string) == ""
 	err = retry.RetryContext(ctx, 1*time.Minute, func() *retry.RetryError {
+		if allocateSortOrder {
+			lowest, err := lowestProductDiscountSortOrder(ctx, client)
+			if err != nil {
+				return utils.ProcessRemoteError(err)
+			}
+			if draft.SortOrder, err = sortOrderBelow(lowest); err != nil {
+				return retry.NonRetryableError(err)
+			}
+		}
+
 		var err error
 		productDiscount, err = client.ProductDiscounts().Post(draft).Execute(ctx)
+		if allocateSortOrder && isDuplicateSortOrderError(err) {
+			// Another discount got the same sort order in the meantime
+			return retry.RetryableError(err)
+		}
 		return utils.ProcessRemoteError(err)
 	})
 
@@ -181,9 +185,10 @@ func resourceProductDiscountCreate(ctx context.Context, d *schema.ResourceData,
 }
 
 func resourceProductDiscountRead(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
-	client := getClient(m)
-
-	productDiscount, err := client.ProductDiscounts().WithId(d.Id()).Get().Execute(ctx)
+	// The product discount is retrieved with the raw client, since the SDK
+	// drops the precise amount of high precision money in absolute values
+	productDiscount := &remoteProductDiscount{}
+	err := getRawClient(m).Do(ctx, http.MethodGet, "/product-discounts/"+url.PathEscape(d.Id()), nil, productDiscount)
 	if err != nil {
 		if utils.IsResourceNotFoundError(err) {
 			d.SetId("")
@@ -195,11 +200,16 @@ func resourceProductDiscountRead(ctx context.Context, d *schema.ResourceData, m
 	if productDiscount == nil {
 		d.SetId("")
 	} else {
+		value, err := flattenProductDiscountValue(productDiscount.Value, productDiscount.Money)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
 		_ = d.Set("version", productDiscount.Version)
 		_ = d.Set("key", productDiscount.Key)
 		_ = d.Set("name", productDiscount.Name)
 		_ = d.Set("description", productDiscount.Description)
-		_ = d.Set("value", flattenProductDiscountValue(productDiscount.Value))
+		_ = d.Set("value", value)
 		_ = d.Set("predicate", productDiscount.Predicate)
 		_ = d.Set("sort_order", productDiscount.SortOrder)
 		_ = d.Set("is_active", productDiscount.IsActive)
@@ -339,8 +349,11 @@ func expandProductDiscountValue(d *schema.ResourceData) (platform.ProductDiscoun
 			Permyriad: value["permyriad"].(int),
 		}, nil
 	case "absolute":
-		money := expandMoneyDraft(value)
-		return platform.ProductDiscountValueAbsoluteDraft{
+		money, err := expandTypedMoneyDraft(value)
+		if err != nil {
+			return nil, err
+		}
+		return productDiscountValueAbsoluteDraft{
 			Money: money,
 		}, nil
 	case "external":
@@ -350,36 +363,77 @@ func expandProductDiscountValue(d *schema.ResourceData) (platform.ProductDiscoun
 	}
 }
 
-func flattenProductDiscountValue(val platform.ProductDiscountValue) []map[string]any {
+// remoteProductDiscount is a product discount as returned by the API,
+// including the money of absolute values in high precision.
+type remoteProductDiscount struct {
+	platform.ProductDiscount
+	Money []platform.TypedMoney
+}
+
+func (r *remoteProductDiscount) UnmarshalJSON(data []byte) error {
+	if err := json.Unmarshal(data, &r.ProductDiscount); err != nil {
+		return err
+	}
+
+	var extra struct {
+		Value struct {
+			Money []remoteMoney `json:"money"`
+		} `json:"value"`
+	}
+	if err := json.Unmarshal(data, &extra); err != nil {
+		return err
+	}
+	r.Money = pie.Map(extra.Value.Money, remoteMoney.typed)
+	return nil
+}
+
+// productDiscountValueAbsoluteDraft is the ProductDiscountValueAbsoluteDraft of
+// the SDK with typed money, so the money can be set in high precision.
+type productDiscountValueAbsoluteDraft struct {
+	Money []platform.TypedMoneyDraft `json:"money"`
+}
+
+func (obj productDiscountValueAbsoluteDraft) MarshalJSON() ([]byte, error) {
+	type Alias productDiscountValueAbsoluteDraft
+	return json.Marshal(struct {
+		Type string `json:"type"`
+		*Alias
+	}{Type: "absolute", Alias: (*Alias)(&obj)})
+}
+
+// flattenProductDiscountValue flattens the value. The money of absolute values
+// is passed separately, since the SDK drops the precise amount of high
+// precision money.
+func flattenProductDiscountValue(val platform.ProductDiscountValue, money []platform.TypedMoney) ([]map[string]any, error) {
 	if val == nil {
-		return []map[string]any{}
+		return []map[string]any{}, nil
 	}
 
 	switch v := val.(type) {
 	case platform.ProductDiscountValueAbsolute:
-		manyMoney := make([]map[string]any, len(v.Money))
-		for i, money := range v.Money {
-			manyMoney[i] = flattenTypedMoney(money)
+		manyMoney, err := flattenManyTypedMoney(money)
+		if err != nil {
+			return nil, err
 		}
 		return []map[string]any{{
 			"type":      "absolute",
 			"money":     manyMoney,
 			"permyriad": 0,
-		}}
+		}}, nil
 	case platform.ProductDiscountValueExternal:
 		return []map[string]any{{
 			"type":      "external",
 			"permyriad": 0,
 			"money":     []any{},
-		}}
+		}}, nil
 	case platform.ProductDiscountValueRelative:
 		return []map[string]any{{
 			"type":      "relative",
 			"permyriad": v.Permyriad,
 			"money":     []any{},
-		}}
+		}}, nil
 	}
-	panic("Unable to flatten product discount value")
+	return nil, fmt.Errorf("unable to flatten product discount value %T", val)
 }
 
 func validateProductDiscountValueType(val any, key string) (warns []string, errs []error) {
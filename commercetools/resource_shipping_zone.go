@@ -43,10 +43,14 @@ func resourceShippingZone() *schema.Resource {
 						"country": {
 							Description: "A two-digit country code as per " +
 								"[ISO 3166-1 alpha-2](https://en.wikipedia.org/wiki/ISO_3166-1_alpha-2)",
-							Type:     schema.TypeString,
-							Required: true,
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateCountryCode,
 						},
 						"state": {
+							Description: "The state in the country. When the country has " +
+								"[ISO 3166-2](https://en.wikipedia.org/wiki/ISO_3166-2) subdivisions, the plan " +
+								"shows a warning when the state is not the name or the code of one of them",
 							Type:     schema.TypeString,
 							Optional: true,
 						},
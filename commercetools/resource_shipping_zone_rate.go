@@ -2,12 +2,18 @@ package commercetools
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"net/http"
+	"net/url"
+	"slices"
 	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/labd/commercetools-go-sdk/platform"
@@ -17,11 +23,16 @@ import (
 func resourceShippingZoneRate() *schema.Resource {
 	return &schema.Resource{
 		Description: "Defines shipping rates (prices) for a specific zone.\n\n" +
+			"The price tiers are validated during plan against the `shipping_rate_input_type` of the project. " +
+			"When the project has no input type yet, for example because it is set in the same apply, this " +
+			"validation is left to the API. The cart classification values are read from the project during " +
+			"plan, so values which are added to the project need to be applied before they are used in a tier.\n\n" +
 			"See also [ZoneRate API Documentation](https://docs.commercetools.com/api/projects/shippingMethods#zonerate)",
 		CreateContext: resourceShippingZoneRateCreate,
 		ReadContext:   resourceShippingZoneRateRead,
 		UpdateContext: resourceShippingZoneRateUpdate,
 		DeleteContext: resourceShippingZoneRateDelete,
+		CustomizeDiff: customdiff.All(resourceShippingZoneRateValidateTiers, validateTypedMoney),
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceShippingZoneRateImportState,
 		},
@@ -37,23 +48,12 @@ func resourceShippingZoneRate() *schema.Resource {
 				ForceNew: true,
 			},
 			"price": {
-				Type:     schema.TypeList,
-				Required: true,
-				MinItems: 1,
-				MaxItems: 1,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"currency_code": {
-							Type:         schema.TypeString,
-							Required:     true,
-							ValidateFunc: ValidateCurrencyCode,
-						},
-						"cent_amount": {
-							Type:     schema.TypeInt,
-							Required: true,
-						},
-					},
-				},
+				Description: "The price of the shipping rate. The price is in high precision when precise_amount is set",
+				Type:        schema.TypeList,
+				Required:    true,
+				MinItems:    1,
+				MaxItems:    1,
+				Elem:        typedMoneyElem(),
 			},
 			"free_above": {
 				Description: "The shipping is free if the sum of the (custom) line item prices reaches the freeAbove value",
@@ -61,21 +61,7 @@ func resourceShippingZoneRate() *schema.Resource {
 				MinItems:    1,
 				MaxItems:    1,
 				Optional:    true,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"currency_code": {
-							Description:  "The currency code compliant to [ISO 4217](https://en.wikipedia.org/wiki/ISO_4217)",
-							Type:         schema.TypeString,
-							Required:     true,
-							ValidateFunc: ValidateCurrencyCode,
-						},
-						"cent_amount": {
-							Description: "The amount in cents (the smallest indivisible unit of the currency)",
-							Type:        schema.TypeInt,
-							Required:    true,
-						},
-					},
-				},
+				Elem:        typedMoneyElem(),
 			},
 			"shipping_rate_price_tier": {
 				Description: "A price tier is selected instead of the default price when a certain threshold or " +
@@ -117,19 +103,7 @@ func resourceShippingZoneRate() *schema.Resource {
 							Type:        schema.TypeList,
 							Optional:    true,
 							MaxItems:    1,
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"currency_code": {
-										Type:         schema.TypeString,
-										Required:     true,
-										ValidateFunc: ValidateCurrencyCode,
-									},
-									"cent_amount": {
-										Type:     schema.TypeInt,
-										Required: true,
-									},
-								},
-							},
+							Elem:        typedMoneyElem(),
 						},
 						"price_function": {
 							Description: "If type is CartScore. Allows to calculate a price dynamically for the score.",
@@ -158,10 +132,9 @@ func resourceShippingZoneRate() *schema.Resource {
 }
 
 func resourceShippingZoneRateImportState(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
-	client := getClient(meta)
 	shippingMethodID, _, _ := getShippingIDs(d.Id())
 
-	shippingMethod, err := client.ShippingMethods().WithId(shippingMethodID).Get().Execute(ctx)
+	shippingMethod, err := getRemoteShippingMethod(ctx, meta, shippingMethodID)
 	if err != nil {
 		return nil, err
 	}
@@ -187,7 +160,7 @@ func resourceShippingZoneRateCreate(ctx context.Context, d *schema.ResourceData,
 	ctMutexKV.Lock(shippingMethodID)
 	defer ctMutexKV.Unlock(shippingMethodID)
 
-	shippingMethod, err := client.ShippingMethods().WithId(shippingMethodID).Get().Execute(ctx)
+	shippingMethod, err := getRemoteShippingMethod(ctx, m, shippingMethodID)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -216,14 +189,13 @@ func resourceShippingZoneRateCreate(ctx context.Context, d *schema.ResourceData,
 		})
 	}
 
-	input.Actions = append(input.Actions, platform.ShippingMethodAddShippingRateAction{
+	input.Actions = append(input.Actions, shippingMethodAddShippingRateAction{
 		Zone:         platform.ZoneResourceIdentifier{ID: &shippingZoneID},
 		ShippingRate: *draft,
 	})
 
 	err = retry.RetryContext(ctx, 1*time.Minute, func() *retry.RetryError {
-		var err error
-		shippingMethod, err = client.ShippingMethods().WithId(shippingMethod.ID).Post(input).Execute(ctx)
+		_, err := client.ShippingMethods().WithId(shippingMethod.ID).Post(input).Execute(ctx)
 		return utils.ProcessRemoteError(err)
 	})
 
@@ -231,15 +203,14 @@ func resourceShippingZoneRateCreate(ctx context.Context, d *schema.ResourceData,
 		return diag.FromErr(err)
 	}
 
-	d.SetId(buildShippingZoneRateID(shippingMethod.ID, shippingZoneID, draft.Price.CurrencyCode))
+	d.SetId(buildShippingZoneRateID(shippingMethod.ID, shippingZoneID, draft.currencyCode()))
 	return resourceShippingZoneRateRead(ctx, d, m)
 }
 
 func resourceShippingZoneRateRead(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
 	shippingMethodID, _, _ := getShippingIDs(d.Id())
 
-	client := getClient(m)
-	shippingMethod, err := client.ShippingMethods().WithId(shippingMethodID).Get().Execute(ctx)
+	shippingMethod, err := getRemoteShippingMethod(ctx, m, shippingMethodID)
 	if err != nil {
 		if utils.IsResourceNotFoundError(err) {
 			d.SetId("")
@@ -262,7 +233,7 @@ func resourceShippingZoneRateUpdate(ctx context.Context, d *schema.ResourceData,
 	defer ctMutexKV.Unlock(shippingMethodID)
 
 	client := getClient(m)
-	shippingMethod, err := client.ShippingMethods().WithId(shippingMethodID).Get().Execute(ctx)
+	shippingMethod, err := getRemoteShippingMethod(ctx, m, shippingMethodID)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -271,7 +242,7 @@ func resourceShippingZoneRateUpdate(ctx context.Context, d *schema.ResourceData,
 	if err != nil {
 		return diag.FromErr(err)
 	}
-	oldShippingRateDraft := createShippingRateDraft(curShippingRate)
+	oldShippingRateDraft := curShippingRate.draft()
 
 	input := platform.ShippingMethodUpdate{
 		Version: shippingMethod.Version,
@@ -285,7 +256,7 @@ func resourceShippingZoneRateUpdate(ctx context.Context, d *schema.ResourceData,
 
 		input.Actions = append(
 			input.Actions,
-			&platform.ShippingMethodRemoveShippingRateAction{
+			shippingMethodRemoveShippingRateAction{
 				Zone:         zoneResourceIdentifier,
 				ShippingRate: *oldShippingRateDraft,
 			})
@@ -297,12 +268,12 @@ func resourceShippingZoneRateUpdate(ctx context.Context, d *schema.ResourceData,
 
 		input.Actions = append(
 			input.Actions,
-			&platform.ShippingMethodAddShippingRateAction{
+			shippingMethodAddShippingRateAction{
 				Zone:         zoneResourceIdentifier,
 				ShippingRate: *newShippingRateDraft,
 			})
 
-		d.SetId(buildShippingZoneRateID(shippingMethod.ID, shippingZoneID, newShippingRateDraft.Price.CurrencyCode))
+		d.SetId(buildShippingZoneRateID(shippingMethod.ID, shippingZoneID, newShippingRateDraft.currencyCode()))
 	}
 
 	err = retry.RetryContext(ctx, 1*time.Minute, func() *retry.RetryError {
@@ -347,7 +318,7 @@ func resourceShippingZoneRateDelete(ctx context.Context, d *schema.ResourceData,
 	}
 
 	shippingZoneID := d.Get("shipping_zone_id").(string)
-	removeAction := platform.ShippingMethodRemoveShippingRateAction{
+	removeAction := shippingMethodRemoveShippingRateAction{
 		Zone:         platform.ZoneResourceIdentifier{ID: &shippingZoneID},
 		ShippingRate: *shippingRateDraft,
 	}
@@ -378,21 +349,6 @@ func resourceShippingZoneRateDelete(ctx context.Context, d *schema.ResourceData,
 	return diag.FromErr(err)
 }
 
-func createShippingRateDraft(rate *platform.ShippingRate) *platform.ShippingRateDraft {
-	var freeAbove *platform.Money
-	if rate.FreeAbove != nil {
-		m := coerceTypedMoney(rate.FreeAbove)
-		freeAbove = &m
-	}
-
-	return &platform.ShippingRateDraft{
-		Price:     coerceTypedMoney(rate.Price),
-		FreeAbove: freeAbove,
-		Tiers:     rate.Tiers,
-	}
-
-}
-
 func getShippingIDs(shippingZoneRateID string) (string, string, string) {
 	idSplit := strings.Split(shippingZoneRateID, "@")
 
@@ -406,11 +362,11 @@ func getShippingIDs(shippingZoneRateID string) (string, string, string) {
 // find the shippingRate in a shippingMethod. This is done by a combination of
 // the zone id and the currency of the rate. The currency must be unique within
 // commercetools so this should be safe.
-func findShippingZoneRate(shippingMethod *platform.ShippingMethod, shippingZoneID string, currencyCode string) (*platform.ShippingRate, error) {
+func findShippingZoneRate(shippingMethod *remoteShippingMethod, shippingZoneID string, currencyCode string) (*remoteShippingRate, error) {
 	for _, zoneRate := range shippingMethod.ZoneRates {
 		if zoneRate.Zone.ID == shippingZoneID {
 			for _, shippingRate := range zoneRate.ShippingRates {
-				if shippingRate.Price.(platform.CentPrecisionMoney).CurrencyCode == currencyCode {
+				if shippingRate.Price.CurrencyCode == currencyCode {
 					return &shippingRate, nil
 				}
 			}
@@ -420,7 +376,7 @@ func findShippingZoneRate(shippingMethod *platform.ShippingMethod, shippingZoneI
 	return nil, fmt.Errorf("couldn't find shipping zone rate")
 }
 
-func setShippingZoneRateState(d *schema.ResourceData, shippingMethod *platform.ShippingMethod) error {
+func setShippingZoneRateState(d *schema.ResourceData, shippingMethod *remoteShippingMethod) error {
 	shippingMethodID, shippingZoneID, currencyCode := getShippingIDs(d.Id())
 
 	_ = d.Set("shipping_method_id", shippingMethodID)
@@ -432,29 +388,25 @@ func setShippingZoneRateState(d *schema.ResourceData, shippingMethod *platform.S
 		return err
 	}
 
-	tiers := flattenShippingZoneRateTiers(shippingRate)
+	tiers, err := flattenShippingZoneRateTiers(shippingRate)
+	if err != nil {
+		return err
+	}
 	_ = d.Set("shipping_rate_price_tier", tiers)
 
-	if typedPrice, ok := shippingRate.Price.(platform.CentPrecisionMoney); ok {
-		price := map[string]any{
-			"currency_code": typedPrice.CurrencyCode,
-			"cent_amount":   typedPrice.CentAmount,
-		}
-		err = d.Set("price", []any{price})
-		if err != nil {
-			return err
-		}
-	} else {
-		_ = d.Set("price", nil)
-		if err != nil {
-			return err
-		}
+	price, err := flattenTypedMoney(shippingRate.Price.typed())
+	if err != nil {
+		return err
+	}
+	err = d.Set("price", []any{price})
+	if err != nil {
+		return err
 	}
 
-	if typedFreeAbove, ok := (shippingRate.FreeAbove).(platform.CentPrecisionMoney); ok {
-		freeAbove := map[string]any{
-			"currency_code": typedFreeAbove.CurrencyCode,
-			"cent_amount":   typedFreeAbove.CentAmount,
+	if shippingRate.FreeAbove != nil {
+		freeAbove, err := flattenTypedMoney(shippingRate.FreeAbove.typed())
+		if err != nil {
+			return err
 		}
 		err = d.Set("free_above", []any{freeAbove})
 		if err != nil {
@@ -462,90 +414,72 @@ func setShippingZoneRateState(d *schema.ResourceData, shippingMethod *platform.S
 		}
 	} else {
 		_ = d.Set("free_above", nil)
-		if err != nil {
-			return err
-		}
 	}
 	return nil
 }
 
-func flattenShippingZoneRateTiers(shippingRate *platform.ShippingRate) []any {
+func flattenShippingZoneRateTiers(shippingRate *remoteShippingRate) ([]any, error) {
 	var tiers []any
 
 	for _, v := range shippingRate.Tiers {
-		switch shippingRateTier := v.(type) {
-		case platform.CartClassificationTier:
-			tiers = append(tiers, map[string]any{
-				"type":  string(platform.ShippingRateTierTypeCartClassification),
-				"value": shippingRateTier.Value,
-				"price": []any{
-					map[string]any{
-						"currency_code": shippingRateTier.Price.CurrencyCode,
-						"cent_amount":   shippingRateTier.Price.CentAmount,
-					},
-				},
-			})
-		case platform.CartScoreTier:
-			tierData := map[string]any{
-				"type":  string(platform.ShippingRateTierTypeCartScore),
-				"score": shippingRateTier.Score,
-			}
+		tierData := map[string]any{
+			"type": v.Type,
+		}
 
-			if shippingRateTier.PriceFunction != nil {
+		switch platform.ShippingRateTierType(v.Type) {
+		case platform.ShippingRateTierTypeCartClassification:
+			tierData["value"] = v.Value
+		case platform.ShippingRateTierTypeCartScore:
+			tierData["score"] = v.Score
+
+			if v.PriceFunction != nil {
 				tierData["price_function"] = []any{
 					map[string]any{
-						"currency_code": shippingRateTier.PriceFunction.CurrencyCode,
-						"function":      shippingRateTier.PriceFunction.Function,
+						"currency_code": v.PriceFunction.CurrencyCode,
+						"function":      v.PriceFunction.Function,
 					},
 				}
 			}
-			if shippingRateTier.Price != nil {
-				tierData["price"] = []any{
-					map[string]any{
-						"currency_code": shippingRateTier.Price.CurrencyCode,
-						"cent_amount":   shippingRateTier.Price.CentAmount,
-					},
-				}
+		case platform.ShippingRateTierTypeCartValue:
+			tierData["minimum_cent_amount"] = v.MinimumCentAmount
+		default:
+			continue
+		}
+
+		if v.Price != nil {
+			price, err := flattenTypedMoney(v.Price.typed())
+			if err != nil {
+				return nil, err
 			}
-			tiers = append(tiers, tierData)
-		case platform.CartValueTier:
-			tiers = append(tiers, map[string]any{
-				"type":                string(platform.ShippingRateTierTypeCartValue),
-				"minimum_cent_amount": shippingRateTier.MinimumCentAmount,
-				"price": []any{
-					map[string]any{
-						"currency_code": shippingRateTier.Price.CurrencyCode,
-						"cent_amount":   shippingRateTier.Price.CentAmount,
-					},
-				},
-			})
+			tierData["price"] = []any{price}
 		}
+		tiers = append(tiers, tierData)
 	}
 
-	return tiers
+	return tiers, nil
 }
 
-func expandShippingRateDraft(d *schema.ResourceData) (*platform.ShippingRateDraft, error) {
+func expandShippingRateDraft(d *schema.ResourceData) (*shippingRateDraft, error) {
 	shippingRatePriceTiers, err := expandShippingRatePriceTiers(d)
 	if err != nil {
 		return nil, err
 	}
 
-	draft := &platform.ShippingRateDraft{
+	draft := &shippingRateDraft{
 		Tiers: shippingRatePriceTiers,
 	}
 
 	if price, _ := elementFromList(d, "price"); price != nil {
-		draft.Price = platform.Money{
-			CurrencyCode: price["currency_code"].(string),
-			CentAmount:   price["cent_amount"].(int),
+		draft.Price, err = expandTypedMoneyDraftItem(price)
+		if err != nil {
+			return nil, err
 		}
 	}
 
 	if price, _ := elementFromList(d, "free_above"); price != nil {
-		draft.FreeAbove = &platform.Money{
-			CurrencyCode: price["currency_code"].(string),
-			CentAmount:   price["cent_amount"].(int),
+		draft.FreeAbove, err = expandTypedMoneyDraftItem(price)
+		if err != nil {
+			return nil, err
 		}
 	}
 
@@ -563,26 +497,27 @@ func expandShippingRatePriceTiers(d *schema.ResourceData) ([]platform.ShippingRa
 	for _, priceTier := range values.([]any) {
 		tierMap := priceTier.(map[string]any)
 
-		var price *platform.Money
+		var price platform.TypedMoneyDraft
 		if rawPrice := elementFromSlice(tierMap, "price"); rawPrice != nil {
-			price = &platform.Money{
-				CurrencyCode: rawPrice["currency_code"].(string),
-				CentAmount:   rawPrice["cent_amount"].(int),
+			var err error
+			price, err = expandTypedMoneyDraftItem(rawPrice)
+			if err != nil {
+				return nil, err
 			}
 		}
 
 		tierType := tierMap["type"].(string)
 		switch tierType {
 		case string(platform.ShippingRateTierTypeCartValue):
-			tiers = append(tiers, platform.CartValueTier{
+			tiers = append(tiers, cartValueTierDraft{
 				MinimumCentAmount: tierMap["minimum_cent_amount"].(int),
-				Price:             *price,
+				Price:             price,
 			})
 
 		case string(platform.ShippingRateTierTypeCartClassification):
-			tiers = append(tiers, platform.CartClassificationTier{
+			tiers = append(tiers, cartClassificationTierDraft{
 				Value: tierMap["value"].(string),
-				Price: *price,
+				Price: price,
 			})
 
 		// CartScore has either a `price` or `price_function` field.
@@ -595,7 +530,7 @@ func expandShippingRatePriceTiers(d *schema.ResourceData) ([]platform.ShippingRa
 				}
 			}
 
-			tiers = append(tiers, platform.CartScoreTier{
+			tiers = append(tiers, cartScoreTierDraft{
 				Score:         tierMap["score"].(int),
 				Price:         price,
 				PriceFunction: function,
@@ -608,6 +543,265 @@ func expandShippingRatePriceTiers(d *schema.ResourceData) ([]platform.ShippingRa
 	return tiers, nil
 }
 
+// resourceShippingZoneRateValidateTiers validates the price tiers, and checks
+// them against the shipping rate input type of the project. The tiers are
+// only checked against the project when they are known and changed.
+func resourceShippingZoneRateValidateTiers(ctx context.Context, d *schema.ResourceDiff, m any) error {
+	tiers := d.Get("shipping_rate_price_tier").([]any)
+	if errs := shippingRateTierErrors(tiers); len(errs) > 0 {
+		return errors.Join(errs...)
+	}
+	if len(tiers) == 0 || !d.NewValueKnown("shipping_rate_price_tier") ||
+		(d.Id() != "" && !d.HasChange("shipping_rate_price_tier")) {
+		return nil
+	}
+
+	project, err := getClient(m).Get().Execute(ctx)
+	if err != nil {
+		return err
+	}
+	return errors.Join(shippingRateInputTypeErrors(tiers, project.ShippingRateInputType)...)
+}
+
+// shippingRateTierErrors returns the errors for the fields of the tiers which
+// don't match their type.
+func shippingRateTierErrors(tiers []any) []error {
+	var errs []error
+	for i, raw := range tiers {
+		tier, ok := raw.(map[string]any)
+		if !ok {
+			continue
+		}
+		hasPrice := elementFromSlice(tier, "price") != nil
+		hasFunction := elementFromSlice(tier, "price_function") != nil
+
+		switch tier["type"].(string) {
+		case string(platform.ShippingRateTierTypeCartScore):
+			if hasPrice && hasFunction {
+				errs = append(errs, fmt.Errorf("shipping_rate_price_tier.%d: CartScore tiers use either price or price_function, not both", i))
+			}
+			if !hasPrice && !hasFunction {
+				errs = append(errs, fmt.Errorf("shipping_rate_price_tier.%d: CartScore tiers need a price or price_function", i))
+			}
+		default:
+			if hasFunction {
+				errs = append(errs, fmt.Errorf("shipping_rate_price_tier.%d: price_function is only supported by CartScore tiers", i))
+			}
+		}
+	}
+	return errs
+}
+
+// shippingRateInputTypeErrors returns the errors for the tiers which are not
+// allowed by the shipping rate input type of the project. Nothing is
+// validated when the project has no input type.
+func shippingRateInputTypeErrors(tiers []any, inputType platform.ShippingRateInputType) []error {
+	var expected platform.ShippingRateTierType
+	var classifications []string
+	switch t := inputType.(type) {
+	case platform.CartValueType:
+		expected = platform.ShippingRateTierTypeCartValue
+	case platform.CartScoreType:
+		expected = platform.ShippingRateTierTypeCartScore
+	case platform.CartClassificationType:
+		expected = platform.ShippingRateTierTypeCartClassification
+		for _, value := range t.Values {
+			classifications = append(classifications, value.Key)
+		}
+	default:
+		return nil
+	}
+
+	var errs []error
+	for i, raw := range tiers {
+		tier, ok := raw.(map[string]any)
+		if !ok {
+			continue
+		}
+		tierType := tier["type"].(string)
+		if tierType != string(expected) {
+			errs = append(errs, fmt.Errorf("shipping_rate_price_tier.%d: the shipping_rate_input_type of the project "+
+				"is %s, %s tiers are not allowed", i, expected, tierType))
+			continue
+		}
+
+		value := tier["value"].(string)
+		if expected != platform.ShippingRateTierTypeCartClassification || value == "" ||
+			slices.Contains(classifications, value) {
+			continue
+		}
+		err := fmt.Errorf("shipping_rate_price_tier.%d: %q is not a shipping_rate_cart_classification_value "+
+			"of the project (%s)", i, value, strings.Join(classifications, ", "))
+		if suggestion := utils.ClosestMatch(value, classifications); suggestion != "" {
+			err = fmt.Errorf("%w, did you mean %q?", err, suggestion)
+		}
+		errs = append(errs, err)
+	}
+	return errs
+}
+
 func buildShippingZoneRateID(shippingMethodID string, shippingZoneID string, currencyCode string) string {
 	return shippingMethodID + "@" + shippingZoneID + "@" + currencyCode
 }
+
+// getRemoteShippingMethod retrieves the shipping method with the raw client,
+// since the SDK drops the precise amount of high precision money in tiers.
+func getRemoteShippingMethod(ctx context.Context, m any, id string) (*remoteShippingMethod, error) {
+	var shippingMethod remoteShippingMethod
+	err := getRawClient(m).Do(ctx, http.MethodGet, "/shipping-methods/"+url.PathEscape(id), nil, &shippingMethod)
+	if err != nil {
+		return nil, err
+	}
+	return &shippingMethod, nil
+}
+
+// remoteShippingMethod holds the fields of a shipping method which are needed
+// to manage the shipping rates of its zones.
+type remoteShippingMethod struct {
+	ID        string           `json:"id"`
+	Version   int              `json:"version"`
+	ZoneRates []remoteZoneRate `json:"zoneRates"`
+}
+
+type remoteZoneRate struct {
+	Zone          platform.ZoneReference `json:"zone"`
+	ShippingRates []remoteShippingRate   `json:"shippingRates"`
+}
+
+type remoteShippingRate struct {
+	Price     remoteMoney              `json:"price"`
+	FreeAbove *remoteMoney             `json:"freeAbove"`
+	Tiers     []remoteShippingRateTier `json:"tiers"`
+}
+
+// draft returns the shipping rate as it was created, which is required to
+// remove it from the shipping method.
+func (r remoteShippingRate) draft() *shippingRateDraft {
+	draft := &shippingRateDraft{
+		Price: r.Price.draft(),
+		Tiers: []platform.ShippingRatePriceTier{},
+	}
+	if r.FreeAbove != nil {
+		draft.FreeAbove = r.FreeAbove.draft()
+	}
+
+	for _, tier := range r.Tiers {
+		var price platform.TypedMoneyDraft
+		if tier.Price != nil {
+			price = tier.Price.draft()
+		}
+
+		switch platform.ShippingRateTierType(tier.Type) {
+		case platform.ShippingRateTierTypeCartValue:
+			draft.Tiers = append(draft.Tiers, cartValueTierDraft{
+				MinimumCentAmount: tier.MinimumCentAmount,
+				Price:             price,
+			})
+		case platform.ShippingRateTierTypeCartClassification:
+			draft.Tiers = append(draft.Tiers, cartClassificationTierDraft{
+				Value: tier.Value,
+				Price: price,
+			})
+		case platform.ShippingRateTierTypeCartScore:
+			draft.Tiers = append(draft.Tiers, cartScoreTierDraft{
+				Score:         tier.Score,
+				Price:         price,
+				PriceFunction: tier.PriceFunction,
+			})
+		}
+	}
+	return draft
+}
+
+type remoteShippingRateTier struct {
+	Type              string                  `json:"type"`
+	MinimumCentAmount int                     `json:"minimumCentAmount"`
+	Value             string                  `json:"value"`
+	Score             int                     `json:"score"`
+	Price             *remoteMoney            `json:"price"`
+	PriceFunction     *platform.PriceFunction `json:"priceFunction"`
+}
+
+// shippingRateDraft is the ShippingRateDraft of the SDK with typed money, so
+// the prices can be set in high precision.
+type shippingRateDraft struct {
+	Price     platform.TypedMoneyDraft         `json:"price"`
+	FreeAbove platform.TypedMoneyDraft         `json:"freeAbove,omitempty"`
+	Tiers     []platform.ShippingRatePriceTier `json:"tiers"`
+}
+
+func (r shippingRateDraft) currencyCode() string {
+	switch p := r.Price.(type) {
+	case platform.HighPrecisionMoneyDraft:
+		return p.CurrencyCode
+	case platform.Money:
+		return p.CurrencyCode
+	}
+	return ""
+}
+
+type cartValueTierDraft struct {
+	MinimumCentAmount int                      `json:"minimumCentAmount"`
+	Price             platform.TypedMoneyDraft `json:"price"`
+}
+
+func (obj cartValueTierDraft) MarshalJSON() ([]byte, error) {
+	type Alias cartValueTierDraft
+	return json.Marshal(struct {
+		Type string `json:"type"`
+		*Alias
+	}{Type: string(platform.ShippingRateTierTypeCartValue), Alias: (*Alias)(&obj)})
+}
+
+type cartClassificationTierDraft struct {
+	Value string                   `json:"value"`
+	Price platform.TypedMoneyDraft `json:"price"`
+}
+
+func (obj cartClassificationTierDraft) MarshalJSON() ([]byte, error) {
+	type Alias cartClassificationTierDraft
+	return json.Marshal(struct {
+		Type string `json:"type"`
+		*Alias
+	}{Type: string(platform.ShippingRateTierTypeCartClassification), Alias: (*Alias)(&obj)})
+}
+
+type cartScoreTierDraft struct {
+	Score         int                      `json:"score"`
+	Price         platform.TypedMoneyDraft `json:"price,omitempty"`
+	PriceFunction *platform.PriceFunction  `json:"priceFunction,omitempty"`
+}
+
+func (obj cartScoreTierDraft) MarshalJSON() ([]byte, error) {
+	type Alias cartScoreTierDraft
+	return json.Marshal(struct {
+		Type string `json:"type"`
+		*Alias
+	}{Type: string(platform.ShippingRateTierTypeCartScore), Alias: (*Alias)(&obj)})
+}
+
+type shippingMethodAddShippingRateAction struct {
+	Zone         platform.ZoneResourceIdentifier `json:"zone"`
+	ShippingRate shippingRateDraft               `json:"shippingRate"`
+}
+
+func (obj shippingMethodAddShippingRateAction) MarshalJSON() ([]byte, error) {
+	type Alias shippingMethodAddShippingRateAction
+	return json.Marshal(struct {
+		Action string `json:"action"`
+		*Alias
+	}{Action: "addShippingRate", Alias: (*Alias)(&obj)})
+}
+
+type shippingMethodRemoveShippingRateAction struct {
+	Zone         platform.ZoneResourceIdentifier `json:"zone"`
+	ShippingRate shippingRateDraft               `json:"shippingRate"`
+}
+
+func (obj shippingMethodRemoveShippingRateAction) MarshalJSON() ([]byte, error) {
+	type Alias shippingMethodRemoveShippingRateAction
+	return json.Marshal(struct {
+		Action string `json:"action"`
+		*Alias
+	}{Action: "removeShippingRate", Alias: (*Alias)(&obj)})
+}
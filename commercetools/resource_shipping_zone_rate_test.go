@@ -7,9 +7,67 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/labd/commercetools-go-sdk/platform"
+	"github.com/stretchr/testify/assert"
 )
 
+func testShippingRateTiers(t *testing.T, tiers ...map[string]any) []any {
+	raw := make([]any, len(tiers))
+	for i, tier := range tiers {
+		raw[i] = tier
+	}
+	d := schema.TestResourceDataRaw(t, resourceShippingZoneRate().Schema, map[string]any{
+		"shipping_method_id":       "method",
+		"shipping_zone_id":         "zone",
+		"shipping_rate_price_tier": raw,
+	})
+	return d.Get("shipping_rate_price_tier").([]any)
+}
+
+func TestShippingRateTierErrors(t *testing.T) {
+	price := []any{map[string]any{"currency_code": "EUR", "cent_amount": 500}}
+	function := []any{map[string]any{"currency_code": "EUR", "function": "x + 100"}}
+
+	tiers := testShippingRateTiers(t,
+		map[string]any{"type": "CartScore", "score": 10, "price": price},
+		map[string]any{"type": "CartScore", "score": 20, "price": price, "price_function": function},
+		map[string]any{"type": "CartScore", "score": 30},
+		map[string]any{"type": "CartValue", "minimum_cent_amount": 1000, "price_function": function},
+	)
+
+	errs := shippingRateTierErrors(tiers)
+	assert.Len(t, errs, 3)
+	assert.EqualError(t, errs[0], "shipping_rate_price_tier.1: CartScore tiers use either price or price_function, not both")
+	assert.EqualError(t, errs[1], "shipping_rate_price_tier.2: CartScore tiers need a price or price_function")
+	assert.EqualError(t, errs[2], "shipping_rate_price_tier.3: price_function is only supported by CartScore tiers")
+}
+
+func TestShippingRateInputTypeErrors(t *testing.T) {
+	price := []any{map[string]any{"currency_code": "EUR", "cent_amount": 500}}
+	tiers := testShippingRateTiers(t,
+		map[string]any{"type": "CartClassification", "value": "Light", "price": price},
+		map[string]any{"type": "CartClassification", "value": "Haevy", "price": price},
+		map[string]any{"type": "CartValue", "minimum_cent_amount": 1000, "price": price},
+	)
+
+	classification := platform.CartClassificationType{
+		Values: []platform.CustomFieldLocalizedEnumValue{{Key: "Light"}, {Key: "Heavy"}},
+	}
+	errs := shippingRateInputTypeErrors(tiers, classification)
+	assert.Len(t, errs, 2)
+	assert.EqualError(t, errs[0], `shipping_rate_price_tier.1: "Haevy" is not a shipping_rate_cart_classification_value `+
+		`of the project (Light, Heavy), did you mean "Heavy"?`)
+	assert.EqualError(t, errs[1], "shipping_rate_price_tier.2: the shipping_rate_input_type of the project is "+
+		"CartClassification, CartValue tiers are not allowed")
+
+	errs = shippingRateInputTypeErrors(tiers, platform.CartValueType{})
+	assert.Len(t, errs, 2)
+
+	assert.Empty(t, shippingRateInputTypeErrors(tiers, nil))
+}
+
 func TestAccShippingZoneRate_createAndUpdate(t *testing.T) {
 
 	taxCategoryName := acctest.RandomWithPrefix("tf-acc-test")
@@ -56,6 +114,20 @@ func TestAccShippingZoneRate_createAndUpdate(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "shipping_rate_price_tier.2.price_function.0.function", "x + 1"),
 				),
 			},
+			{
+				Config: testAccShippingZoneRateHighPrecision(taxCategoryName, shippingMethodName, "USD"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "price.0.precise_amount", "43215"),
+					resource.TestCheckResourceAttr(resourceName, "price.0.fraction_digits", "3"),
+					resource.TestCheckResourceAttr(resourceName, "price.0.cent_amount", "4322"),
+					resource.TestCheckResourceAttr(resourceName, "free_above.0.cent_amount", "12345"),
+					resource.TestCheckResourceAttr(resourceName, "free_above.0.precise_amount", "0"),
+					resource.TestCheckResourceAttr(resourceName, "shipping_rate_price_tier.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "shipping_rate_price_tier.0.type", "CartValue"),
+					resource.TestCheckResourceAttr(resourceName, "shipping_rate_price_tier.0.price.0.precise_amount", "19995"),
+					resource.TestCheckResourceAttr(resourceName, "shipping_rate_price_tier.0.price.0.fraction_digits", "3"),
+				),
+			},
 		},
 	})
 }
@@ -200,6 +272,63 @@ func testAccShippingZoneRateUpdate(taxCategoryName string, shippingMethodName st
 		})
 }
 
+func testAccShippingZoneRateHighPrecision(taxCategoryName string, shippingMethodName string, currencyCode string) string {
+	return hclTemplate(`
+		resource "commercetools_tax_category" "standard" {
+			name        = "{{ .taxCategoryName }}"
+			key         = "{{ .taxCategoryName }}"
+			description = "Terraform test rate tax"
+		}
+
+		resource "commercetools_shipping_method" "standard" {
+			name            = "{{ .shippingMethodName }}"
+			key             = "{{ .shippingMethodName }}"
+			description     = "Terraform test tax category"
+			tax_category_id = commercetools_tax_category.standard.id
+			predicate		= "1 = 1"
+		}
+
+		resource "commercetools_shipping_zone" "de" {
+			name        = "DE"
+			description = "Germany"
+			location {
+				country = "DE"
+			}
+		}
+
+		resource "commercetools_shipping_zone_rate" "standard-de" {
+			shipping_method_id = commercetools_shipping_method.standard.id
+			shipping_zone_id   = commercetools_shipping_zone.de.id
+
+			price {
+				precise_amount  = 43215
+				fraction_digits = 3
+				currency_code   = "{{ .currencyCode }}"
+			}
+
+			free_above {
+				cent_amount   = 12345
+				currency_code = "{{ .currencyCode }}"
+			}
+
+			shipping_rate_price_tier {
+				type                = "CartValue"
+				minimum_cent_amount = 20000
+
+				price {
+					precise_amount  = 19995
+					fraction_digits = 3
+					currency_code   = "{{ .currencyCode }}"
+				}
+			}
+		}`,
+		map[string]any{
+			"taxCategoryName":    taxCategoryName,
+			"shippingMethodName": shippingMethodName,
+			"currencyCode":       currencyCode,
+		})
+}
+
 func testAccCheckShippingZoneRateDestroy(s *terraform.State) error {
 	client := getClient(testAccProvider.Meta())
 	// TODO: Do we want to check trailing rates separately? Similar to resource_tax_category_test_rate.go
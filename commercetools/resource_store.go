@@ -3,9 +3,10 @@ package commercetools
 import (
 	"context"
 	"errors"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/labd/commercetools-go-sdk/platform"
@@ -16,11 +17,19 @@ func resourceStore() *schema.Resource {
 	return &schema.Resource{
 		Description: "Stores can be used to model, for example, physical retail locations, brand stores, " +
 			"or country-specific stores.\n\n" +
+			"The `distribution_channels` need the `ProductDistribution` role and the `supply_channels` the " +
+			"`InventorySupply` role, which is validated during plan for the channels that already exist. " +
+			"Channels of which the roles are changed in the same apply are validated by the API, when they " +
+			"are referred to through the `commercetools_channel` resource.\n\n" +
 			"See also the [Stores API Documentation](https://docs.commercetools.com/api/projects/stores)",
 		CreateContext: resourceStoreCreate,
 		ReadContext:   resourceStoreRead,
 		UpdateContext: resourceStoreUpdate,
 		DeleteContext: resourceStoreDelete,
+		CustomizeDiff: validateChannelRoles(
+			channelRole{field: "distribution_channels", role: platform.ChannelRoleEnumProductDistribution, byKey: true},
+			channelRole{field: "supply_channels", role: platform.ChannelRoleEnumInventorySupply, byKey: true},
+		),
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -3,6 +3,7 @@ package commercetools
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -141,6 +142,35 @@ func TestAccStore_createAndUpdateDistributionLanguages(t *testing.T) {
 	})
 }
 
+func TestAccStore_ChannelRoles(t *testing.T) {
+	channel := `
+		resource "commercetools_channel" "supply" {
+			key   = "acctest-supply"
+			roles = ["InventorySupply"]
+		}
+	`
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviders,
+		CheckDestroy:      testAccCheckStoreDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: channel,
+			},
+			{
+				Config: channel + `
+					resource "commercetools_store" "test" {
+						key                   = "acctest-channel-roles"
+						distribution_channels = [commercetools_channel.supply.key]
+					}
+				`,
+				ExpectError: regexp.MustCompile(`channel "acctest-supply" does not have the ProductDistribution role`),
+			},
+		},
+	})
+}
+
 func TestAccStore_CustomField(t *testing.T) {
 	resourceName := "commercetools_store.test"
 	name := "test method"
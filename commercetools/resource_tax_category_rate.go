@@ -45,14 +45,17 @@ func resourceTaxCategoryRate() *schema.Resource {
 				Required: true,
 			},
 			"country": {
-				Description: "A two-digit country code as per [ISO 3166-1 alpha-2](https://en.wikipedia.org/wiki/ISO_3166-1_alpha-2)",
-				Type:        schema.TypeString,
-				Required:    true,
+				Description:  "A two-digit country code as per [ISO 3166-1 alpha-2](https://en.wikipedia.org/wiki/ISO_3166-1_alpha-2)",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateCountryCode,
 			},
 			"state": {
-				Description: "The state in the country",
-				Type:        schema.TypeString,
-				Optional:    true,
+				Description: "The state in the country. When the country has " +
+					"[ISO 3166-2](https://en.wikipedia.org/wiki/ISO_3166-2) subdivisions, the plan shows a " +
+					"warning when the state is not the name or the code of one of them",
+				Type:     schema.TypeString,
+				Optional: true,
 			},
 			"sub_rate": {
 				Description: "For countries (for example the US) where the total tax is a combination of multiple " +
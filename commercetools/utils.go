@@ -8,6 +8,8 @@ import (
 	"github.com/labd/commercetools-go-sdk/platform"
 	"golang.org/x/text/language"
 	"reflect"
+
+	"github.com/labd/terraform-provider-commercetools/internal/utils"
 )
 
 // TypeLocalizedString defined merely for documentation,
@@ -16,8 +18,21 @@ import (
 const TypeLocalizedString = schema.TypeMap
 
 func getClient(m any) *platform.ByProjectKeyRequestBuilder {
-	client := m.(*platform.ByProjectKeyRequestBuilder)
-	return client
+	data := m.(*utils.ProviderData)
+	return data.Client
+}
+
+// getRawClient returns the client for fields which the SDK does not support
+// yet.
+func getRawClient(m any) *utils.RawClient {
+	data := m.(*utils.ProviderData)
+	return data.RawClient
+}
+
+// getProjectKey returns the key of the project the provider is configured for.
+func getProjectKey(m any) string {
+	data := m.(*utils.ProviderData)
+	return data.ProjectKey
 }
 
 func stringRef(value any) *string {
@@ -32,6 +47,24 @@ func stringRef(value any) *string {
 	return &result
 }
 
+// expandStoreKeys returns the store keys of the set as resource identifiers.
+func expandStoreKeys(input *schema.Set) []platform.StoreResourceIdentifier {
+	keys := expandStringArray(input.List())
+	result := make([]platform.StoreResourceIdentifier, len(keys))
+	for i := range keys {
+		result[i] = platform.StoreResourceIdentifier{Key: &keys[i]}
+	}
+	return result
+}
+
+func flattenStoreKeys(stores []platform.StoreKeyReference) []string {
+	result := make([]string, len(stores))
+	for i := range stores {
+		result[i] = stores[i].Key
+	}
+	return result
+}
+
 func intRef(value any) *int {
 	result := value.(int)
 	return &result
@@ -412,23 +445,6 @@ func diffSlices(old, new map[string]any) map[string]any {
 	return result
 }
 
-func coerceTypedMoney(val platform.TypedMoney) platform.Money {
-	switch p := val.(type) {
-	case platform.CentPrecisionMoney:
-		return platform.Money{
-			CentAmount:   p.CentAmount,
-			CurrencyCode: p.CurrencyCode,
-		}
-	case platform.HighPrecisionMoney:
-		return platform.Money{
-			CentAmount:   p.CentAmount,
-			CurrencyCode: p.CurrencyCode,
-		}
-	}
-
-	return platform.Money{}
-}
-
 // intNilIfEmpty returns a nil value if the integer is nil or empty (0) otherwise
 // it returns the value
 func intNilIfEmpty(val *int) *int {
@@ -441,3 +457,16 @@ func intNilIfEmpty(val *int) *int {
 	}
 	return val
 }
+
+// ctyString returns the string attribute of the object, or an empty string
+// when the object or the attribute is null or unknown.
+func ctyString(obj cty.Value, name string) string {
+	if obj.IsNull() || !obj.IsKnown() {
+		return ""
+	}
+	val := obj.GetAttr(name)
+	if val.IsNull() || !val.IsKnown() {
+		return ""
+	}
+	return val.AsString()
+}
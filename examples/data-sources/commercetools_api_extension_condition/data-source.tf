@@ -0,0 +1,18 @@
+data "commercetools_api_extension_condition" "large_dutch_cart" {
+  resource_type_id = "cart"
+  condition        = "country = \"NL\" and totalPrice(centAmount > 10000)"
+  resource = jsonencode({
+    country = "NL"
+    totalPrice = {
+      currencyCode = "EUR"
+      centAmount   = 12500
+    }
+  })
+}
+
+check "large_dutch_cart" {
+  assert {
+    condition     = data.commercetools_api_extension_condition.large_dutch_cart.matches
+    error_message = "The extension must be called for large Dutch carts"
+  }
+}
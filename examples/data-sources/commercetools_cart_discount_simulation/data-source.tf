@@ -0,0 +1,52 @@
+data "commercetools_cart_discount_simulation" "summer" {
+  discount {
+    key        = "socks"
+    predicate  = "lineItemCount(sku = \"socks\") >= 3"
+    sort_order = "0.9"
+    target {
+      type      = "lineItems"
+      predicate = "sku = \"socks\""
+    }
+    value {
+      type      = "relative"
+      permyriad = 1500
+    }
+  }
+
+  discount {
+    key           = "free-shipping"
+    predicate     = "totalPrice >= \"50.00 EUR\""
+    sort_order    = "0.8"
+    stacking_mode = "StopAfterThisDiscount"
+    target {
+      type = "shipping"
+    }
+    value {
+      type = "fixed"
+      money {
+        currency_code = "EUR"
+        cent_amount   = 0
+      }
+    }
+  }
+
+  carts = {
+    socks = jsonencode({
+      lineItems = [{
+        quantity = 3
+        variant  = { sku = "socks" }
+        price    = { value = { currencyCode = "EUR", centAmount = 999 } }
+      }]
+      shippingInfo = {
+        price = { currencyCode = "EUR", centAmount = 695 }
+      }
+    })
+  }
+}
+
+check "summer_discounts" {
+  assert {
+    condition     = data.commercetools_cart_discount_simulation.summer.results["socks"].applied_discounts == ["socks"]
+    error_message = "Only the socks discount must apply to a cart with three pairs of socks"
+  }
+}
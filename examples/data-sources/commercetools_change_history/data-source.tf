@@ -0,0 +1,10 @@
+data "commercetools_change_history" "product_type" {
+  resource_type = "product-type"
+  resource_key  = "shoes"
+  date_from     = "2024-01-01T00:00:00Z"
+  limit         = 5
+}
+
+output "product_type_last_modified_by" {
+  value = try(data.commercetools_change_history.product_type.records[0].modified_by.description, null)
+}
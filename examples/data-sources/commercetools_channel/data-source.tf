@@ -0,0 +1,17 @@
+data "commercetools_channel" "web" {
+  key = "web"
+}
+
+# The nearest warehouse within 50 kilometers of Amsterdam
+data "commercetools_channel" "warehouse" {
+  role      = "InventorySupply"
+  latitude  = 52.37
+  longitude = 4.89
+  radius    = 50000
+}
+
+resource "commercetools_store" "amsterdam" {
+  key                   = "amsterdam"
+  distribution_channels = [data.commercetools_channel.web.key]
+  supply_channels       = [data.commercetools_channel.warehouse.key]
+}
@@ -0,0 +1,16 @@
+data "commercetools_import_container_summary" "products" {
+  key = commercetools_import_container.products.key
+}
+
+resource "commercetools_import_container" "products" {
+  key           = "product-drafts"
+  resource_type = "product-draft"
+}
+
+output "import_errors" {
+  value = data.commercetools_import_container_summary.products.errors
+}
+
+output "import_finished" {
+  value = data.commercetools_import_container_summary.products.processing == 0
+}
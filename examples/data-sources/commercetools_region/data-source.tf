@@ -0,0 +1,16 @@
+data "commercetools_region" "us" {
+  alias   = "US-contiguous"
+  exclude = ["US-DC"]
+}
+
+resource "commercetools_shipping_zone" "us" {
+  name = "Contiguous US"
+
+  dynamic "location" {
+    for_each = data.commercetools_region.us.locations
+    content {
+      country = location.value.country
+      state   = location.value.state
+    }
+  }
+}
@@ -0,0 +1,12 @@
+data "commercetools_state_machine" "order" {
+  type = "OrderState"
+}
+
+resource "local_file" "order_workflow" {
+  filename = "${path.module}/docs/order-workflow.mmd"
+  content  = data.commercetools_state_machine.order.mermaid
+}
+
+output "order_workflow_dot" {
+  value = data.commercetools_state_machine.order.dot
+}
@@ -0,0 +1,7 @@
+data "commercetools_store" "nl" {
+  key = "nl"
+}
+
+output "nl_supply_channels" {
+  value = [for channel in data.commercetools_store.nl.supply_channels : channel.key]
+}
@@ -2,3 +2,35 @@ resource "commercetools_api_client" "my-api-client" {
   name  = "My API Client"
   scope = ["manage_orders:my-ct-project-key", "manage_payments:my-ct-project-key"]
 }
+
+# Replace the API client every 90 days, the previous API client remains
+# available until the next rotation
+resource "time_rotating" "api-client" {
+  rotation_days = 90
+}
+
+resource "commercetools_api_client" "my-rotated-api-client" {
+  name  = "My rotated API Client"
+  scope = ["view_products:my-ct-project-key"]
+
+  access_token_validity_seconds  = 3600
+  refresh_token_validity_seconds = 86400
+  delete_days_after_last_usage   = 30
+
+  rotation {
+    version       = time_rotating.api-client.unix
+    keep_previous = true
+  }
+}
+
+# Restrict the API client to the orders and customers of two stores, which
+# results in the scopes manage_orders:my-ct-project-key:nl and so on
+resource "commercetools_api_client" "my-store-api-client" {
+  name  = "My store API Client"
+  scope = ["view_products:my-ct-project-key"]
+
+  store_scoped {
+    store_keys  = ["nl", "be"]
+    permissions = ["manage_orders", "view_customers"]
+  }
+}
@@ -12,6 +12,14 @@ resource "commercetools_api_extension" "my-http-extension" {
     resource_type_id = "customer"
     actions          = ["Create", "Update"]
   }
+
+  # Verify that the extension responds with a valid extension response before
+  # it is created or updated
+  verify {
+    sample_resource = jsonencode({
+      email = "john.doe@example.com"
+    })
+  }
 }
 
 # AWS Lambda api extension
@@ -22,7 +30,11 @@ resource "commercetools_api_extension" "my-awslambda-extension" {
     type          = "awslambda"
     arn           = "us-east-1:123456789012:mylambda"
     access_key    = "mykey"
-    access_secret = "mysecret"
+    access_secret = var.lambda_access_secret
+
+    # Only a hash of the access secret is stored in the state. Increase the
+    # version to send the secret again after rotating it.
+    secret_version = 1
   }
 
   trigger {
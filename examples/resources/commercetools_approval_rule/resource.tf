@@ -0,0 +1,37 @@
+resource "commercetools_associate_role" "buyer" {
+  key         = "buyer"
+  name        = "Buyer"
+  permissions = ["CreateMyOrdersFromMyCarts"]
+}
+
+resource "commercetools_associate_role" "manager" {
+  key         = "manager"
+  name        = "Manager"
+  permissions = ["UpdateApprovalFlows"]
+}
+
+resource "commercetools_associate_role" "finance" {
+  key         = "finance"
+  name        = "Finance"
+  permissions = ["UpdateApprovalFlows"]
+}
+
+resource "commercetools_approval_rule" "large_orders" {
+  business_unit_key = "acme-inc"
+  associate_id      = "1a0e7ab2-9d2c-4f1e-b6a5-0c6e0b0d5e1f"
+  key               = "large-orders"
+  name              = "Large orders"
+  description       = "Orders over 1000 euro need approval of a manager and finance"
+  status            = "Active"
+  predicate         = "totalPrice.centAmount > 100000"
+  requesters        = [commercetools_associate_role.buyer.key]
+
+  approver_tier {
+    and {
+      or = [commercetools_associate_role.manager.key]
+    }
+    and {
+      or = [commercetools_associate_role.finance.key]
+    }
+  }
+}
@@ -132,3 +132,35 @@ resource "commercetools_cart_discount" "my-cart-discount" {
   }
   sort_order = "0.8"
 }
+
+# With target pattern: buy 2 shirts and get 50% off 1 trouser
+resource "commercetools_cart_discount" "my-cart-discount" {
+  key = "my-cart-discount-key"
+  name = {
+    en = "My Discount name"
+  }
+
+  value {
+    type      = "relative"
+    permyriad = 5000
+  }
+  predicate = "1=1"
+  target {
+    type           = "pattern"
+    selection_mode = "Cheapest"
+    max_occurrence = 1
+
+    trigger_pattern {
+      type      = "CountOnLineItemUnits"
+      predicate = "sku = \"shirt\""
+      min_count = 2
+    }
+
+    target_pattern {
+      type      = "CountOnLineItemUnits"
+      predicate = "sku = \"trousers\""
+      max_count = 1
+    }
+  }
+  sort_order = "0.8"
+}
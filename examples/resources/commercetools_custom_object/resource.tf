@@ -1,5 +1,26 @@
 resource "commercetools_custom_object" "my-custom-object" {
   container = "my-container"
   key       = "my-key"
-  value     = jsonencode(10)
+  value     = 10
+}
+
+resource "commercetools_custom_object" "storefront-config" {
+  container = "storefront"
+  key       = "checkout"
+  value = {
+    express_enabled = true
+    max_items       = 50
+    payment_methods = ["card", "paypal"]
+  }
+
+  json_schema = jsonencode({
+    type                 = "object"
+    required             = ["express_enabled", "payment_methods"]
+    additionalProperties = false
+    properties = {
+      express_enabled = { type = "boolean" }
+      max_items       = { type = "integer", minimum = 1 }
+      payment_methods = { type = "array", items = { enum = ["card", "paypal", "klarna"] } }
+    }
+  })
 }
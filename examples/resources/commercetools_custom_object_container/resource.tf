@@ -0,0 +1,12 @@
+resource "commercetools_custom_object_container" "feature_flags" {
+  container = "feature-flags"
+  exclusive = true
+
+  values = {
+    new-checkout = jsonencode({
+      enabled    = true
+      percentage = 25
+    })
+    search-provider = jsonencode("algolia")
+  }
+}
@@ -0,0 +1,47 @@
+resource "commercetools_customer_group" "b2b" {
+  key  = "b2b-buyers"
+  name = "B2B buyers"
+}
+
+resource "commercetools_customer" "b2b-buyer" {
+  key               = "b2b-buyer"
+  email             = "buyer@example.com"
+  password          = "my-secret-password"
+  company_name      = "Example Corp"
+  vat_id            = "NL123456789B01"
+  is_email_verified = true
+}
+
+resource "commercetools_customer" "grouped" {
+  key               = "gold-customer"
+  email             = "gold@example.com"
+  first_name        = "Jane"
+  last_name         = "Doe"
+  customer_group_id = commercetools_customer_group.b2b.id
+}
+
+resource "commercetools_customer" "with-addresses" {
+  key        = "customer-with-addresses"
+  email      = "addresses@example.com"
+  first_name = "John"
+  last_name  = "Doe"
+
+  address {
+    key           = "home"
+    country       = "NL"
+    city          = "Utrecht"
+    street_name   = "Reykjavikstraat"
+    street_number = "1"
+    postal_code   = "3543 KH"
+  }
+
+  address {
+    key         = "office"
+    country     = "DE"
+    city        = "Berlin"
+    street_name = "Friedrichstrasse"
+  }
+
+  default_shipping_address_key = "home"
+  default_billing_address_key  = "office"
+}
@@ -0,0 +1,37 @@
+resource "commercetools_discount_group" "brand-a" {
+  key = "brand-a"
+  name = {
+    en = "Brand A promotions"
+  }
+  description = {
+    en = "Only the best promotion of brand A is applied"
+  }
+  sort_order = "0.75"
+  is_active  = true
+}
+
+resource "commercetools_store" "brand-a" {
+  key = "brand-a"
+  name = {
+    en = "Brand A"
+  }
+}
+
+resource "commercetools_cart_discount" "brand-a-summer" {
+  key = "brand-a-summer"
+  name = {
+    en = "Summer sale"
+  }
+  value {
+    type      = "relative"
+    permyriad = 1000
+  }
+  predicate = "1=1"
+  target {
+    type      = "lineItems"
+    predicate = "1=1"
+  }
+  sort_order        = "0.7"
+  stores            = [commercetools_store.brand-a.key]
+  discount_group_id = commercetools_discount_group.brand-a.id
+}
@@ -0,0 +1,41 @@
+# The sort orders are not set on the discounts, they are managed by the
+# commercetools_discount_priority resource
+resource "commercetools_cart_discount" "summer" {
+  key = "summer"
+  name = {
+    en = "Summer sale"
+  }
+  value {
+    type      = "relative"
+    permyriad = 1000
+  }
+  predicate = "1=1"
+  target {
+    type      = "lineItems"
+    predicate = "1=1"
+  }
+}
+
+resource "commercetools_cart_discount" "loyalty" {
+  key = "loyalty"
+  name = {
+    en = "Loyalty discount"
+  }
+  value {
+    type      = "relative"
+    permyriad = 500
+  }
+  predicate = "customer.customerGroup.key = \"loyal\""
+  target {
+    type      = "lineItems"
+    predicate = "1=1"
+  }
+}
+
+resource "commercetools_discount_priority" "cart" {
+  discount_type = "cart"
+  discount_keys = [
+    commercetools_cart_discount.loyalty.key,
+    commercetools_cart_discount.summer.key,
+  ]
+}
@@ -0,0 +1,4 @@
+resource "commercetools_import_container" "products" {
+  key           = "product-drafts"
+  resource_type = "product-draft"
+}
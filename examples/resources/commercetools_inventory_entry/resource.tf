@@ -0,0 +1,13 @@
+resource "commercetools_channel" "warehouse" {
+  key   = "warehouse-amsterdam"
+  roles = ["InventorySupply"]
+}
+
+resource "commercetools_inventory_entry" "my-inventory-entry" {
+  key                 = "my-inventory-entry-key"
+  sku                 = "1001"
+  supply_channel_id   = commercetools_channel.warehouse.id
+  quantity_on_stock   = 100
+  restockable_in_days = 3
+  expected_delivery   = "2030-01-01T00:00:00Z"
+}
@@ -13,7 +13,19 @@ resource "commercetools_project_settings" "my-project" {
   }
   carts {
     country_tax_rate_fallback_enabled = true
+    price_rounding_mode               = "HalfEven"
+    tax_rounding_mode                 = "HalfEven"
+    tax_calculation_mode              = "LineItemLevel"
   }
+  business_units {
+    my_business_unit_status_on_creation             = "Active"
+    my_business_unit_associate_role_key_on_creation = "buyer"
+  }
+  shopping_lists {
+    delete_days_after_last_modification = 360
+  }
+  enable_search_index_customers = true
+
   shipping_rate_input_type = "CartClassification"
 
   shipping_rate_cart_classification_value {
@@ -17,3 +17,40 @@ resource "commercetools_subscription" "my-sqs-subscription" {
     types            = ["ProductPublished", "ProductCreated"]
   }
 }
+
+# Publish a test message to LocalStack before the subscription is created and
+# check that the subscription is healthy
+resource "commercetools_subscription" "my-localstack-subscription" {
+  key = "my-localstack-subscription-key"
+  destination {
+    type          = "SQS"
+    queue_url     = "https://sqs.eu-west-1.amazonaws.com/000000000000/my-queue"
+    access_key    = "test"
+    access_secret = "test"
+    region        = "eu-west-1"
+  }
+
+  message {
+    resource_type_id = "order"
+    types            = ["OrderCreated"]
+  }
+
+  test_delivery {
+    endpoint = "http://localhost:4566"
+  }
+}
+
+# Subscribe to the events of the Import API
+resource "commercetools_subscription" "my-import-events-subscription" {
+  key = "my-import-events-subscription-key"
+  destination {
+    type       = "GoogleCloudPubSub"
+    project_id = "my-project"
+    topic      = "import-events"
+  }
+
+  event {
+    resource_type_id = "import-api"
+    types            = ["ImportContainerCreated", "ImportOperationRejected"]
+  }
+}
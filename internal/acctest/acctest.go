@@ -20,12 +20,15 @@ var ProtoV5ProviderFactories map[string]func() (tfprotov5.ProviderServer, error)
 var Provider tfprotov5.ProviderServer
 
 func init() {
+	// Always set the factories, resource.Test needs them to be able to skip
+	// the acceptance tests when TF_ACC is not set.
+	ProtoV5ProviderFactories = protoV5ProviderFactoriesInit("commercetools")
+
 	if os.Getenv("TF_ACC") != "1" {
 		log.Println("TF_ACC is not set, skipping acceptance tests")
 		return
 	}
 
-	ProtoV5ProviderFactories = protoV5ProviderFactoriesInit("commercetools")
 	newProvider := providerserver.NewProtocol5(provider.New("testing"))()
 	if err := ConfigureProvider(newProvider); err != nil {
 		panic(err)
@@ -63,7 +66,7 @@ func init() {
 	// Mux the new and the old provider
 	providers := []func() tfprotov5.ProviderServer{
 		func() tfprotov5.ProviderServer { return newProvider },
-		sdkProvider.GRPCProvider,
+		commercetools.NewProviderServer(sdkProvider),
 	}
 
 	ctx := context.Background()
@@ -111,22 +114,32 @@ func TestAccPreCheck(t *testing.T) {
 func ConfigureProvider(p tfprotov5.ProviderServer) error {
 	testType := tftypes.Object{
 		AttributeTypes: map[string]tftypes.Type{
-			"client_id":     tftypes.String,
-			"client_secret": tftypes.String,
-			"project_key":   tftypes.String,
-			"scopes":        tftypes.String,
-			"api_url":       tftypes.String,
-			"token_url":     tftypes.String,
+			"client_id":      tftypes.String,
+			"client_secret":  tftypes.String,
+			"project_key":    tftypes.String,
+			"scopes":         tftypes.String,
+			"api_url":        tftypes.String,
+			"token_url":      tftypes.String,
+			"import_api_url": tftypes.String,
+
+			"history_api_url":        tftypes.String,
+			"history_drift_warnings": tftypes.Bool,
+			"validate_locales":       tftypes.Bool,
 		},
 	}
 
 	testValue := tftypes.NewValue(testType, map[string]tftypes.Value{
-		"client_id":     tftypes.NewValue(tftypes.String, os.Getenv("CTP_CLIENT_ID")),
-		"client_secret": tftypes.NewValue(tftypes.String, os.Getenv("CTP_CLIENT_SECRET")),
-		"project_key":   tftypes.NewValue(tftypes.String, os.Getenv("CTP_PROJECT_KEY")),
-		"scopes":        tftypes.NewValue(tftypes.String, os.Getenv("CTP_SCOPES")),
-		"api_url":       tftypes.NewValue(tftypes.String, os.Getenv("CTP_API_URL")),
-		"token_url":     tftypes.NewValue(tftypes.String, os.Getenv("CTP_AUTH_URL")),
+		"client_id":      tftypes.NewValue(tftypes.String, os.Getenv("CTP_CLIENT_ID")),
+		"client_secret":  tftypes.NewValue(tftypes.String, os.Getenv("CTP_CLIENT_SECRET")),
+		"project_key":    tftypes.NewValue(tftypes.String, os.Getenv("CTP_PROJECT_KEY")),
+		"scopes":         tftypes.NewValue(tftypes.String, os.Getenv("CTP_SCOPES")),
+		"api_url":        tftypes.NewValue(tftypes.String, os.Getenv("CTP_API_URL")),
+		"token_url":      tftypes.NewValue(tftypes.String, os.Getenv("CTP_AUTH_URL")),
+		"import_api_url": tftypes.NewValue(tftypes.String, nil),
+
+		"history_api_url":        tftypes.NewValue(tftypes.String, nil),
+		"history_drift_warnings": tftypes.NewValue(tftypes.Bool, nil),
+		"validate_locales":       tftypes.NewValue(tftypes.Bool, nil),
 	})
 
 	testDynamicValue, err := tfprotov5.NewDynamicValue(testType, testValue)
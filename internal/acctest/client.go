@@ -1,6 +1,7 @@
 package acctest
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"net/http"
@@ -9,24 +10,24 @@ import (
 
 	"github.com/labd/commercetools-go-sdk/ctutils"
 	"github.com/labd/commercetools-go-sdk/platform"
+	"golang.org/x/oauth2"
 	"golang.org/x/oauth2/clientcredentials"
+
+	"github.com/labd/terraform-provider-commercetools/internal/utils"
 )
 
+func oauth2Config() *clientcredentials.Config {
+	return &clientcredentials.Config{
+		ClientID:     os.Getenv("CTP_CLIENT_ID"),
+		ClientSecret: os.Getenv("CTP_CLIENT_SECRET"),
+		Scopes:       strings.Split(os.Getenv("CTP_SCOPES"), " "),
+		TokenURL:     fmt.Sprintf("%s/oauth/token", os.Getenv("CTP_AUTH_URL")),
+	}
+}
+
 func GetClient() (*platform.ByProjectKeyRequestBuilder, error) {
-	clientID := os.Getenv("CTP_CLIENT_ID")
-	clientSecret := os.Getenv("CTP_CLIENT_SECRET")
 	projectKey := os.Getenv("CTP_PROJECT_KEY")
-	authURL := os.Getenv("CTP_AUTH_URL")
 	apiURL := os.Getenv("CTP_API_URL")
-	scopesRaw := os.Getenv("CTP_SCOPES")
-
-	oauthScopes := strings.Split(scopesRaw, " ")
-	oauth2Config := &clientcredentials.Config{
-		ClientID:     clientID,
-		ClientSecret: clientSecret,
-		Scopes:       oauthScopes,
-		TokenURL:     fmt.Sprintf("%s/oauth/token", authURL),
-	}
 
 	httpClient := &http.Client{
 		Transport: ctutils.DebugTransport,
@@ -34,7 +35,7 @@ func GetClient() (*platform.ByProjectKeyRequestBuilder, error) {
 
 	client, err := platform.NewClient(&platform.ClientConfig{
 		URL:         apiURL,
-		Credentials: oauth2Config,
+		Credentials: oauth2Config(),
 		UserAgent:   "terraform-provider-commercetools/testing",
 		HTTPClient:  httpClient,
 	})
@@ -45,6 +46,16 @@ func GetClient() (*platform.ByProjectKeyRequestBuilder, error) {
 	return client.WithProjectKey(projectKey), nil
 }
 
+// GetRawClient returns a client for the resources which are not supported by
+// the SDK yet.
+func GetRawClient() *utils.RawClient {
+	httpClient := oauth2Config().Client(context.WithValue(context.Background(), oauth2.HTTPClient, &http.Client{
+		Transport: ctutils.DebugTransport,
+	}))
+	return utils.NewRawClient(httpClient, os.Getenv("CTP_API_URL"), os.Getenv("CTP_PROJECT_KEY"),
+		"terraform-provider-commercetools/testing")
+}
+
 func CheckApiResult(err error) error {
 	if errors.Is(err, platform.ErrNotFound) {
 		return nil
@@ -0,0 +1,475 @@
+// Package cartpredicate evaluates commercetools [Cart Predicates], as used in
+// the predicates and targets of cart discounts. The predicates are parsed by
+// the predicate package.
+//
+// [Cart Predicates]: https://docs.commercetools.com/api/predicates/predicate-operators
+package cartpredicate
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/labd/terraform-provider-commercetools/internal/predicate"
+)
+
+// Predicate is a parsed cart predicate.
+type Predicate struct {
+	root predicate.Expression
+}
+
+// grammar is the grammar of cart predicates, with the functions on the line
+// items and custom line items of the cart.
+var grammar = func() predicate.Grammar {
+	result := predicate.Grammar{Operands: true, Functions: map[string]bool{}}
+	for name, fn := range functions {
+		result.Functions[name] = fn.boolean
+	}
+	return result
+}()
+
+// Parse parses the cart predicate. The returned error contains the position of
+// the first syntax error.
+func Parse(input string) (*Predicate, error) {
+	root, err := predicate.ParseExpression(input, grammar)
+	if err != nil {
+		return nil, err
+	}
+	return &Predicate{root: root}, nil
+}
+
+// The kinds of resources a predicate is evaluated against. Cart discount
+// predicates are evaluated against the cart, target predicates against the
+// line items or custom line items of the cart.
+const (
+	kindCart           = "cart"
+	kindLineItem       = "lineItem"
+	kindCustomLineItem = "customLineItem"
+)
+
+// scope is the resource the predicate is evaluated against. The cart is
+// always set so functions like lineItemCount(...) can be evaluated, the item
+// only for line item and custom line item predicates.
+type scope struct {
+	kind string
+	cart map[string]any
+	item map[string]any
+}
+
+func (s scope) object() map[string]any {
+	if s.item != nil {
+		return s.item
+	}
+	return s.cart
+}
+
+// EvalCart evaluates the predicate against the cart, as decoded from the JSON
+// representation returned by the commercetools API.
+//
+// Fields which are not present in the cart don't match any comparison.
+// Comparisons on arrays match when any of the elements match.
+func (p *Predicate) EvalCart(cart map[string]any) (bool, error) {
+	return eval(p.root, scope{kind: kindCart, cart: cart})
+}
+
+// EvalLineItem evaluates the predicate against a line item of the cart, as
+// used by the line item target of a cart discount.
+func (p *Predicate) EvalLineItem(cart, item map[string]any) (bool, error) {
+	return eval(p.root, scope{kind: kindLineItem, cart: cart, item: item})
+}
+
+// EvalCustomLineItem evaluates the predicate against a custom line item of the
+// cart, as used by the custom line item target of a cart discount.
+func (p *Predicate) EvalCustomLineItem(cart, item map[string]any) (bool, error) {
+	return eval(p.root, scope{kind: kindCustomLineItem, cart: cart, item: item})
+}
+
+func eval(e predicate.Expression, s scope) (bool, error) {
+	switch n := e.(type) {
+	case *predicate.And:
+		left, err := eval(n.Left, s)
+		if err != nil || !left {
+			return false, err
+		}
+		return eval(n.Right, s)
+
+	case *predicate.Or:
+		left, err := eval(n.Left, s)
+		if err != nil || left {
+			return left, err
+		}
+		return eval(n.Right, s)
+
+	case *predicate.Not:
+		result, err := eval(n.Expr, s)
+		return !result, err
+
+	case *predicate.Compare:
+		return evalCompare(n, s)
+	case *predicate.Is:
+		return evalIs(n, s)
+
+	case *predicate.Value, *predicate.Function:
+		v, err := value(n.(predicate.Operand), s)
+		if err != nil {
+			return false, err
+		}
+		result, ok := v.(bool)
+		if !ok {
+			return false, fmt.Errorf("%s is not a condition", describe(n.(predicate.Operand)))
+		}
+		return result, nil
+	}
+	return false, fmt.Errorf("unsupported condition %T", e)
+}
+
+func evalCompare(n *predicate.Compare, s scope) (bool, error) {
+	left, err := value(n.Left, s)
+	if err != nil || left == nil {
+		return false, err
+	}
+
+	values := make([]any, len(n.Values))
+	for i, o := range n.Values {
+		if values[i], err = value(o, s); err != nil {
+			return false, err
+		}
+	}
+
+	switch n.Op {
+	case "contains", "contains any", "contains all":
+		items, ok := left.([]any)
+		if !ok {
+			return false, fmt.Errorf("%s is not an array", describe(n.Left))
+		}
+		matches := 0
+		for _, expected := range values {
+			for _, item := range items {
+				if equal(normalize(item), expected) {
+					matches++
+					break
+				}
+			}
+		}
+		if n.Op == "contains all" {
+			return matches == len(values), nil
+		}
+		return matches > 0, nil
+	}
+
+	if items, ok := left.([]any); ok {
+		for _, item := range items {
+			if predicate.Matches(n.Op, normalize(item), values, equal, order) {
+				return true, nil
+			}
+		}
+		return false, nil
+	}
+	if _, ok := left.(map[string]any); ok {
+		return false, fmt.Errorf("%s is an object and can't be compared, use one of its fields", describe(n.Left))
+	}
+	return predicate.Matches(n.Op, left, values, equal, order), nil
+}
+
+func evalIs(n *predicate.Is, s scope) (bool, error) {
+	v, err := value(n.Operand, s)
+	if err != nil {
+		return false, err
+	}
+
+	var result bool
+	switch n.Check {
+	case "defined":
+		result = v != nil
+	case "empty":
+		switch v := v.(type) {
+		case nil:
+			result = true
+		case []any:
+			result = len(v) == 0
+		case string:
+			result = v == ""
+		}
+	}
+	return result != n.Negate, nil
+}
+
+// value returns the value of the operand. Numbers are returned as *big.Rat
+// and money as Money, missing fields as nil.
+func value(o predicate.Operand, s scope) (any, error) {
+	switch v := o.(type) {
+	case *predicate.Value:
+		return v.Value, nil
+	case *predicate.Field:
+		return fieldValue(v, s), nil
+	case *predicate.Function:
+		return functionValue(v, s)
+	}
+	return nil, fmt.Errorf("unsupported operand %T", o)
+}
+
+func fieldValue(f *predicate.Field, s scope) any {
+	var current any = s.object()
+	path := f.Path
+	if alias, ok := aliases[s.kind][path[0]]; ok && s.object()[path[0]] == nil {
+		path = append(append([]string{}, alias...), path[1:]...)
+	}
+
+	for i, name := range path {
+		current = step(current, name)
+		if name == "custom" && i+1 < len(path) && path[i+1] != "type" && path[i+1] != "fields" {
+			current = step(current, "fields")
+		}
+		if name == "attributes" {
+			current = attributeMap(current)
+		}
+	}
+
+	// The price of a line item is compared by its value
+	if s.kind == kindLineItem && len(f.Path) == 1 && f.Path[0] == "price" {
+		if price, ok := current.(map[string]any); ok && price["value"] != nil {
+			current = price["value"]
+		}
+	}
+	return normalize(current)
+}
+
+// aliases are the shorthand fields of the predicates, which resolve to a
+// different path in the JSON representation of the resource.
+var aliases = map[string]map[string][]string{
+	kindCart: {
+		"currency": {"totalPrice", "currencyCode"},
+	},
+	kindLineItem: {
+		"sku":        {"variant", "sku"},
+		"attributes": {"variant", "attributes"},
+	},
+}
+
+// step returns the field of the value. Fields of expanded references are
+// looked up in the referenced object, fields of arrays in each element.
+func step(value any, name string) any {
+	switch v := value.(type) {
+	case map[string]any:
+		if result, ok := v[name]; ok {
+			return result
+		}
+		if obj, ok := v["obj"].(map[string]any); ok {
+			return obj[name]
+		}
+		return nil
+	case []any:
+		result := []any{}
+		for _, item := range v {
+			switch elem := step(item, name).(type) {
+			case nil:
+			case []any:
+				result = append(result, elem...)
+			default:
+				result = append(result, elem)
+			}
+		}
+		return result
+	}
+	return nil
+}
+
+// attributeMap converts the attributes of a product variant, which are an
+// array of name and value pairs, to an object.
+func attributeMap(value any) any {
+	items, ok := value.([]any)
+	if !ok {
+		return value
+	}
+	result := map[string]any{}
+	for _, item := range items {
+		if attribute, ok := item.(map[string]any); ok {
+			if name, ok := attribute["name"].(string); ok {
+				result[name] = attribute["value"]
+			}
+		}
+	}
+	return result
+}
+
+func functionValue(f *predicate.Function, s scope) (any, error) {
+	fn := functions[f.Name]
+	var matched []map[string]any
+	all := true
+	items, _ := s.cart[fn.items].([]any)
+	for _, item := range items {
+		elem, ok := item.(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf("%s of the cart are not an array of objects", fn.items)
+		}
+		match, err := eval(f.Expr, scope{kind: fn.kind, cart: s.cart, item: elem})
+		if err != nil {
+			return nil, err
+		}
+		if match {
+			matched = append(matched, elem)
+		} else {
+			all = false
+		}
+	}
+	return fn.result(s.cart, matched, all), nil
+}
+
+// function is a function on the line items or custom line items of the cart.
+// The result is computed from the items which match the predicate.
+type function struct {
+	items   string
+	kind    string
+	boolean bool
+	result  func(cart map[string]any, matched []map[string]any, all bool) any
+}
+
+var functions = map[string]function{
+	"lineItemCount":            countFunction("lineItems", kindLineItem),
+	"customLineItemCount":      countFunction("customLineItems", kindCustomLineItem),
+	"lineItemTotal":            totalFunction("lineItems", kindLineItem, "totalPrice"),
+	"lineItemNetTotal":         totalFunction("lineItems", kindLineItem, "taxedPrice", "totalNet"),
+	"lineItemGrossTotal":       totalFunction("lineItems", kindLineItem, "taxedPrice", "totalGross"),
+	"customLineItemTotal":      totalFunction("customLineItems", kindCustomLineItem, "totalPrice"),
+	"customLineItemNetTotal":   totalFunction("customLineItems", kindCustomLineItem, "taxedPrice", "totalNet"),
+	"customLineItemGrossTotal": totalFunction("customLineItems", kindCustomLineItem, "taxedPrice", "totalGross"),
+	"lineItemExists":           existsFunction("lineItems", kindLineItem),
+	"customLineItemExists":     existsFunction("customLineItems", kindCustomLineItem),
+	"forAllLineItems":          forAllFunction("lineItems", kindLineItem),
+	"forAllCustomLineItems":    forAllFunction("customLineItems", kindCustomLineItem),
+}
+
+// countFunction returns the total quantity of the matching items.
+func countFunction(items, kind string) function {
+	return function{
+		items: items,
+		kind:  kind,
+		result: func(_ map[string]any, matched []map[string]any, _ bool) any {
+			result := new(big.Rat)
+			for _, item := range matched {
+				if quantity, ok := normalize(item["quantity"]).(*big.Rat); ok {
+					result.Add(result, quantity)
+				} else {
+					result.Add(result, big.NewRat(1, 1))
+				}
+			}
+			return result
+		},
+	}
+}
+
+// totalFunction returns the sum of the price at the path of the matching
+// items. When the path is not present, for example because the cart has no
+// taxes, the total price of the item is used.
+func totalFunction(items, kind string, path ...string) function {
+	return function{
+		items: items,
+		kind:  kind,
+		result: func(cart map[string]any, matched []map[string]any, _ bool) any {
+			currency, _ := step(cart["totalPrice"], "currencyCode").(string)
+			result := Money{CurrencyCode: currency, Amount: new(big.Rat)}
+			for _, item := range matched {
+				var value any = item
+				for _, name := range path {
+					value = step(value, name)
+				}
+				if value == nil {
+					value = item["totalPrice"]
+				}
+				if m, ok := normalize(value).(Money); ok {
+					result.CurrencyCode = m.CurrencyCode
+					result.Amount.Add(result.Amount, m.Amount)
+				}
+			}
+			return result
+		},
+	}
+}
+
+func existsFunction(items, kind string) function {
+	return function{
+		items:   items,
+		kind:    kind,
+		boolean: true,
+		result: func(_ map[string]any, matched []map[string]any, _ bool) any {
+			return len(matched) > 0
+		},
+	}
+}
+
+func forAllFunction(items, kind string) function {
+	return function{
+		items:   items,
+		kind:    kind,
+		boolean: true,
+		result: func(_ map[string]any, _ []map[string]any, all bool) any {
+			return all
+		},
+	}
+}
+
+// describe returns a description of the operand for error messages.
+func describe(o predicate.Operand) string {
+	switch v := o.(type) {
+	case *predicate.Field:
+		return fmt.Sprintf("field %q at position %d", v.Name(), v.Pos+1)
+	case *predicate.Function:
+		return fmt.Sprintf("%s at position %d", v.Name, v.Pos+1)
+	}
+	return "value"
+}
+
+// normalize converts JSON numbers to *big.Rat and JSON money objects to Money,
+// so they can be compared with the literals of the predicate.
+func normalize(value any) any {
+	if r, ok := predicate.Number(value); ok {
+		return r
+	}
+	switch v := value.(type) {
+	case map[string]any:
+		if m, ok := MoneyFromJSON(v); ok {
+			return m
+		}
+	}
+	return value
+}
+
+func equal(a, b any) bool {
+	if x, ok := a.(bool); ok {
+		y, ok := b.(bool)
+		return ok && x == y
+	}
+	c, ok := order(a, b)
+	return ok && c == 0
+}
+
+// order compares two strings, numbers or money values. Money is compared with
+// money literals like "10.00 EUR". The second return value is false if the
+// values can't be compared, for example money in different currencies.
+func order(a, b any) (int, bool) {
+	switch x := a.(type) {
+	case string:
+		switch y := b.(type) {
+		case string:
+			return strings.Compare(x, y), true
+		case Money:
+			if m, ok := ParseMoney(x); ok {
+				return m.compare(y)
+			}
+		}
+	case *big.Rat:
+		if y, ok := b.(*big.Rat); ok {
+			return x.Cmp(y), true
+		}
+	case Money:
+		switch y := b.(type) {
+		case Money:
+			return x.compare(y)
+		case string:
+			if m, ok := ParseMoney(y); ok {
+				return x.compare(m)
+			}
+		}
+	}
+	return 0, false
+}
@@ -0,0 +1,198 @@
+package cartpredicate
+
+import (
+	"encoding/json"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testCart = `{
+	"country": "NL",
+	"customerEmail": "john@example.com",
+	"customerGroup": {"typeId": "customer-group", "id": "1", "obj": {"key": "loyal"}},
+	"totalPrice": {"type": "centPrecision", "currencyCode": "EUR", "centAmount": 12500, "fractionDigits": 2},
+	"lineItems": [
+		{
+			"quantity": 1,
+			"productKey": "shirt",
+			"variant": {"sku": "shirt-m", "attributes": [{"name": "color", "value": "red"}]},
+			"price": {"value": {"currencyCode": "EUR", "centAmount": 5000}},
+			"totalPrice": {"currencyCode": "EUR", "centAmount": 5000},
+			"categories": [{"id": "c1", "key": "tops"}, {"id": "c2", "key": "sale"}]
+		},
+		{
+			"quantity": 3,
+			"productKey": "socks",
+			"variant": {"sku": "socks", "attributes": [{"name": "color", "value": "blue"}]},
+			"price": {"value": {"currencyCode": "EUR", "centAmount": 1000}},
+			"totalPrice": {"currencyCode": "EUR", "centAmount": 3000},
+			"taxedPrice": {"totalNet": {"currencyCode": "EUR", "centAmount": 2479}}
+		}
+	],
+	"customLineItems": [
+		{"slug": "gift-wrap", "quantity": 1, "money": {"currencyCode": "EUR", "centAmount": 500}, "totalPrice": {"currencyCode": "EUR", "centAmount": 500}}
+	],
+	"custom": {"fields": {"isGift": true, "tags": ["b2b", "promo"]}}
+}`
+
+func decodeTestCart(t *testing.T) map[string]any {
+	decoder := json.NewDecoder(strings.NewReader(testCart))
+	decoder.UseNumber()
+
+	var cart map[string]any
+	require.NoError(t, decoder.Decode(&cart))
+	return cart
+}
+
+func TestParse(t *testing.T) {
+	testCases := []struct {
+		predicate string
+		err       string
+	}{
+		{predicate: `1 = 1`},
+		{predicate: `true`},
+		{predicate: `totalPrice > "100.00 EUR" and customer.email is defined`},
+		{predicate: `lineItemCount(sku in ("a", "b")) >= 2 or not(country <> "NL")`},
+		{predicate: `lineItemExists(attributes.color = "red")`},
+		{predicate: `custom.tags contains any ("a", "b") and currency not in ("USD")`},
+		{predicate: `country = `, err: `expected a value or field but got end of predicate at position 11`},
+		{predicate: `country`, err: `expected an operator after "country" but got end of predicate at position 8`},
+		{predicate: `lineItemCount(1 = 1)`, err: `expected a condition but got "lineItemCount" at position 1`},
+		{predicate: `lineItemSum(1 = 1) > 1`, err: `unknown function "lineItemSum" at position 1`},
+		{predicate: `customer. = "x"`, err: `expected a field name but got "=" at position 11`},
+		{predicate: `sku is blank`, err: `expected "defined" or "empty" but got "blank" at position 8`},
+		{predicate: `sku = "a" sku`, err: `unexpected "sku" at position 11`},
+		{predicate: `sku = "a`, err: `unterminated string at position 7`},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.predicate, func(t *testing.T) {
+			_, err := Parse(tc.predicate)
+			if tc.err == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tc.err)
+			}
+		})
+	}
+}
+
+func TestEvalCart(t *testing.T) {
+	cart := decodeTestCart(t)
+
+	testCases := []struct {
+		predicate string
+		expected  bool
+	}{
+		{`1 = 1`, true},
+		{`true and not(false)`, true},
+		{`country = "NL"`, true},
+		{`country <> "NL"`, false},
+		{`country in ("BE", "NL")`, true},
+		{`country not in ("BE", "NL")`, false},
+		{`currency = "EUR"`, true},
+		{`totalPrice > "100.00 EUR"`, true},
+		{`totalPrice >= "125 EUR" and totalPrice <= "125.00 EUR"`, true},
+		{`totalPrice > "10.00 USD"`, false},
+		{`customerGroup.key = "loyal"`, true},
+		{`customerEmail is defined`, true},
+		{`shippingAddress is defined`, false},
+		{`shippingAddress.country = "NL"`, false},
+		{`custom.isGift = true`, true},
+		{`custom.fields.isGift = true`, true},
+		{`custom.tags contains "promo"`, true},
+		{`custom.tags contains all ("b2b", "sale")`, false},
+		{`custom.tags contains any ("b2b", "sale")`, true},
+		{`lineItemCount(1 = 1) = 4`, true},
+		{`lineItemCount(sku = "socks") > 2`, true},
+		{`lineItemTotal(attributes.color = "red") = "50.00 EUR"`, true},
+		{`lineItemNetTotal(1 = 1) = "74.79 EUR"`, true},
+		{`lineItemTotal(sku = "shoes") = "0 EUR"`, true},
+		{`lineItemExists(categories.key contains "sale")`, true},
+		{`lineItemExists(categories.key = "shoes")`, false},
+		{`forAllLineItems(price >= "10.00 EUR")`, true},
+		{`forAllLineItems(quantity > 1)`, false},
+		{`customLineItemExists(slug = "gift-wrap") and customLineItemCount(1 = 1) = 1`, true},
+		{`customLineItemTotal(1 = 1) < "5.01 EUR"`, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.predicate, func(t *testing.T) {
+			p, err := Parse(tc.predicate)
+			require.NoError(t, err)
+
+			result, err := p.EvalCart(cart)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, result)
+		})
+	}
+}
+
+func TestEvalLineItem(t *testing.T) {
+	cart := decodeTestCart(t)
+	items := cart["lineItems"].([]any)
+
+	testCases := []struct {
+		predicate string
+		expected  []bool
+	}{
+		{`sku = "socks"`, []bool{false, true}},
+		{`price > "20.00 EUR"`, []bool{true, false}},
+		{`quantity >= 3 or productKey = "shirt"`, []bool{true, true}},
+		{`attributes.color in ("red", "green")`, []bool{true, false}},
+		{`categories.id contains "c2"`, []bool{true, false}},
+		{`categories is empty`, []bool{false, true}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.predicate, func(t *testing.T) {
+			p, err := Parse(tc.predicate)
+			require.NoError(t, err)
+
+			for i, item := range items {
+				result, err := p.EvalLineItem(cart, item.(map[string]any))
+				require.NoError(t, err)
+				assert.Equal(t, tc.expected[i], result, "line item %d", i)
+			}
+		})
+	}
+}
+
+func TestEvalErrors(t *testing.T) {
+	cart := decodeTestCart(t)
+
+	p, err := Parse(`country = "BE" or customerGroup = "loyal"`)
+	require.NoError(t, err)
+	_, err = p.EvalCart(cart)
+	assert.EqualError(t, err, `field "customerGroup" at position 19 is an object and can't be compared, use one of its fields`)
+
+	p, err = Parse(`country contains "N"`)
+	require.NoError(t, err)
+	_, err = p.EvalCart(cart)
+	assert.EqualError(t, err, `field "country" at position 1 is not an array`)
+}
+
+func TestMoney(t *testing.T) {
+	m, ok := ParseMoney("10.5 EUR")
+	require.True(t, ok)
+	assert.Equal(t, "EUR", m.CurrencyCode)
+	assert.Equal(t, big.NewRat(21, 2), m.Amount)
+
+	_, ok = ParseMoney("EUR 10")
+	assert.False(t, ok)
+
+	m, ok = MoneyFromJSON(map[string]any{
+		"type":           "highPrecision",
+		"currencyCode":   "EUR",
+		"centAmount":     json.Number("1"),
+		"preciseAmount":  json.Number("123"),
+		"fractionDigits": json.Number("4"),
+	})
+	require.True(t, ok)
+	assert.Equal(t, "0.01 EUR", m.String())
+	assert.Equal(t, big.NewRat(123, 10000), m.Amount)
+}
@@ -0,0 +1,85 @@
+package cartpredicate
+
+import (
+	"math/big"
+	"regexp"
+	"strconv"
+)
+
+// Money is an amount in a currency. The amount is in the major unit of the
+// currency, so 1050 cent is represented as 10.5.
+type Money struct {
+	CurrencyCode string
+	Amount       *big.Rat
+}
+
+var moneyPattern = regexp.MustCompile(`^\s*(-?\d+(?:\.\d+)?)\s+([A-Z]{3})\s*$`)
+
+// ParseMoney parses a money literal like "10.00 EUR".
+func ParseMoney(value string) (Money, bool) {
+	match := moneyPattern.FindStringSubmatch(value)
+	if match == nil {
+		return Money{}, false
+	}
+	amount, ok := new(big.Rat).SetString(match[1])
+	if !ok {
+		return Money{}, false
+	}
+	return Money{CurrencyCode: match[2], Amount: amount}, true
+}
+
+// MoneyFromJSON converts the JSON representation of money, with a
+// currencyCode, centAmount and optional fractionDigits or preciseAmount, to
+// Money.
+func MoneyFromJSON(value map[string]any) (Money, bool) {
+	currency, ok := value["currencyCode"].(string)
+	if !ok {
+		return Money{}, false
+	}
+
+	amount, ok := jsonInteger(value["centAmount"])
+	if !ok {
+		return Money{}, false
+	}
+	digits := int64(2)
+	if d, ok := jsonInteger(value["fractionDigits"]); ok {
+		digits = d.Int64()
+	}
+	if precise, ok := jsonInteger(value["preciseAmount"]); ok {
+		amount = precise
+	}
+
+	scale := new(big.Int).Exp(big.NewInt(10), big.NewInt(digits), nil)
+	return Money{CurrencyCode: currency, Amount: new(big.Rat).SetFrac(amount, scale)}, true
+}
+
+// String returns the money as literal, e.g. "10.5 EUR".
+func (m Money) String() string {
+	return m.Amount.FloatString(2) + " " + m.CurrencyCode
+}
+
+func (m Money) compare(other Money) (int, bool) {
+	if m.CurrencyCode != other.CurrencyCode {
+		return 0, false
+	}
+	return m.Amount.Cmp(other.Amount), true
+}
+
+// jsonInteger returns the integer value of a decoded JSON number.
+func jsonInteger(value any) (*big.Int, bool) {
+	switch v := value.(type) {
+	case float64:
+		return big.NewInt(int64(v)), true
+	case int:
+		return big.NewInt(int64(v)), true
+	case int64:
+		return big.NewInt(v), true
+	case interface{ String() string }:
+		if _, err := strconv.ParseInt(v.String(), 10, 64); err != nil {
+			return nil, false
+		}
+		result, ok := new(big.Int).SetString(v.String(), 10)
+		return result, ok
+	}
+	return nil, false
+}
@@ -0,0 +1,62 @@
+package custommodifiers
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/labd/terraform-provider-commercetools/internal/utils"
+)
+
+// hashedSecretModifier is a plan modifier that plans the hash of a secret
+// instead of the secret itself, when the version attribute next to it is set.
+// The attribute must be marked as Optional and Computed, since the planned
+// value differs from the configured value. The resource must use the
+// configured value when calling the API, and store the planned hash in the
+// state.
+type hashedSecretModifier struct {
+	VersionAttribute string
+}
+
+// Description returns a plain text description of the modifier's behavior, suitable for a practitioner to understand its impact.
+func (m hashedSecretModifier) Description(ctx context.Context) string {
+	return fmt.Sprintf("If %s is set, only a hash of the value is stored in the state", m.VersionAttribute)
+}
+
+// MarkdownDescription returns a markdown formatted description of the modifier's behavior, suitable for a practitioner to understand its impact.
+func (m hashedSecretModifier) MarkdownDescription(ctx context.Context) string {
+	return fmt.Sprintf("If `%s` is set, only a hash of the value is stored in the state", m.VersionAttribute)
+}
+
+func (m hashedSecretModifier) PlanModifyString(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		resp.PlanValue = req.ConfigValue
+		return
+	}
+
+	var version types.Int64
+	diags := req.Config.GetAttribute(ctx, req.Path.ParentPath().AtName(m.VersionAttribute), &version)
+	resp.Diagnostics.Append(diags...)
+	if diags.HasError() {
+		return
+	}
+
+	switch {
+	case version.IsUnknown():
+		resp.PlanValue = types.StringUnknown()
+	case version.IsNull() || version.ValueInt64() == 0:
+		resp.PlanValue = req.ConfigValue
+	default:
+		resp.PlanValue = types.StringValue(utils.HashSecret(req.ConfigValue.ValueString()))
+	}
+}
+
+// HashedSecret returns a plan modifier which plans the hash of the secret when
+// the version attribute in the same object is set.
+func HashedSecret(versionAttribute string) planmodifier.String {
+	return hashedSecretModifier{
+		VersionAttribute: versionAttribute,
+	}
+}
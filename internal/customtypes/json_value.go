@@ -0,0 +1,322 @@
+package customtypes
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var (
+	_ basetypes.DynamicTypable                    = JSONType{}
+	_ basetypes.DynamicValuableWithSemanticEquals = JSONValue{}
+)
+
+// JSONType is a dynamic type for values which are stored as JSON, for example
+// the value of a custom object. Any HCL value is accepted. For backwards
+// compatibility a string containing a JSON document, for example the result
+// of jsonencode(), is stored as the decoded document.
+type JSONType struct {
+	basetypes.DynamicType
+}
+
+func (t JSONType) Equal(o attr.Type) bool {
+	_, ok := o.(JSONType)
+	return ok
+}
+
+func (t JSONType) String() string {
+	return "customtypes.JSONType"
+}
+
+func (t JSONType) ValueFromDynamic(_ context.Context, in basetypes.DynamicValue) (basetypes.DynamicValuable, diag.Diagnostics) {
+	return JSONValue{DynamicValue: in}, nil
+}
+
+func (t JSONType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.DynamicType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	dynamicValue, ok := attrValue.(basetypes.DynamicValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	value, diags := t.ValueFromDynamic(ctx, dynamicValue)
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting DynamicValue to JSONValue: %v", diags)
+	}
+	return value, nil
+}
+
+func (t JSONType) ValueType(_ context.Context) attr.Value {
+	return JSONValue{}
+}
+
+// JSONValue is the value of a JSONType.
+type JSONValue struct {
+	basetypes.DynamicValue
+}
+
+func NewJSONNull() JSONValue {
+	return JSONValue{basetypes.NewDynamicNull()}
+}
+
+func NewJSONUnknown() JSONValue {
+	return JSONValue{basetypes.NewDynamicUnknown()}
+}
+
+// NewJSONValue creates the value from a decoded JSON document, for example
+// the value of a custom object as returned by the API. Objects are converted
+// to HCL objects and arrays to HCL tuples.
+func NewJSONValue(data any) (JSONValue, error) {
+	value, err := jsonToValue(data)
+	if err != nil {
+		return JSONValue{}, err
+	}
+	return JSONValue{basetypes.NewDynamicValue(value)}, nil
+}
+
+func (v JSONValue) Type(_ context.Context) attr.Type {
+	return JSONType{}
+}
+
+func (v JSONValue) Equal(o attr.Value) bool {
+	other, ok := o.(JSONValue)
+	if !ok {
+		return false
+	}
+	return v.DynamicValue.Equal(other.DynamicValue)
+}
+
+// DynamicSemanticEquals returns true if both values are the same JSON
+// document, regardless of the order of the keys, the formatting of numbers or
+// whether the value is given as a JSON encoded string.
+func (v JSONValue) DynamicSemanticEquals(_ context.Context, newValuable basetypes.DynamicValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(JSONValue)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			"An unexpected value type was received while performing semantic equality checks. "+
+				"Please report this to the provider developers.\n\n"+
+				"Expected Value Type: "+fmt.Sprintf("%T", v)+"\n"+
+				"Got Value Type: "+fmt.Sprintf("%T", newValuable),
+		)
+		return false, diags
+	}
+
+	a, err := v.Decode()
+	if err != nil {
+		return false, nil
+	}
+	b, err := newValue.Decode()
+	if err != nil {
+		return false, nil
+	}
+	return JSONEqual(a, b), nil
+}
+
+// Decode returns the value as a JSON document which can be marshalled, for
+// example as the value of a custom object draft. Numbers are returned as
+// json.Number to keep their precision.
+func (v JSONValue) Decode() (any, error) {
+	if v.IsNull() || v.IsUnderlyingValueNull() {
+		return nil, nil
+	}
+	if v.IsUnknown() || v.IsUnderlyingValueUnknown() {
+		return nil, fmt.Errorf("value is unknown")
+	}
+
+	// A string containing a JSON document is decoded, as this is how the
+	// value was specified before it accepted any HCL value.
+	if s, ok := v.UnderlyingValue().(types.String); ok {
+		if data, err := decodeJSON(s.ValueString()); err == nil {
+			return data, nil
+		}
+		return s.ValueString(), nil
+	}
+	return valueToJSON(v.UnderlyingValue())
+}
+
+// JSONEqual returns true if both decoded JSON documents are equal. Numbers are
+// compared by value, so 10 and 10.0 are equal.
+func JSONEqual(a, b any) bool {
+	switch a := a.(type) {
+	case map[string]any:
+		b, ok := b.(map[string]any)
+		if !ok || len(a) != len(b) {
+			return false
+		}
+		for k, va := range a {
+			vb, ok := b[k]
+			if !ok || !JSONEqual(va, vb) {
+				return false
+			}
+		}
+		return true
+	case []any:
+		b, ok := b.([]any)
+		if !ok || len(a) != len(b) {
+			return false
+		}
+		for i := range a {
+			if !JSONEqual(a[i], b[i]) {
+				return false
+			}
+		}
+		return true
+	case json.Number, float64:
+		fa, fb := toBigFloat(a), toBigFloat(b)
+		return fa != nil && fb != nil && fa.Cmp(fb) == 0
+	default:
+		return a == b
+	}
+}
+
+func decodeJSON(value string) (any, error) {
+	var data any
+	decoder := json.NewDecoder(strings.NewReader(value))
+	decoder.UseNumber()
+	if err := decoder.Decode(&data); err != nil {
+		return nil, err
+	}
+	if decoder.More() {
+		return nil, fmt.Errorf("unexpected data after JSON value")
+	}
+	return data, nil
+}
+
+func toBigFloat(v any) *big.Float {
+	switch v := v.(type) {
+	case json.Number:
+		f, _, err := big.ParseFloat(v.String(), 10, 256, big.ToNearestEven)
+		if err != nil {
+			return nil
+		}
+		return f
+	case float64:
+		return big.NewFloat(v)
+	}
+	return nil
+}
+
+func valueToJSON(value attr.Value) (any, error) {
+	if value.IsNull() {
+		return nil, nil
+	}
+	if value.IsUnknown() {
+		return nil, fmt.Errorf("value is unknown")
+	}
+
+	elements := func(values []attr.Value) ([]any, error) {
+		result := make([]any, 0, len(values))
+		for _, e := range values {
+			data, err := valueToJSON(e)
+			if err != nil {
+				return nil, err
+			}
+			result = append(result, data)
+		}
+		return result, nil
+	}
+	attributes := func(values map[string]attr.Value) (map[string]any, error) {
+		result := make(map[string]any, len(values))
+		for k, e := range values {
+			data, err := valueToJSON(e)
+			if err != nil {
+				return nil, err
+			}
+			result[k] = data
+		}
+		return result, nil
+	}
+
+	switch v := value.(type) {
+	case types.String:
+		return v.ValueString(), nil
+	case types.Bool:
+		return v.ValueBool(), nil
+	case types.Number:
+		return json.Number(v.ValueBigFloat().Text('g', -1)), nil
+	case types.Int64:
+		return json.Number(fmt.Sprint(v.ValueInt64())), nil
+	case types.Float64:
+		return json.Number(big.NewFloat(v.ValueFloat64()).Text('g', -1)), nil
+	case types.List:
+		return elements(v.Elements())
+	case types.Set:
+		return elements(v.Elements())
+	case types.Tuple:
+		return elements(v.Elements())
+	case types.Map:
+		return attributes(v.Elements())
+	case types.Object:
+		return attributes(v.Attributes())
+	case types.Dynamic:
+		return valueToJSON(v.UnderlyingValue())
+	}
+	return nil, fmt.Errorf("unsupported value type %T", value)
+}
+
+func jsonToValue(data any) (attr.Value, error) {
+	switch v := data.(type) {
+	case nil:
+		return types.DynamicNull(), nil
+	case string:
+		return types.StringValue(v), nil
+	case bool:
+		return types.BoolValue(v), nil
+	case float64:
+		return types.NumberValue(big.NewFloat(v)), nil
+	case json.Number:
+		f := toBigFloat(v)
+		if f == nil {
+			return nil, fmt.Errorf("invalid number %s", v)
+		}
+		return types.NumberValue(f), nil
+	case []any:
+		elemTypes := make([]attr.Type, 0, len(v))
+		elems := make([]attr.Value, 0, len(v))
+		for _, e := range v {
+			value, err := jsonToValue(e)
+			if err != nil {
+				return nil, err
+			}
+			elemTypes = append(elemTypes, value.Type(context.Background()))
+			elems = append(elems, value)
+		}
+		value, diags := types.TupleValue(elemTypes, elems)
+		if diags.HasError() {
+			return nil, fmt.Errorf("unable to convert array: %v", diags)
+		}
+		return value, nil
+	case map[string]any:
+		attrTypes := make(map[string]attr.Type, len(v))
+		attrs := make(map[string]attr.Value, len(v))
+		for k, e := range v {
+			value, err := jsonToValue(e)
+			if err != nil {
+				return nil, err
+			}
+			attrTypes[k] = value.Type(context.Background())
+			attrs[k] = value
+		}
+		value, diags := types.ObjectValue(attrTypes, attrs)
+		if diags.HasError() {
+			return nil, fmt.Errorf("unable to convert object: %v", diags)
+		}
+		return value, nil
+	}
+	return nil, fmt.Errorf("unsupported JSON type %T", data)
+}
@@ -0,0 +1,138 @@
+package customtypes
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func jsonValue(value attr.Value) JSONValue {
+	return JSONValue{basetypes.NewDynamicValue(value)}
+}
+
+func TestJSONValueDecode(t *testing.T) {
+	object := types.ObjectValueMust(
+		map[string]attr.Type{
+			"enabled": types.BoolType,
+			"ratio":   types.NumberType,
+			"tags":    types.TupleType{ElemTypes: []attr.Type{types.StringType, types.NumberType}},
+		},
+		map[string]attr.Value{
+			"enabled": types.BoolValue(true),
+			"ratio":   types.NumberValue(big.NewFloat(0.25)),
+			"tags": types.TupleValueMust(
+				[]attr.Type{types.StringType, types.NumberType},
+				[]attr.Value{types.StringValue("a"), types.NumberValue(big.NewFloat(10))},
+			),
+		},
+	)
+
+	testCases := []struct {
+		name     string
+		value    JSONValue
+		expected any
+	}{
+		{
+			name:  "object",
+			value: jsonValue(object),
+			expected: map[string]any{
+				"enabled": true,
+				"ratio":   json.Number("0.25"),
+				"tags":    []any{"a", json.Number("10")},
+			},
+		},
+		{
+			name:     "JSON encoded string",
+			value:    jsonValue(types.StringValue(`{"number": 10}`)),
+			expected: map[string]any{"number": json.Number("10")},
+		},
+		{
+			name:     "plain string",
+			value:    jsonValue(types.StringValue("hello")),
+			expected: "hello",
+		},
+		{
+			name:     "number",
+			value:    jsonValue(types.NumberValue(big.NewFloat(20))),
+			expected: json.Number("20"),
+		},
+		{
+			name:     "null",
+			value:    NewJSONNull(),
+			expected: nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			result, err := tc.value.Decode()
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, result)
+		})
+	}
+
+	_, err := NewJSONUnknown().Decode()
+	assert.Error(t, err)
+}
+
+func TestNewJSONValue(t *testing.T) {
+	data := map[string]any{
+		"address": map[string]any{"street": "foo", "number": 10.0},
+		"tags":    []any{"a", true, nil},
+	}
+
+	value, err := NewJSONValue(data)
+	require.NoError(t, err)
+
+	result, err := value.Decode()
+	require.NoError(t, err)
+	assert.True(t, JSONEqual(data, result))
+
+	_, err = value.ToTerraformValue(context.Background())
+	assert.NoError(t, err)
+}
+
+func TestJSONValueSemanticEquals(t *testing.T) {
+	remote, err := NewJSONValue(map[string]any{"b": 1.0, "a": "x"})
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name     string
+		value    JSONValue
+		expected bool
+	}{
+		{
+			name:     "JSON encoded string with other key order",
+			value:    jsonValue(types.StringValue(`{"a":"x","b":1.0}`)),
+			expected: true,
+		},
+		{
+			name: "object",
+			value: jsonValue(types.ObjectValueMust(
+				map[string]attr.Type{"a": types.StringType, "b": types.NumberType},
+				map[string]attr.Value{"a": types.StringValue("x"), "b": types.NumberValue(big.NewFloat(1))},
+			)),
+			expected: true,
+		},
+		{
+			name:     "different value",
+			value:    jsonValue(types.StringValue(`{"a":"x","b":2}`)),
+			expected: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			result, diags := tc.value.DynamicSemanticEquals(context.Background(), remote)
+			assert.False(t, diags.HasError())
+			assert.Equal(t, tc.expected, result)
+		})
+	}
+}
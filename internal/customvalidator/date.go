@@ -0,0 +1,44 @@
+package customvalidator
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// RFC3339 validates that a string is a date in RFC3339 format.
+func RFC3339() validator.String {
+	return rfc3339Validator{}
+}
+
+var _ validator.String = rfc3339Validator{}
+
+// rfc3339Validator implements the validator.
+type rfc3339Validator struct{}
+
+// Description describes the validation in plain text formatting.
+func (v rfc3339Validator) Description(_ context.Context) string {
+	return "value must be a date in RFC3339 format"
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (v rfc3339Validator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// ValidateString performs the validation.
+func (v rfc3339Validator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if _, err := time.Parse(time.RFC3339, req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid date",
+			fmt.Sprintf("%q is not a valid RFC3339 date: %s", req.ConfigValue.ValueString(), err),
+		)
+	}
+}
@@ -0,0 +1,45 @@
+package customvalidator
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// JSON validates that a string is a valid JSON document.
+func JSON() validator.String {
+	return jsonValidator{}
+}
+
+var _ validator.String = jsonValidator{}
+
+// jsonValidator implements the validator.
+type jsonValidator struct{}
+
+// Description describes the validation in plain text formatting.
+func (v jsonValidator) Description(_ context.Context) string {
+	return "value must be valid JSON"
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (v jsonValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// ValidateString performs the validation.
+func (v jsonValidator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	var data any
+	if err := json.Unmarshal([]byte(req.ConfigValue.ValueString()), &data); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid JSON",
+			fmt.Sprintf("%q is not valid JSON: %s", req.ConfigValue.ValueString(), err),
+		)
+	}
+}
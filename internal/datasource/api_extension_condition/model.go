@@ -0,0 +1,54 @@
+package api_extension_condition
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/labd/terraform-provider-commercetools/internal/predicate"
+)
+
+// Condition maps the data source schema data.
+type Condition struct {
+	ID             types.String `tfsdk:"id"`
+	ResourceTypeID types.String `tfsdk:"resource_type_id"`
+	Condition      types.String `tfsdk:"condition"`
+	Resource       types.String `tfsdk:"resource"`
+	Matches        types.Bool   `tfsdk:"matches"`
+}
+
+// parse parses the condition and validates it against the fields of the
+// resource type.
+func (c Condition) parse() (*predicate.Predicate, error) {
+	s, ok := predicate.ExtensionSchema(c.ResourceTypeID.ValueString())
+	if !ok {
+		return nil, fmt.Errorf("unsupported resource type %q", c.ResourceTypeID.ValueString())
+	}
+
+	p, err := predicate.Parse(c.Condition.ValueString())
+	if err != nil {
+		return nil, err
+	}
+	if err := p.Validate(s); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// evaluate evaluates the condition against the sample resource and sets the
+// computed attributes.
+func (c *Condition) evaluate() error {
+	p, err := c.parse()
+	if err != nil {
+		return err
+	}
+
+	matches, err := p.Eval([]byte(c.Resource.ValueString()))
+	if err != nil {
+		return err
+	}
+
+	c.ID = c.ResourceTypeID
+	c.Matches = types.BoolValue(matches)
+	return nil
+}
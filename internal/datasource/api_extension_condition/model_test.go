@@ -0,0 +1,33 @@
+package api_extension_condition
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConditionEvaluate(t *testing.T) {
+	c := Condition{
+		ResourceTypeID: types.StringValue("cart"),
+		Condition:      types.StringValue(`country = "NL" and lineItems(quantity > 1)`),
+		Resource:       types.StringValue(`{"country": "NL", "lineItems": [{"quantity": 2}]}`),
+	}
+	require.NoError(t, c.evaluate())
+	assert.Equal(t, types.StringValue("cart"), c.ID)
+	assert.Equal(t, types.BoolValue(true), c.Matches)
+
+	c.Resource = types.StringValue(`{"country": "BE", "lineItems": [{"quantity": 2}]}`)
+	require.NoError(t, c.evaluate())
+	assert.Equal(t, types.BoolValue(false), c.Matches)
+
+	c.Condition = types.StringValue(`contry = "NL"`)
+	assert.EqualError(t, c.evaluate(), `field "contry" at position 1 does not exist on cart, did you mean "country"?`)
+
+	c.Condition = types.StringValue(`country = `)
+	assert.EqualError(t, c.evaluate(), `expected a value but got end of predicate at position 11`)
+
+	c.ResourceTypeID = types.StringValue("product")
+	assert.EqualError(t, c.evaluate(), `unsupported resource type "product"`)
+}
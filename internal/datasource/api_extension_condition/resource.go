@@ -0,0 +1,112 @@
+package api_extension_condition
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+
+	"github.com/labd/terraform-provider-commercetools/internal/predicate"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource                   = &ConditionSource{}
+	_ datasource.DataSourceWithValidateConfig = &ConditionSource{}
+)
+
+// NewDataSource is a helper function to simplify the data source implementation.
+func NewDataSource() datasource.DataSource {
+	return &ConditionSource{}
+}
+
+// ConditionSource is the data source implementation.
+type ConditionSource struct{}
+
+// Metadata returns the data source type name.
+func (d *ConditionSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_api_extension_condition"
+}
+
+// Schema defines the schema for the data source.
+func (d *ConditionSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Evaluates the condition of an API extension trigger against a sample resource, " +
+			"without calling the commercetools API. This can be used to test the conditions of " +
+			"`commercetools_api_extension` triggers, for example in a `check` block or a `terraform test`.\n\n" +
+			"The condition is validated against the fields of the resource type. Fields which are not " +
+			"present in the sample resource don't match any comparison, and comparisons on arrays match " +
+			"when any of the elements match.\n\n" +
+			"See also the [Query Predicates Documentation](https://docs.commercetools.com/api/predicates/query)",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The resource type ID",
+				Computed:    true,
+			},
+			"resource_type_id": schema.StringAttribute{
+				Description: "The resource type of the trigger",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(predicate.ExtensionResourceTypes()...),
+				},
+			},
+			"condition": schema.StringAttribute{
+				Description: "The condition of the trigger",
+				Required:    true,
+			},
+			"resource": schema.StringAttribute{
+				Description: "JSON representation of the resource, as returned by the commercetools API",
+				Required:    true,
+			},
+			"matches": schema.BoolAttribute{
+				Description: "Whether the condition matches the resource",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// ValidateConfig checks the condition against the fields of the resource type.
+func (d *ConditionSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var config Condition
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.ResourceTypeID.IsUnknown() || config.Condition.IsUnknown() {
+		return
+	}
+	if _, err := config.parse(); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("condition"),
+			"Invalid condition",
+			err.Error(),
+		)
+	}
+}
+
+// Read evaluates the condition against the resource.
+func (d *ConditionSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state Condition
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := state.evaluate(); err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to evaluate condition",
+			err.Error(),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
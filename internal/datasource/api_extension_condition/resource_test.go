@@ -0,0 +1,44 @@
+package api_extension_condition_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/labd/terraform-provider-commercetools/internal/acctest"
+)
+
+func TestAccApiExtensionCondition(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					data "commercetools_api_extension_condition" "test" {
+						resource_type_id = "cart"
+						condition        = "totalPrice(centAmount > 10000) and country = \"NL\""
+						resource = jsonencode({
+							country    = "NL"
+							totalPrice = { centAmount = 12500, currencyCode = "EUR" }
+						})
+					}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.commercetools_api_extension_condition.test", "matches", "true"),
+				),
+			},
+			{
+				Config: `
+					data "commercetools_api_extension_condition" "test" {
+						resource_type_id = "cart"
+						condition        = "totalPrice > 10000"
+						resource         = "{}"
+					}
+				`,
+				ExpectError: regexp.MustCompile(`is an object, use totalPrice`),
+			},
+		},
+	})
+}
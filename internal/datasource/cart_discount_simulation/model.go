@@ -0,0 +1,222 @@
+package cart_discount_simulation
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/labd/terraform-provider-commercetools/internal/cartpredicate"
+)
+
+// Simulation maps the data source schema data.
+type Simulation struct {
+	ID        types.String            `tfsdk:"id"`
+	Discounts []Discount              `tfsdk:"discount"`
+	Carts     map[string]types.String `tfsdk:"carts"`
+	Results   map[string]Result       `tfsdk:"results"`
+}
+
+// Discount is the definition of a cart discount.
+type Discount struct {
+	Key          types.String     `tfsdk:"key"`
+	Predicate    types.String     `tfsdk:"predicate"`
+	SortOrder    types.String     `tfsdk:"sort_order"`
+	StackingMode types.String     `tfsdk:"stacking_mode"`
+	Target       []DiscountTarget `tfsdk:"target"`
+	Value        []DiscountValue  `tfsdk:"value"`
+}
+
+type DiscountTarget struct {
+	Type      types.String `tfsdk:"type"`
+	Predicate types.String `tfsdk:"predicate"`
+}
+
+type DiscountValue struct {
+	Type      types.String `tfsdk:"type"`
+	Permyriad types.Int64  `tfsdk:"permyriad"`
+	Money     []Money      `tfsdk:"money"`
+}
+
+type Money struct {
+	CurrencyCode types.String `tfsdk:"currency_code"`
+	CentAmount   types.Int64  `tfsdk:"cent_amount"`
+}
+
+// Result is the outcome of the simulation for a cart.
+type Result struct {
+	CurrencyCode         types.String           `tfsdk:"currency_code"`
+	TotalCentAmount      types.Int64            `tfsdk:"total_cent_amount"`
+	DiscountedCentAmount types.Int64            `tfsdk:"discounted_cent_amount"`
+	AppliedDiscounts     []types.String         `tfsdk:"applied_discounts"`
+	DiscountAmounts      map[string]types.Int64 `tfsdk:"discount_amounts"`
+}
+
+// parse validates the discount definition.
+func (d Discount) parse() (discount, error) {
+	if len(d.Target) != 1 || len(d.Value) != 1 {
+		return discount{}, fmt.Errorf("exactly one target and value block is required")
+	}
+	target, value := d.Target[0], d.Value[0]
+
+	result := discount{
+		Key:          d.Key.ValueString(),
+		TargetType:   target.Type.ValueString(),
+		ValueType:    value.Type.ValueString(),
+		Permyriad:    value.Permyriad.ValueInt64(),
+		StackingMode: d.StackingMode.ValueString(),
+		Money:        map[string]int64{},
+	}
+
+	r, ok := new(big.Rat).SetString(d.SortOrder.ValueString())
+	if !ok || r.Sign() <= 0 || r.Cmp(big.NewRat(1, 1)) >= 0 {
+		return discount{}, fmt.Errorf("sort_order must be a decimal number between 0 and 1, got %q", d.SortOrder.ValueString())
+	}
+	result.SortOrder = r
+
+	p, err := cartpredicate.Parse(d.Predicate.ValueString())
+	if err != nil {
+		return discount{}, fmt.Errorf("invalid predicate: %w", err)
+	}
+	result.Predicate = p
+
+	switch result.TargetType {
+	case "lineItems", "customLineItems":
+		if target.Predicate.IsNull() {
+			return discount{}, fmt.Errorf("target predicate is required for %s targets", result.TargetType)
+		}
+		p, err := cartpredicate.Parse(target.Predicate.ValueString())
+		if err != nil {
+			return discount{}, fmt.Errorf("invalid target predicate: %w", err)
+		}
+		result.TargetPredicate = p
+	case "shipping", "totalPrice":
+		if !target.Predicate.IsNull() {
+			return discount{}, fmt.Errorf("target predicate is not supported for %s targets", result.TargetType)
+		}
+	}
+
+	switch result.ValueType {
+	case "relative":
+		if value.Permyriad.IsNull() {
+			return discount{}, fmt.Errorf("permyriad is required for relative values")
+		}
+	case "absolute", "fixed":
+		if len(value.Money) == 0 {
+			return discount{}, fmt.Errorf("money is required for %s values", result.ValueType)
+		}
+		for _, m := range value.Money {
+			result.Money[m.CurrencyCode.ValueString()] = m.CentAmount.ValueInt64()
+		}
+	}
+	return result, nil
+}
+
+// problem is an invalid discount definition or cart.
+type problem struct {
+	path path.Path
+	err  error
+}
+
+// validate checks the discount definitions and the carts.
+func (s Simulation) validate() []problem {
+	var result []problem
+	keys := map[string]bool{}
+	sortOrders := map[string]string{}
+	for i, d := range s.Discounts {
+		p := path.Root("discount").AtListIndex(i)
+		parsed, err := d.parse()
+		if err != nil {
+			result = append(result, problem{path: p, err: err})
+			continue
+		}
+		if keys[parsed.Key] {
+			result = append(result, problem{path: p, err: fmt.Errorf("key %s is used by multiple discounts", parsed.Key)})
+		}
+		keys[parsed.Key] = true
+
+		if other, ok := sortOrders[parsed.SortOrder.RatString()]; ok {
+			result = append(result, problem{path: p, err: fmt.Errorf("sort_order %s is already used by discount %s", d.SortOrder.ValueString(), other)})
+		}
+		sortOrders[parsed.SortOrder.RatString()] = parsed.Key
+	}
+
+	for _, name := range sortedKeys(s.Carts) {
+		if _, err := decodeCart(s.Carts[name].ValueString()); err != nil {
+			result = append(result, problem{path: path.Root("carts").AtMapKey(name), err: err})
+		}
+	}
+	return result
+}
+
+// simulate applies the discounts to each of the carts and sets the results.
+func (s *Simulation) simulate() error {
+	if problems := s.validate(); len(problems) > 0 {
+		return fmt.Errorf("%s: %w", problems[0].path, problems[0].err)
+	}
+
+	discounts := make([]discount, len(s.Discounts))
+	for i, d := range s.Discounts {
+		discounts[i], _ = d.parse()
+	}
+
+	s.Results = map[string]Result{}
+	for _, name := range sortedKeys(s.Carts) {
+		cart, _ := decodeCart(s.Carts[name].ValueString())
+		res, err := simulate(discounts, cart)
+		if err != nil {
+			return fmt.Errorf("cart %s: %w", name, err)
+		}
+		s.Results[name] = newResult(res)
+	}
+	s.ID = types.StringValue(strings.Join(sortedKeys(s.Carts), ","))
+	return nil
+}
+
+func newResult(r cartResult) Result {
+	applied := make([]types.String, len(r.Applied))
+	for i, key := range r.Applied {
+		applied[i] = types.StringValue(key)
+	}
+	amounts := make(map[string]types.Int64, len(r.Amounts))
+	for key, amount := range r.Amounts {
+		amounts[key] = types.Int64Value(amount)
+	}
+
+	return Result{
+		CurrencyCode:         types.StringValue(r.CurrencyCode),
+		TotalCentAmount:      types.Int64Value(r.Total),
+		DiscountedCentAmount: types.Int64Value(r.DiscountedTotal),
+		AppliedDiscounts:     applied,
+		DiscountAmounts:      amounts,
+	}
+}
+
+// decodeCart decodes the JSON representation of the cart. Numbers are decoded
+// as json.Number so money amounts are exact.
+func decodeCart(value string) (map[string]any, error) {
+	decoder := json.NewDecoder(strings.NewReader(value))
+	decoder.UseNumber()
+
+	var cart map[string]any
+	if err := decoder.Decode(&cart); err != nil {
+		return nil, fmt.Errorf("cart is not a valid JSON object: %w", err)
+	}
+	if cart == nil {
+		return nil, fmt.Errorf("cart is not a valid JSON object")
+	}
+	return cart, nil
+}
+
+func sortedKeys[T any](m map[string]T) []string {
+	result := make([]string, 0, len(m))
+	for key := range m {
+		result = append(result, key)
+	}
+	sort.Strings(result)
+	return result
+}
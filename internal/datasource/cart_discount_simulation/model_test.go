@@ -0,0 +1,106 @@
+package cart_discount_simulation
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testSimulation() Simulation {
+	return Simulation{
+		Discounts: []Discount{
+			{
+				Key:       types.StringValue("socks"),
+				Predicate: types.StringValue(`1 = 1`),
+				SortOrder: types.StringValue("0.9"),
+				Target: []DiscountTarget{{
+					Type:      types.StringValue("lineItems"),
+					Predicate: types.StringValue(`sku = "socks"`),
+				}},
+				Value: []DiscountValue{{
+					Type:      types.StringValue("relative"),
+					Permyriad: types.Int64Value(1000),
+				}},
+			},
+			{
+				Key:       types.StringValue("shipping"),
+				Predicate: types.StringValue(`totalPrice > "50.00 EUR"`),
+				SortOrder: types.StringValue("0.8"),
+				Target: []DiscountTarget{{
+					Type: types.StringValue("shipping"),
+				}},
+				Value: []DiscountValue{{
+					Type:  types.StringValue("absolute"),
+					Money: []Money{{CurrencyCode: types.StringValue("EUR"), CentAmount: types.Int64Value(1000)}},
+				}},
+			},
+		},
+		Carts: map[string]types.String{
+			"small": types.StringValue(`{"lineItems": [{"quantity": 2, "variant": {"sku": "socks"}, "price": {"value": {"currencyCode": "EUR", "centAmount": 1000}}}]}`),
+			"large": types.StringValue(`{"lineItems": [{"quantity": 6, "variant": {"sku": "socks"}, "price": {"value": {"currencyCode": "EUR", "centAmount": 1000}}}], "shippingInfo": {"price": {"currencyCode": "EUR", "centAmount": 495}}}`),
+		},
+	}
+}
+
+func TestSimulationSimulate(t *testing.T) {
+	s := testSimulation()
+	require.NoError(t, s.simulate())
+
+	assert.Equal(t, types.StringValue("large,small"), s.ID)
+	assert.Equal(t, Result{
+		CurrencyCode:         types.StringValue("EUR"),
+		TotalCentAmount:      types.Int64Value(2000),
+		DiscountedCentAmount: types.Int64Value(1800),
+		AppliedDiscounts:     []types.String{types.StringValue("socks")},
+		DiscountAmounts:      map[string]types.Int64{"socks": types.Int64Value(200)},
+	}, s.Results["small"])
+	assert.Equal(t, Result{
+		CurrencyCode:         types.StringValue("EUR"),
+		TotalCentAmount:      types.Int64Value(6495),
+		DiscountedCentAmount: types.Int64Value(5400),
+		AppliedDiscounts:     []types.String{types.StringValue("socks"), types.StringValue("shipping")},
+		DiscountAmounts: map[string]types.Int64{
+			"socks":    types.Int64Value(600),
+			"shipping": types.Int64Value(495),
+		},
+	}, s.Results["large"])
+}
+
+func TestSimulationValidate(t *testing.T) {
+	s := testSimulation()
+	assert.Empty(t, s.validate())
+
+	s.Discounts[0].Predicate = types.StringValue(`sku = `)
+	s.Discounts[1].SortOrder = types.StringValue("1.5")
+	s.Carts["small"] = types.StringValue(`[]`)
+
+	var problems []string
+	for _, p := range s.validate() {
+		problems = append(problems, p.path.String()+": "+p.err.Error())
+	}
+	assert.Equal(t, []string{
+		`discount[0]: invalid predicate: expected a value or field but got end of predicate at position 7`,
+		`discount[1]: sort_order must be a decimal number between 0 and 1, got "1.5"`,
+		`carts["small"]: cart is not a valid JSON object: json: cannot unmarshal array into Go value of type map[string]interface {}`,
+	}, problems)
+
+	s = testSimulation()
+	s.Discounts[1].SortOrder = types.StringValue("0.90")
+	s.Discounts[1].Target[0].Predicate = types.StringValue("1 = 1")
+	s.Discounts = append(s.Discounts, s.Discounts[0])
+	s.Discounts[2].SortOrder = types.StringValue("0.1")
+
+	problems = nil
+	for _, p := range s.validate() {
+		problems = append(problems, p.path.String()+": "+p.err.Error())
+	}
+	assert.Equal(t, []string{
+		`discount[1]: target predicate is not supported for shipping targets`,
+		`discount[2]: key socks is used by multiple discounts`,
+	}, problems)
+
+	s.Discounts[1].Target[0].Predicate = types.StringNull()
+	assert.EqualError(t, s.simulate(), `discount[1]: sort_order 0.90 is already used by discount socks`)
+}
@@ -0,0 +1,222 @@
+package cart_discount_simulation
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource                   = &SimulationSource{}
+	_ datasource.DataSourceWithValidateConfig = &SimulationSource{}
+)
+
+// NewDataSource is a helper function to simplify the data source implementation.
+func NewDataSource() datasource.DataSource {
+	return &SimulationSource{}
+}
+
+// SimulationSource is the data source implementation.
+type SimulationSource struct{}
+
+// Metadata returns the data source type name.
+func (d *SimulationSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cart_discount_simulation"
+}
+
+// Schema defines the schema for the data source.
+func (d *SimulationSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Simulates cart discounts against sample carts, without calling the commercetools API. " +
+			"This can be used to test promotions before they are launched, for example in a `check` block " +
+			"or a `terraform test`.\n\n" +
+			"The discounts are applied in the order of their sort order, the discount with the greatest sort " +
+			"order first. The predicates are evaluated against the cart without discounts, each discount is " +
+			"applied on the prices after the previous discounts. Relative amounts are rounded half to even " +
+			"per unit. Discounts which require a discount code, multi-buy targets and pattern targets are " +
+			"not supported.\n\n" +
+			"The carts use the JSON representation returned by the commercetools API. Line items need a " +
+			"`price.value` or `totalPrice`, custom line items a `money` or `totalPrice`, and the shipping " +
+			"price is taken from `shippingInfo.price`.\n\n" +
+			"See also the [Cart Predicates Documentation](https://docs.commercetools.com/api/predicates/predicate-operators)",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The names of the carts",
+				Computed:    true,
+			},
+			"carts": schema.MapAttribute{
+				Description: "The sample carts by name, as JSON representation returned by the commercetools API",
+				ElementType: types.StringType,
+				Required:    true,
+			},
+			"results": schema.MapAttribute{
+				Description: "The result of the simulation for each cart. Contains the `currency_code`, the " +
+					"`total_cent_amount` without discounts, the `discounted_cent_amount` after applying the " +
+					"discounts, the keys of the `applied_discounts` in the order they are applied, and the " +
+					"`discount_amounts` in cents by discount key",
+				ElementType: types.ObjectType{
+					AttrTypes: map[string]attr.Type{
+						"currency_code":          types.StringType,
+						"total_cent_amount":      types.Int64Type,
+						"discounted_cent_amount": types.Int64Type,
+						"applied_discounts":      types.ListType{ElemType: types.StringType},
+						"discount_amounts":       types.MapType{ElemType: types.Int64Type},
+					},
+				},
+				Computed: true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"discount": schema.ListNestedBlock{
+				Description: "The cart discounts to simulate",
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"key": schema.StringAttribute{
+							Description: "Identifies the discount in the results",
+							Required:    true,
+						},
+						"predicate": schema.StringAttribute{
+							Description: "A valid [Cart Predicate](https://docs.commercetools.com/api/projects/predicates#cart-predicates)",
+							Required:    true,
+						},
+						"sort_order": schema.StringAttribute{
+							Description: "The string must contain a number between 0 and 1. A discount with greater " +
+								"sort order is prioritized higher than a discount with lower sort order",
+							Required: true,
+						},
+						"stacking_mode": schema.StringAttribute{
+							Description: "Specifies whether the application of this discount causes the following " +
+								"discounts to be ignored. Defaults to Stacking",
+							Optional: true,
+							Validators: []validator.String{
+								stringvalidator.OneOf("Stacking", "StopAfterThisDiscount"),
+							},
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"target": schema.ListNestedBlock{
+							Description: "Specifies the parts of the cart the discount is applied to",
+							Validators: []validator.List{
+								listvalidator.IsRequired(),
+								listvalidator.SizeBetween(1, 1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"type": schema.StringAttribute{
+										Description: "Supports lineItems, customLineItems, shipping and totalPrice",
+										Required:    true,
+										Validators: []validator.String{
+											stringvalidator.OneOf("lineItems", "customLineItems", "shipping", "totalPrice"),
+										},
+									},
+									"predicate": schema.StringAttribute{
+										Description: "LineItems/CustomLineItems target specific fields",
+										Optional:    true,
+									},
+								},
+							},
+						},
+						"value": schema.ListNestedBlock{
+							Description: "Defines the effect the discount will have",
+							Validators: []validator.List{
+								listvalidator.IsRequired(),
+								listvalidator.SizeBetween(1, 1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"type": schema.StringAttribute{
+										Description: "Supports relative, absolute and fixed",
+										Required:    true,
+										Validators: []validator.String{
+											stringvalidator.OneOf("relative", "absolute", "fixed"),
+										},
+									},
+									"permyriad": schema.Int64Attribute{
+										Description: "Relative discount specific fields",
+										Optional:    true,
+										Validators: []validator.Int64{
+											int64validator.Between(0, 10000),
+										},
+									},
+								},
+								Blocks: map[string]schema.Block{
+									"money": schema.ListNestedBlock{
+										Description: "Absolute and fixed discount specific fields",
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												"currency_code": schema.StringAttribute{
+													Description: "The currency code compliant to ISO 4217",
+													Required:    true,
+												},
+												"cent_amount": schema.Int64Attribute{
+													Description: "The amount in cents (the smallest indivisible unit of the currency)",
+													Required:    true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// ValidateConfig checks the discount definitions and carts. The configuration
+// is only validated when all values are known.
+func (d *SimulationSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	if !req.Config.Raw.IsFullyKnown() {
+		return
+	}
+
+	var config Simulation
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for _, p := range config.validate() {
+		resp.Diagnostics.AddAttributeError(
+			p.path,
+			"Invalid cart discount simulation",
+			p.err.Error(),
+		)
+	}
+}
+
+// Read simulates the discounts against the carts.
+func (d *SimulationSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state Simulation
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := state.simulate(); err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to simulate cart discounts",
+			err.Error(),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
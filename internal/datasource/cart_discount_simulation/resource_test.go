@@ -0,0 +1,75 @@
+package cart_discount_simulation_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/labd/terraform-provider-commercetools/internal/acctest"
+)
+
+func TestAccCartDiscountSimulation(t *testing.T) {
+	resourceName := "data.commercetools_cart_discount_simulation.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					data "commercetools_cart_discount_simulation" "test" {
+						discount {
+							key        = "socks"
+							predicate  = "lineItemCount(sku = \"socks\") >= 2"
+							sort_order = "0.9"
+							target {
+								type      = "lineItems"
+								predicate = "sku = \"socks\""
+							}
+							value {
+								type      = "relative"
+								permyriad = 1000
+							}
+						}
+						carts = {
+							socks = jsonencode({
+								lineItems = [{
+									quantity = 2
+									variant  = { sku = "socks" }
+									price    = { value = { currencyCode = "EUR", centAmount = 1000 } }
+								}]
+							})
+						}
+					}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "results.socks.total_cent_amount", "2000"),
+					resource.TestCheckResourceAttr(resourceName, "results.socks.discounted_cent_amount", "1800"),
+					resource.TestCheckResourceAttr(resourceName, "results.socks.applied_discounts.0", "socks"),
+					resource.TestCheckResourceAttr(resourceName, "results.socks.discount_amounts.socks", "200"),
+				),
+			},
+			{
+				Config: `
+					data "commercetools_cart_discount_simulation" "test" {
+						discount {
+							key        = "invalid"
+							predicate  = "totalPrice >"
+							sort_order = "0.9"
+							target {
+								type = "shipping"
+							}
+							value {
+								type      = "relative"
+								permyriad = 1000
+							}
+						}
+						carts = { empty = "{}" }
+					}
+				`,
+				ExpectError: regexp.MustCompile(`invalid predicate: expected a value or field`),
+			},
+		},
+	})
+}
@@ -0,0 +1,253 @@
+package cart_discount_simulation
+
+import (
+	"fmt"
+	"math/big"
+	"sort"
+
+	"github.com/labd/terraform-provider-commercetools/internal/cartpredicate"
+)
+
+// discount is a parsed cart discount definition.
+type discount struct {
+	Key             string
+	SortOrder       *big.Rat
+	Predicate       *cartpredicate.Predicate
+	TargetType      string
+	TargetPredicate *cartpredicate.Predicate
+	ValueType       string
+	Permyriad       int64
+	Money           map[string]int64
+	StackingMode    string
+}
+
+// item is a line item, custom line item or the shipping of the cart, with the
+// unit price after the discounts applied so far.
+type item struct {
+	obj      map[string]any
+	quantity int64
+	unit     int64
+}
+
+// cartResult is the outcome of the simulation for a single cart. Amounts are
+// in cent.
+type cartResult struct {
+	CurrencyCode    string
+	Total           int64
+	DiscountedTotal int64
+	Applied         []string
+	Amounts         map[string]int64
+}
+
+// simulate applies the discounts to the cart, in the order of their sort
+// order. The predicates are evaluated against the cart without discounts.
+func simulate(discounts []discount, cart map[string]any) (cartResult, error) {
+	currency := cartCurrency(cart)
+	if currency == "" {
+		return cartResult{}, fmt.Errorf("the currency of the cart can't be determined, set totalPrice.currencyCode")
+	}
+
+	lineItems, err := cartItems(cart, "lineItems", "price")
+	if err != nil {
+		return cartResult{}, err
+	}
+	customLineItems, err := cartItems(cart, "customLineItems", "money")
+	if err != nil {
+		return cartResult{}, err
+	}
+	var shipping []*item
+	if price, ok := centAmount(step(cart, "shippingInfo", "price")); ok {
+		shipping = append(shipping, &item{quantity: 1, unit: price})
+	}
+
+	total := sum(lineItems) + sum(customLineItems) + sum(shipping)
+	if _, ok := cart["totalPrice"]; !ok {
+		cart["totalPrice"] = map[string]any{"currencyCode": currency, "centAmount": float64(total)}
+	}
+
+	sorted := append([]discount{}, discounts...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].SortOrder.Cmp(sorted[j].SortOrder) > 0
+	})
+
+	result := cartResult{
+		CurrencyCode: currency,
+		Total:        total,
+		Applied:      []string{},
+		Amounts:      map[string]int64{},
+	}
+	remaining := total
+	for _, d := range sorted {
+		match, err := d.Predicate.EvalCart(cart)
+		if err != nil {
+			return cartResult{}, fmt.Errorf("discount %s: %w", d.Key, err)
+		}
+		if !match {
+			continue
+		}
+
+		var amount int64
+		switch d.TargetType {
+		case "lineItems":
+			amount, err = d.applyItems(cart, lineItems, currency, d.TargetPredicate.EvalLineItem)
+		case "customLineItems":
+			amount, err = d.applyItems(cart, customLineItems, currency, d.TargetPredicate.EvalCustomLineItem)
+		case "shipping":
+			for _, s := range shipping {
+				amount += d.apply(s, currency)
+			}
+		case "totalPrice":
+			amount = d.apply(&item{quantity: 1, unit: remaining}, currency)
+		}
+		if err != nil {
+			return cartResult{}, fmt.Errorf("discount %s: %w", d.Key, err)
+		}
+		if amount == 0 {
+			continue
+		}
+
+		remaining -= amount
+		result.Applied = append(result.Applied, d.Key)
+		result.Amounts[d.Key] = amount
+		if d.StackingMode == "StopAfterThisDiscount" {
+			break
+		}
+	}
+
+	result.DiscountedTotal = remaining
+	return result, nil
+}
+
+// applyItems applies the discount to the items which match the target
+// predicate and returns the total discounted amount.
+func (d discount) applyItems(cart map[string]any, items []*item, currency string, eval func(cart, item map[string]any) (bool, error)) (int64, error) {
+	var amount int64
+	for _, i := range items {
+		match, err := eval(cart, i.obj)
+		if err != nil {
+			return 0, err
+		}
+		if match {
+			amount += d.apply(i, currency)
+		}
+	}
+	return amount, nil
+}
+
+// apply reduces the unit price of the item and returns the discounted amount
+// for all units. Absolute and fixed discounts without an amount in the
+// currency of the cart don't apply.
+func (d discount) apply(i *item, currency string) int64 {
+	var reduction int64
+	switch d.ValueType {
+	case "relative":
+		reduction = roundHalfEven(i.unit*d.Permyriad, 10000)
+	case "absolute":
+		if money, ok := d.Money[currency]; ok {
+			reduction = min(money, i.unit)
+		}
+	case "fixed":
+		if money, ok := d.Money[currency]; ok && money < i.unit {
+			reduction = i.unit - money
+		}
+	}
+	if reduction <= 0 {
+		return 0
+	}
+	i.unit -= reduction
+	return reduction * i.quantity
+}
+
+// roundHalfEven returns n / d rounded to the nearest integer, with ties
+// rounded to the even integer as done by commercetools.
+func roundHalfEven(n, d int64) int64 {
+	q, r := n/d, n%d
+	if r*2 > d || (r*2 == d && q%2 == 1) {
+		q++
+	}
+	return q
+}
+
+func sum(items []*item) int64 {
+	var result int64
+	for _, i := range items {
+		result += i.unit * i.quantity
+	}
+	return result
+}
+
+// cartItems returns the line items or custom line items of the cart. The unit
+// price is taken from the price field, or computed from the total price.
+func cartItems(cart map[string]any, field, priceField string) ([]*item, error) {
+	values, _ := cart[field].([]any)
+	result := make([]*item, 0, len(values))
+	for n, value := range values {
+		obj, ok := value.(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf("%s.%d is not an object", field, n)
+		}
+
+		quantity := int64(1)
+		if q, ok := integer(obj["quantity"]); ok {
+			quantity = q
+		}
+
+		price := obj[priceField]
+		if p, ok := price.(map[string]any); ok && p["value"] != nil {
+			price = p["value"]
+		}
+		unit, ok := centAmount(price)
+		if !ok {
+			total, ok := centAmount(obj["totalPrice"])
+			if !ok || quantity == 0 {
+				return nil, fmt.Errorf("%s.%d has no %s or totalPrice", field, n, priceField)
+			}
+			unit = total / quantity
+		}
+		result = append(result, &item{obj: obj, quantity: quantity, unit: unit})
+	}
+	return result, nil
+}
+
+// cartCurrency returns the currency of the cart, taken from the total price or
+// the price of the first line item.
+func cartCurrency(cart map[string]any) string {
+	if currency, ok := step(cart, "totalPrice", "currencyCode").(string); ok {
+		return currency
+	}
+	if items, ok := cart["lineItems"].([]any); ok && len(items) > 0 {
+		if currency, ok := step(items[0], "price", "value", "currencyCode").(string); ok {
+			return currency
+		}
+		if currency, ok := step(items[0], "totalPrice", "currencyCode").(string); ok {
+			return currency
+		}
+	}
+	return ""
+}
+
+func step(value any, path ...string) any {
+	for _, name := range path {
+		obj, ok := value.(map[string]any)
+		if !ok {
+			return nil
+		}
+		value = obj[name]
+	}
+	return value
+}
+
+func centAmount(value any) (int64, bool) {
+	return integer(step(value, "centAmount"))
+}
+
+func integer(value any) (int64, bool) {
+	switch v := value.(type) {
+	case float64:
+		return int64(v), true
+	case interface{ Int64() (int64, error) }:
+		result, err := v.Int64()
+		return result, err == nil
+	}
+	return 0, false
+}
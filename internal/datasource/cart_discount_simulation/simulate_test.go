@@ -0,0 +1,147 @@
+package cart_discount_simulation
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/labd/terraform-provider-commercetools/internal/cartpredicate"
+)
+
+const testCart = `{
+	"totalPrice": {"currencyCode": "EUR", "centAmount": 9192},
+	"lineItems": [
+		{"quantity": 1, "variant": {"sku": "shirt"}, "price": {"value": {"currencyCode": "EUR", "centAmount": 5000}}},
+		{"quantity": 3, "variant": {"sku": "socks"}, "price": {"value": {"currencyCode": "EUR", "centAmount": 999}}}
+	],
+	"customLineItems": [
+		{"slug": "gift-wrap", "quantity": 1, "money": {"currencyCode": "EUR", "centAmount": 500}}
+	],
+	"shippingInfo": {"price": {"currencyCode": "EUR", "centAmount": 695}}
+}`
+
+func testDiscount(t *testing.T, key, sortOrder, predicate, targetType, targetPredicate, valueType string) discount {
+	d := discount{
+		Key:        key,
+		SortOrder:  new(big.Rat),
+		TargetType: targetType,
+		ValueType:  valueType,
+		Money:      map[string]int64{},
+	}
+	d.SortOrder.SetString(sortOrder)
+
+	var err error
+	d.Predicate, err = cartpredicate.Parse(predicate)
+	require.NoError(t, err)
+	if targetPredicate != "" {
+		d.TargetPredicate, err = cartpredicate.Parse(targetPredicate)
+		require.NoError(t, err)
+	}
+	return d
+}
+
+func TestSimulate(t *testing.T) {
+	expensive := testDiscount(t, "expensive", "0.95", `totalPrice > "1000.00 EUR"`, "lineItems", `1 = 1`, "relative")
+	expensive.Permyriad = 5000
+
+	socks := testDiscount(t, "socks", "0.9", `lineItemCount(sku = "socks") >= 3`, "lineItems", `sku = "socks"`, "relative")
+	socks.Permyriad = 1500
+
+	shipping := testDiscount(t, "shipping", "0.8", `totalPrice >= "90.00 EUR"`, "shipping", "", "fixed")
+	shipping.Money["EUR"] = 0
+
+	wrap := testDiscount(t, "wrap", "0.7", `1 = 1`, "customLineItems", `slug = "gift-wrap"`, "absolute")
+	wrap.Money["EUR"] = 1000
+
+	dollars := testDiscount(t, "dollars", "0.6", `1 = 1`, "totalPrice", "", "absolute")
+	dollars.Money["USD"] = 500
+
+	total := testDiscount(t, "total", "0.5", `1 = 1`, "totalPrice", "", "relative")
+	total.Permyriad = 1000
+
+	stop := socks
+	stop.StackingMode = "StopAfterThisDiscount"
+
+	testCases := []struct {
+		name      string
+		discounts []discount
+		expected  cartResult
+	}{
+		{
+			name:      "stacking",
+			discounts: []discount{dollars, wrap, shipping, socks, expensive},
+			expected: cartResult{
+				CurrencyCode:    "EUR",
+				Total:           9192,
+				DiscountedTotal: 7547,
+				Applied:         []string{"socks", "shipping", "wrap"},
+				Amounts:         map[string]int64{"socks": 450, "shipping": 695, "wrap": 500},
+			},
+		},
+		{
+			name:      "stop after this discount",
+			discounts: []discount{wrap, shipping, stop},
+			expected: cartResult{
+				CurrencyCode:    "EUR",
+				Total:           9192,
+				DiscountedTotal: 8742,
+				Applied:         []string{"socks"},
+				Amounts:         map[string]int64{"socks": 450},
+			},
+		},
+		{
+			name:      "total price after line item discounts",
+			discounts: []discount{total, socks},
+			expected: cartResult{
+				CurrencyCode:    "EUR",
+				Total:           9192,
+				DiscountedTotal: 7868,
+				Applied:         []string{"socks", "total"},
+				Amounts:         map[string]int64{"socks": 450, "total": 874},
+			},
+		},
+		{
+			name:      "no discounts apply",
+			discounts: []discount{expensive, dollars},
+			expected: cartResult{
+				CurrencyCode:    "EUR",
+				Total:           9192,
+				DiscountedTotal: 9192,
+				Applied:         []string{},
+				Amounts:         map[string]int64{},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cart, err := decodeCart(testCart)
+			require.NoError(t, err)
+
+			result, err := simulate(tc.discounts, cart)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, result)
+		})
+	}
+}
+
+func TestSimulateErrors(t *testing.T) {
+	cart, err := decodeCart(`{"lineItems": [{"quantity": 1}]}`)
+	require.NoError(t, err)
+	_, err = simulate(nil, cart)
+	assert.EqualError(t, err, "the currency of the cart can't be determined, set totalPrice.currencyCode")
+
+	cart, err = decodeCart(`{"totalPrice": {"currencyCode": "EUR", "centAmount": 0}, "lineItems": [{"quantity": 1}]}`)
+	require.NoError(t, err)
+	_, err = simulate(nil, cart)
+	assert.EqualError(t, err, "lineItems.0 has no price or totalPrice")
+}
+
+func TestRoundHalfEven(t *testing.T) {
+	assert.Equal(t, int64(150), roundHalfEven(999*1500, 10000))
+	assert.Equal(t, int64(2), roundHalfEven(25, 10))
+	assert.Equal(t, int64(4), roundHalfEven(35, 10))
+	assert.Equal(t, int64(3), roundHalfEven(26, 10))
+}
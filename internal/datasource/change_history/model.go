@@ -0,0 +1,154 @@
+package change_history
+
+import (
+	"encoding/json"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/labd/commercetools-go-sdk/history"
+
+	"github.com/labd/terraform-provider-commercetools/internal/utils"
+)
+
+// ChangeHistory maps the data source schema data.
+type ChangeHistory struct {
+	ID           types.String `tfsdk:"id"`
+	ResourceType types.String `tfsdk:"resource_type"`
+	ResourceID   types.String `tfsdk:"resource_id"`
+	ResourceKey  types.String `tfsdk:"resource_key"`
+	DateFrom     types.String `tfsdk:"date_from"`
+	DateTo       types.String `tfsdk:"date_to"`
+	UserID       types.String `tfsdk:"user_id"`
+	ClientID     types.String `tfsdk:"client_id"`
+	Limit        types.Int64  `tfsdk:"limit"`
+	Records      []Record     `tfsdk:"records"`
+}
+
+// Record is a single change of a resource.
+type Record struct {
+	Type            types.String `tfsdk:"type"`
+	Version         types.Int64  `tfsdk:"version"`
+	PreviousVersion types.Int64  `tfsdk:"previous_version"`
+	ModifiedAt      types.String `tfsdk:"modified_at"`
+	ModifiedBy      ModifiedBy   `tfsdk:"modified_by"`
+	ResourceType    types.String `tfsdk:"resource_type"`
+	ResourceID      types.String `tfsdk:"resource_id"`
+	ResourceKey     types.String `tfsdk:"resource_key"`
+	Changes         []Change     `tfsdk:"changes"`
+}
+
+// ModifiedBy describes the user or API client who performed the change.
+type ModifiedBy struct {
+	ID               types.String `tfsdk:"id"`
+	Type             types.String `tfsdk:"type"`
+	ClientID         types.String `tfsdk:"client_id"`
+	IsPlatformClient types.Bool   `tfsdk:"is_platform_client"`
+	Description      types.String `tfsdk:"description"`
+}
+
+// Change is the difference between two versions of a resource. The previous
+// and next values are JSON encoded since their type depends on the change.
+type Change struct {
+	Change        types.String `tfsdk:"change"`
+	Type          types.String `tfsdk:"type"`
+	PreviousValue types.String `tfsdk:"previous_value"`
+	NextValue     types.String `tfsdk:"next_value"`
+}
+
+var changeAttrTypes = map[string]attr.Type{
+	"change":         types.StringType,
+	"type":           types.StringType,
+	"previous_value": types.StringType,
+	"next_value":     types.StringType,
+}
+
+var modifiedByAttrTypes = map[string]attr.Type{
+	"id":                 types.StringType,
+	"type":               types.StringType,
+	"client_id":          types.StringType,
+	"is_platform_client": types.BoolType,
+	"description":        types.StringType,
+}
+
+var recordAttrTypes = map[string]attr.Type{
+	"type":             types.StringType,
+	"version":          types.Int64Type,
+	"previous_version": types.Int64Type,
+	"modified_at":      types.StringType,
+	"modified_by":      types.ObjectType{AttrTypes: modifiedByAttrTypes},
+	"resource_type":    types.StringType,
+	"resource_id":      types.StringType,
+	"resource_key":     types.StringType,
+	"changes":          types.ListType{ElemType: types.ObjectType{AttrTypes: changeAttrTypes}},
+}
+
+func NewRecordFromNative(r history.Record) (Record, error) {
+	record := Record{
+		Type:            types.StringValue(r.Type),
+		Version:         types.Int64Value(int64(r.Version)),
+		PreviousVersion: types.Int64Value(int64(r.PreviousVersion)),
+		ModifiedAt:      types.StringValue(r.ModifiedAt),
+		ModifiedBy: ModifiedBy{
+			ID:               optionalString(r.ModifiedBy.ID),
+			Type:             types.StringValue(r.ModifiedBy.Type),
+			ClientID:         utils.FromOptionalString(r.ModifiedBy.ClientId),
+			IsPlatformClient: types.BoolValue(r.ModifiedBy.IsPlatformClient),
+			Description:      types.StringValue(utils.DescribeModifiedBy(r.ModifiedBy)),
+		},
+		ResourceType: types.StringValue(string(r.Resource.TypeId)),
+		ResourceID:   types.StringValue(r.Resource.ID),
+		ResourceKey:  optionalString(r.Resource.Key),
+		Changes:      []Change{},
+	}
+
+	for _, c := range r.Changes {
+		change, err := newChangeFromNative(c)
+		if err != nil {
+			return Record{}, err
+		}
+		record.Changes = append(record.Changes, change)
+	}
+	return record, nil
+}
+
+// newChangeFromNative converts one of the many change types of the History
+// API to a generic change by using the JSON representation.
+func newChangeFromNative(c history.Change) (Change, error) {
+	data, err := json.Marshal(c)
+	if err != nil {
+		return Change{}, err
+	}
+
+	raw := map[string]json.RawMessage{}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return Change{}, err
+	}
+
+	str := func(key string) types.String {
+		var value string
+		if err := json.Unmarshal(raw[key], &value); err != nil {
+			return types.StringNull()
+		}
+		return types.StringValue(value)
+	}
+	value := func(key string) types.String {
+		if v, ok := raw[key]; ok && string(v) != "null" {
+			return types.StringValue(string(v))
+		}
+		return types.StringNull()
+	}
+
+	return Change{
+		Change:        str("change"),
+		Type:          str("type"),
+		PreviousValue: value("previousValue"),
+		NextValue:     value("nextValue"),
+	}, nil
+}
+
+func optionalString(value string) types.String {
+	if value == "" {
+		return types.StringNull()
+	}
+	return types.StringValue(value)
+}
@@ -0,0 +1,69 @@
+package change_history
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/labd/commercetools-go-sdk/history"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewRecordFromNative(t *testing.T) {
+	clientID := "client-1"
+	record, err := NewRecordFromNative(history.Record{
+		Version:         4,
+		PreviousVersion: 3,
+		Type:            "ResourceUpdated",
+		ModifiedAt:      "2024-01-01T12:00:00.000Z",
+		ModifiedBy: history.ModifiedBy{
+			Type:     "external-user",
+			ClientId: &clientID,
+		},
+		Resource: history.ResourceIdentifier{
+			ID:     "type-id",
+			Key:    "my-type",
+			TypeId: history.ReferenceTypeIdType,
+		},
+		Changes: []history.Change{
+			history.SetKeyChange{Change: "setKey", PreviousValue: "old-key", NextValue: "my-type"},
+			history.ChangeLabelChange{
+				Change:        "changeLabel",
+				PreviousValue: history.LocalizedString{"en": "Old"},
+				NextValue:     history.LocalizedString{"en": "New"},
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, Record{
+		Type:            types.StringValue("ResourceUpdated"),
+		Version:         types.Int64Value(4),
+		PreviousVersion: types.Int64Value(3),
+		ModifiedAt:      types.StringValue("2024-01-01T12:00:00.000Z"),
+		ModifiedBy: ModifiedBy{
+			ID:               types.StringNull(),
+			Type:             types.StringValue("external-user"),
+			ClientID:         types.StringValue("client-1"),
+			IsPlatformClient: types.BoolValue(false),
+			Description:      types.StringValue("API client client-1"),
+		},
+		ResourceType: types.StringValue("type"),
+		ResourceID:   types.StringValue("type-id"),
+		ResourceKey:  types.StringValue("my-type"),
+		Changes: []Change{
+			{
+				Change:        types.StringValue("setKey"),
+				Type:          types.StringValue("SetKeyChange"),
+				PreviousValue: types.StringValue(`"old-key"`),
+				NextValue:     types.StringValue(`"my-type"`),
+			},
+			{
+				Change:        types.StringValue("changeLabel"),
+				Type:          types.StringValue("ChangeLabelChange"),
+				PreviousValue: types.StringValue(`{"en":"Old"}`),
+				NextValue:     types.StringValue(`{"en":"New"}`),
+			},
+		},
+	}, record)
+}
@@ -0,0 +1,263 @@
+package change_history
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/labd/commercetools-go-sdk/history"
+
+	"github.com/labd/terraform-provider-commercetools/internal/customvalidator"
+	"github.com/labd/terraform-provider-commercetools/internal/utils"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource                   = &ChangeHistorySource{}
+	_ datasource.DataSourceWithConfigure      = &ChangeHistorySource{}
+	_ datasource.DataSourceWithValidateConfig = &ChangeHistorySource{}
+)
+
+// defaultLimit is the number of records returned when no limit is set.
+const defaultLimit = 20
+
+// ResourceTypes lists the resource types which are tracked by the History API.
+var ResourceTypes = []string{
+	string(history.ChangeHistoryResourceTypeAssociateRole),
+	string(history.ChangeHistoryResourceTypeBusinessUnit),
+	string(history.ChangeHistoryResourceTypeCartDiscount),
+	string(history.ChangeHistoryResourceTypeCategory),
+	string(history.ChangeHistoryResourceTypeChannel),
+	string(history.ChangeHistoryResourceTypeCustomer),
+	string(history.ChangeHistoryResourceTypeCustomerGroup),
+	string(history.ChangeHistoryResourceTypeDiscountCode),
+	string(history.ChangeHistoryResourceTypeInventoryEntry),
+	string(history.ChangeHistoryResourceTypeKeyValueDocument),
+	string(history.ChangeHistoryResourceTypeOrder),
+	string(history.ChangeHistoryResourceTypePayment),
+	string(history.ChangeHistoryResourceTypeProduct),
+	string(history.ChangeHistoryResourceTypeProductDiscount),
+	string(history.ChangeHistoryResourceTypeProductSelection),
+	string(history.ChangeHistoryResourceTypeProductType),
+	string(history.ChangeHistoryResourceTypeQuoteRequest),
+	string(history.ChangeHistoryResourceTypeQuote),
+	string(history.ChangeHistoryResourceTypeReview),
+	string(history.ChangeHistoryResourceTypeShoppingList),
+	string(history.ChangeHistoryResourceTypeStagedQuote),
+	string(history.ChangeHistoryResourceTypeState),
+	string(history.ChangeHistoryResourceTypeStore),
+	string(history.ChangeHistoryResourceTypeTaxCategory),
+	string(history.ChangeHistoryResourceTypeType),
+	string(history.ChangeHistoryResourceTypeZone),
+}
+
+// NewDataSource is a helper function to simplify the data source implementation.
+func NewDataSource() datasource.DataSource {
+	return &ChangeHistorySource{}
+}
+
+// ChangeHistorySource is the data source implementation.
+type ChangeHistorySource struct {
+	client *history.ByProjectKeyRequestBuilder
+}
+
+// Metadata returns the data source type name.
+func (d *ChangeHistorySource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_change_history"
+}
+
+// Schema defines the schema for the data source.
+func (d *ChangeHistorySource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Fetches the change history of resources from the commercetools History API, for example " +
+			"to find out who modified a resource in the Merchant Center. The API client needs the " +
+			"`view_audit_log` scope. Records are returned with the most recent change first.\n\n" +
+			"See also the [Change History API Documentation](https://docs.commercetools.com/api/history/change-history)",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Identifier of the query",
+				Computed:    true,
+			},
+			"resource_type": schema.StringAttribute{
+				Description: "Only return changes of this resource type, for example `product-type`",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(ResourceTypes...),
+				},
+			},
+			"resource_id": schema.StringAttribute{
+				Description: "Only return changes of the resource with this ID",
+				Optional:    true,
+			},
+			"resource_key": schema.StringAttribute{
+				Description: "Only return changes of the resource with this key",
+				Optional:    true,
+			},
+			"date_from": schema.StringAttribute{
+				Description: "Only return changes made at or after this date, in RFC3339 format",
+				Optional:    true,
+				Validators:  []validator.String{customvalidator.RFC3339()},
+			},
+			"date_to": schema.StringAttribute{
+				Description: "Only return changes made before this date, in RFC3339 format",
+				Optional:    true,
+				Validators:  []validator.String{customvalidator.RFC3339()},
+			},
+			"user_id": schema.StringAttribute{
+				Description: "Only return changes made by the Merchant Center user with this ID",
+				Optional:    true,
+			},
+			"client_id": schema.StringAttribute{
+				Description: "Only return changes made by the API client with this ID",
+				Optional:    true,
+			},
+			"limit": schema.Int64Attribute{
+				Description: fmt.Sprintf("Maximum number of records to return, defaults to %d", defaultLimit),
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 500),
+				},
+			},
+			"records": schema.ListAttribute{
+				Description: "The changes matching the filters. Each record contains the `type` of the change " +
+					"(`ResourceCreated`, `ResourceUpdated` or `ResourceDeleted`), the `version` and " +
+					"`previous_version`, `modified_at`, the `resource_type`, `resource_id` and `resource_key` " +
+					"and the `modified_by` object with the `id`, `type`, `client_id`, `is_platform_client` " +
+					"and a readable `description` of who made the change. The `changes` list contains the " +
+					"`change`, `type` and the JSON encoded `previous_value` and `next_value`",
+				Computed:    true,
+				ElementType: types.ObjectType{AttrTypes: recordAttrTypes},
+			},
+		},
+	}
+}
+
+// ValidateConfig checks that the date range is valid.
+func (d *ChangeHistorySource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var config ChangeHistory
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !isKnown(config.DateFrom) || !isKnown(config.DateTo) {
+		return
+	}
+	from, errFrom := time.Parse(time.RFC3339, config.DateFrom.ValueString())
+	to, errTo := time.Parse(time.RFC3339, config.DateTo.ValueString())
+	if errFrom == nil && errTo == nil && !from.Before(to) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("date_to"),
+			"Invalid date range",
+			"date_to must be after date_from",
+		)
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *ChangeHistorySource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	data := req.ProviderData.(*utils.ProviderData)
+	d.client = data.HistoryClient
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *ChangeHistorySource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	if d.client == nil {
+		resp.Diagnostics.AddError(
+			"Unable to read change history",
+			"The URL of the History API could not be determined from the api_url. "+
+				"Set the history_api_url provider setting or the CTP_HISTORY_API_URL environment variable.",
+		)
+		return
+	}
+
+	var state ChangeHistory
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, err := d.query(state).Execute(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to read change history",
+			err.Error(),
+		)
+		return
+	}
+
+	state.ID = types.StringValue("change-history")
+	state.Records = []Record{}
+	for _, r := range result.Results {
+		record, err := NewRecordFromNative(r)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to read change history",
+				"Could not process the change history record: "+err.Error(),
+			)
+			return
+		}
+		state.Records = append(state.Records, record)
+	}
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (d *ChangeHistorySource) query(config ChangeHistory) *history.ByProjectKeyRequestMethodGet {
+	limit := defaultLimit
+	if isKnown(config.Limit) {
+		limit = int(config.Limit.ValueInt64())
+	}
+
+	query := d.client.Get().Limit(limit)
+	if isKnown(config.ResourceType) {
+		query = query.ResourceTypes([]history.ChangeHistoryResourceType{
+			history.ChangeHistoryResourceType(config.ResourceType.ValueString()),
+		})
+	}
+	if isKnown(config.ResourceID) {
+		query = query.ResourceId(config.ResourceID.ValueString())
+	}
+	if isKnown(config.ResourceKey) {
+		query = query.ResourceKey(config.ResourceKey.ValueString())
+	}
+	if isKnown(config.DateFrom) {
+		query = query.DateFrom(config.DateFrom.ValueString())
+	}
+	if isKnown(config.DateTo) {
+		query = query.DateTo(config.DateTo.ValueString())
+	}
+	if isKnown(config.UserID) {
+		query = query.UserId(config.UserID.ValueString())
+	}
+	if isKnown(config.ClientID) {
+		query = query.ClientId(config.ClientID.ValueString())
+	}
+	return query
+}
+
+type knownValue interface {
+	IsNull() bool
+	IsUnknown() bool
+}
+
+func isKnown(v knownValue) bool {
+	return !v.IsNull() && !v.IsUnknown()
+}
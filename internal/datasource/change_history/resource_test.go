@@ -0,0 +1,36 @@
+package change_history_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/labd/terraform-provider-commercetools/internal/acctest"
+	"github.com/labd/terraform-provider-commercetools/internal/utils"
+)
+
+func TestAccChangeHistory(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfigChangeHistory(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.commercetools_change_history.test", "id", "change-history"),
+					resource.TestCheckResourceAttrSet("data.commercetools_change_history.test", "records.#"),
+				),
+			},
+		},
+	})
+}
+
+func testAccConfigChangeHistory() string {
+	return utils.HCLTemplate(`
+		data "commercetools_change_history" "test" {
+			resource_type = "state"
+			date_from     = "2024-01-01T00:00:00Z"
+			limit         = 10
+		}
+	`, map[string]any{})
+}
@@ -0,0 +1,151 @@
+package channel
+
+import (
+	"math"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/labd/commercetools-go-sdk/platform"
+)
+
+// earthRadius is the mean radius of the earth in meters.
+const earthRadius = 6371008.8
+
+// Channel maps the data source schema data.
+type Channel struct {
+	ID          types.String            `tfsdk:"id"`
+	Key         types.String            `tfsdk:"key"`
+	Role        types.String            `tfsdk:"role"`
+	Latitude    types.Float64           `tfsdk:"latitude"`
+	Longitude   types.Float64           `tfsdk:"longitude"`
+	Radius      types.Float64           `tfsdk:"radius"`
+	Roles       []types.String          `tfsdk:"roles"`
+	Name        map[string]types.String `tfsdk:"name"`
+	Description map[string]types.String `tfsdk:"description"`
+	Geolocation types.Object            `tfsdk:"geolocation"`
+	Distance    types.Float64           `tfsdk:"distance"`
+	Channels    []Match                 `tfsdk:"channels"`
+}
+
+// Match is a channel found by a search by role and geolocation.
+type Match struct {
+	ID       types.String  `tfsdk:"id"`
+	Key      types.String  `tfsdk:"key"`
+	Distance types.Float64 `tfsdk:"distance"`
+}
+
+var geolocationAttrTypes = map[string]attr.Type{
+	"coordinates": types.ListType{ElemType: types.Float64Type},
+}
+
+var matchAttrTypes = map[string]attr.Type{
+	"id":       types.StringType,
+	"key":      types.StringType,
+	"distance": types.Float64Type,
+}
+
+// isSearch returns true when the channel is searched by role and geolocation
+// instead of looked up by key.
+func (c Channel) isSearch() bool {
+	return !c.Role.IsNull()
+}
+
+// setChannel sets the computed attributes of the channel.
+func (c *Channel) setChannel(channel platform.Channel) {
+	c.ID = types.StringValue(channel.ID)
+	c.Key = types.StringValue(channel.Key)
+	c.Roles = make([]types.String, len(channel.Roles))
+	for i, role := range channel.Roles {
+		c.Roles[i] = types.StringValue(string(role))
+	}
+	c.Name = localizedString(channel.Name)
+	c.Description = localizedString(channel.Description)
+	c.Geolocation = types.ObjectNull(geolocationAttrTypes)
+	c.Distance = types.Float64Null()
+
+	if point, ok := geoPoint(channel); ok {
+		c.Geolocation = types.ObjectValueMust(geolocationAttrTypes, map[string]attr.Value{
+			"coordinates": types.ListValueMust(types.Float64Type, []attr.Value{
+				types.Float64Value(point[0]),
+				types.Float64Value(point[1]),
+			}),
+		})
+	}
+}
+
+// setMatches sets the nearest channel and all matches ordered by distance.
+// Channels without a geolocation are skipped.
+func (c *Channel) setMatches(channels []platform.Channel) bool {
+	type match struct {
+		channel  platform.Channel
+		distance float64
+	}
+
+	var matches []match
+	for _, channel := range channels {
+		point, ok := geoPoint(channel)
+		if !ok {
+			continue
+		}
+		d := distance(c.Latitude.ValueFloat64(), c.Longitude.ValueFloat64(), point[1], point[0])
+		matches = append(matches, match{channel: channel, distance: d})
+	}
+	sort.SliceStable(matches, func(i, j int) bool {
+		return matches[i].distance < matches[j].distance
+	})
+
+	c.Channels = make([]Match, len(matches))
+	for i, m := range matches {
+		c.Channels[i] = Match{
+			ID:       types.StringValue(m.channel.ID),
+			Key:      types.StringValue(m.channel.Key),
+			Distance: types.Float64Value(m.distance),
+		}
+	}
+	if len(matches) == 0 {
+		return false
+	}
+	c.setChannel(matches[0].channel)
+	c.Distance = types.Float64Value(matches[0].distance)
+	return true
+}
+
+// geoPoint returns the longitude and latitude of the channel.
+func geoPoint(channel platform.Channel) ([]float64, bool) {
+	var coordinates []float64
+	switch loc := channel.GeoLocation.(type) {
+	case platform.GeoJsonPoint:
+		coordinates = loc.Coordinates
+	case *platform.GeoJsonPoint:
+		if loc != nil {
+			coordinates = loc.Coordinates
+		}
+	}
+	if len(coordinates) != 2 {
+		return nil, false
+	}
+	return coordinates, true
+}
+
+// distance returns the great-circle distance in meters between two points,
+// using the haversine formula.
+func distance(lat1, lng1, lat2, lng2 float64) float64 {
+	toRad := func(deg float64) float64 { return deg * math.Pi / 180 }
+	dLat := toRad(lat2 - lat1)
+	dLng := toRad(lng2 - lng1)
+	a := math.Sin(dLat/2)*math.Sin(dLat/2) +
+		math.Cos(toRad(lat1))*math.Cos(toRad(lat2))*math.Sin(dLng/2)*math.Sin(dLng/2)
+	return 2 * earthRadius * math.Asin(math.Sqrt(a))
+}
+
+func localizedString(value *platform.LocalizedString) map[string]types.String {
+	if value == nil {
+		return nil
+	}
+	result := make(map[string]types.String, len(*value))
+	for locale, text := range *value {
+		result[locale] = types.StringValue(text)
+	}
+	return result
+}
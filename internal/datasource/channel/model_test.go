@@ -0,0 +1,78 @@
+package channel
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/labd/commercetools-go-sdk/platform"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDistance(t *testing.T) {
+	// Amsterdam Centraal to Utrecht Centraal is about 35 kilometers
+	d := distance(52.3791, 4.9003, 52.0894, 5.1100)
+	assert.InDelta(t, 35300, d, 500)
+	assert.Zero(t, distance(52.3791, 4.9003, 52.3791, 4.9003))
+}
+
+func TestChannelSetMatches(t *testing.T) {
+	channels := []platform.Channel{
+		{
+			ID:          "utrecht-id",
+			Key:         "utrecht",
+			Roles:       []platform.ChannelRoleEnum{platform.ChannelRoleEnumInventorySupply},
+			GeoLocation: platform.GeoJsonPoint{Coordinates: []float64{5.1100, 52.0894}},
+		},
+		{
+			ID:    "unknown-id",
+			Key:   "unknown",
+			Roles: []platform.ChannelRoleEnum{platform.ChannelRoleEnumInventorySupply},
+		},
+		{
+			ID:          "amsterdam-id",
+			Key:         "amsterdam",
+			Name:        &platform.LocalizedString{"en": "Amsterdam"},
+			Roles:       []platform.ChannelRoleEnum{platform.ChannelRoleEnumInventorySupply},
+			GeoLocation: platform.GeoJsonPoint{Coordinates: []float64{4.9003, 52.3791}},
+		},
+	}
+
+	c := Channel{
+		Role:      types.StringValue("InventorySupply"),
+		Latitude:  types.Float64Value(52.37),
+		Longitude: types.Float64Value(4.89),
+		Radius:    types.Float64Value(50000),
+	}
+	require.True(t, c.setMatches(channels))
+
+	assert.Equal(t, "amsterdam-id", c.ID.ValueString())
+	assert.Equal(t, "amsterdam", c.Key.ValueString())
+	assert.Equal(t, map[string]types.String{"en": types.StringValue("Amsterdam")}, c.Name)
+	assert.Equal(t, []types.String{types.StringValue("InventorySupply")}, c.Roles)
+	assert.Less(t, c.Distance.ValueFloat64(), 2000.0)
+
+	require.Len(t, c.Channels, 2)
+	assert.Equal(t, "amsterdam", c.Channels[0].Key.ValueString())
+	assert.Equal(t, "utrecht", c.Channels[1].Key.ValueString())
+	assert.Greater(t, c.Channels[1].Distance.ValueFloat64(), c.Channels[0].Distance.ValueFloat64())
+
+	c = Channel{Latitude: types.Float64Value(0), Longitude: types.Float64Value(0)}
+	assert.False(t, c.setMatches(channels[1:2]))
+	assert.Empty(t, c.Channels)
+}
+
+func TestChannelSetChannel(t *testing.T) {
+	var c Channel
+	c.setChannel(platform.Channel{
+		ID:    "web-id",
+		Key:   "web",
+		Roles: []platform.ChannelRoleEnum{platform.ChannelRoleEnumProductDistribution},
+	})
+
+	assert.Equal(t, "web-id", c.ID.ValueString())
+	assert.True(t, c.Geolocation.IsNull())
+	assert.True(t, c.Distance.IsNull())
+	assert.Nil(t, c.Name)
+	assert.False(t, c.isSearch())
+}
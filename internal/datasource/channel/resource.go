@@ -0,0 +1,200 @@
+package channel
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/labd/commercetools-go-sdk/platform"
+
+	"github.com/labd/terraform-provider-commercetools/internal/utils"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &ChannelSource{}
+	_ datasource.DataSourceWithConfigure = &ChannelSource{}
+)
+
+// pageSize is the maximum number of channels which are searched.
+const pageSize = 500
+
+// NewDataSource is a helper function to simplify the data source implementation.
+func NewDataSource() datasource.DataSource {
+	return &ChannelSource{}
+}
+
+// ChannelSource is the data source implementation.
+type ChannelSource struct {
+	client *platform.ByProjectKeyRequestBuilder
+}
+
+// Metadata returns the data source type name.
+func (d *ChannelSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_channel"
+}
+
+// Schema defines the schema for the data source.
+func (d *ChannelSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Fetches a channel by `key`, or the nearest channel with a `role` within the " +
+			"`radius` of a location. The roles of the channel are returned, so they can be checked before " +
+			"the channel is used, for example as supply channel of a store.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "ID of the channel",
+				Computed:    true,
+			},
+			"key": schema.StringAttribute{
+				Description: "Key of the channel to look up",
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("role")),
+				},
+			},
+			"role": schema.StringAttribute{
+				MarkdownDescription: "Role of the channel to search for. Requires `latitude`, `longitude` and `radius`",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						string(platform.ChannelRoleEnumInventorySupply),
+						string(platform.ChannelRoleEnumProductDistribution),
+						string(platform.ChannelRoleEnumOrderExport),
+						string(platform.ChannelRoleEnumOrderImport),
+						string(platform.ChannelRoleEnumPrimary),
+					),
+					stringvalidator.AlsoRequires(
+						path.MatchRoot("latitude"),
+						path.MatchRoot("longitude"),
+						path.MatchRoot("radius"),
+					),
+				},
+			},
+			"latitude": schema.Float64Attribute{
+				Description: "Latitude of the location to search from",
+				Optional:    true,
+				Validators: []validator.Float64{
+					float64validator.Between(-90, 90),
+					float64validator.AlsoRequires(path.MatchRoot("role")),
+				},
+			},
+			"longitude": schema.Float64Attribute{
+				Description: "Longitude of the location to search from",
+				Optional:    true,
+				Validators: []validator.Float64{
+					float64validator.Between(-180, 180),
+					float64validator.AlsoRequires(path.MatchRoot("role")),
+				},
+			},
+			"radius": schema.Float64Attribute{
+				Description: "Radius in meters around the location to search in",
+				Optional:    true,
+				Validators: []validator.Float64{
+					float64validator.AtLeast(0),
+					float64validator.AlsoRequires(path.MatchRoot("role")),
+				},
+			},
+			"roles": schema.ListAttribute{
+				Description: "Roles of the channel",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+			"name": schema.MapAttribute{
+				MarkdownDescription: "[LocalizedString](https://docs.commercetools.com/api/types#localizedstring)",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"description": schema.MapAttribute{
+				MarkdownDescription: "[LocalizedString](https://docs.commercetools.com/api/types#localizedstring)",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"geolocation": schema.ObjectAttribute{
+				MarkdownDescription: "Geolocation of the channel, the `coordinates` are the longitude and latitude",
+				Computed:            true,
+				AttributeTypes:      geolocationAttrTypes,
+			},
+			"distance": schema.Float64Attribute{
+				Description: "Distance in meters between the location and the channel, when searched by role",
+				Computed:    true,
+			},
+			"channels": schema.ListAttribute{
+				MarkdownDescription: "All channels found by the search ordered by `distance`, with their `id` and " +
+					"`key`. The first one is the returned channel",
+				Computed:    true,
+				ElementType: types.ObjectType{AttrTypes: matchAttrTypes},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *ChannelSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	data := req.ProviderData.(*utils.ProviderData)
+	d.client = data.Client
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *ChannelSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state Channel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if state.isSearch() {
+		where := []string{
+			fmt.Sprintf("roles contains %q", state.Role.ValueString()),
+			fmt.Sprintf("geoLocation within circle(%g, %g, %g)",
+				state.Longitude.ValueFloat64(), state.Latitude.ValueFloat64(), state.Radius.ValueFloat64()),
+		}
+		result, err := d.client.Channels().Get().Where(where).Limit(pageSize).Execute(ctx)
+		if err != nil {
+			resp.Diagnostics.AddError("Unable to search channels", err.Error())
+			return
+		}
+		if !state.setMatches(result.Results) {
+			resp.Diagnostics.AddError(
+				"No channel found",
+				fmt.Sprintf("No channel with the %s role was found within %g meters of the location",
+					state.Role.ValueString(), state.Radius.ValueFloat64()),
+			)
+			return
+		}
+	} else {
+		result, err := d.client.Channels().Get().
+			Where([]string{fmt.Sprintf("key = %q", state.Key.ValueString())}).
+			Execute(ctx)
+		if err != nil {
+			resp.Diagnostics.AddError("Unable to read channel", err.Error())
+			return
+		}
+		if len(result.Results) == 0 {
+			resp.Diagnostics.AddError(
+				"Unable to read channel",
+				fmt.Sprintf("No channel with key %q was found", state.Key.ValueString()),
+			)
+			return
+		}
+		state.setChannel(result.Results[0])
+		state.Channels = []Match{}
+	}
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
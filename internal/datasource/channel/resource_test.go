@@ -0,0 +1,59 @@
+package channel_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/labd/terraform-provider-commercetools/internal/acctest"
+)
+
+func TestAccChannelDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource "commercetools_channel" "acctest_amsterdam" {
+						key   = "acctest-amsterdam"
+						roles = ["InventorySupply"]
+						geolocation {
+							coordinates = [4.9003, 52.3791]
+						}
+					}
+
+					resource "commercetools_channel" "acctest_utrecht" {
+						key   = "acctest-utrecht"
+						roles = ["InventorySupply", "ProductDistribution"]
+						geolocation {
+							coordinates = [5.1100, 52.0894]
+						}
+					}
+
+					data "commercetools_channel" "by_key" {
+						key = commercetools_channel.acctest_utrecht.key
+					}
+
+					data "commercetools_channel" "nearest" {
+						role      = "InventorySupply"
+						latitude  = 52.37
+						longitude = 4.89
+						radius    = 50000
+
+						depends_on = [commercetools_channel.acctest_amsterdam, commercetools_channel.acctest_utrecht]
+					}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.commercetools_channel.by_key", "id",
+						"commercetools_channel.acctest_utrecht", "id"),
+					resource.TestCheckResourceAttr("data.commercetools_channel.by_key", "roles.#", "2"),
+					resource.TestCheckResourceAttr("data.commercetools_channel.nearest", "key", "acctest-amsterdam"),
+					resource.TestCheckResourceAttr("data.commercetools_channel.nearest", "channels.#", "2"),
+					resource.TestCheckResourceAttr("data.commercetools_channel.nearest", "channels.1.key",
+						"acctest-utrecht"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,126 @@
+package import_container_summary
+
+import (
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/labd/commercetools-go-sdk/importapi"
+)
+
+// ImportSummary maps the data source schema data.
+type ImportSummary struct {
+	ID            types.String          `tfsdk:"id"`
+	Key           types.String          `tfsdk:"key"`
+	Total         types.Int64           `tfsdk:"total"`
+	Processing    types.Int64           `tfsdk:"processing"`
+	Imported      types.Int64           `tfsdk:"imported"`
+	Errors        types.Int64           `tfsdk:"errors"`
+	ResourceTypes []ResourceTypeSummary `tfsdk:"resource_types"`
+}
+
+// ResourceTypeSummary contains the number of import operations in each
+// processing state for a single resource type.
+type ResourceTypeSummary struct {
+	ResourceType         types.String `tfsdk:"resource_type"`
+	Containers           types.Int64  `tfsdk:"containers"`
+	Total                types.Int64  `tfsdk:"total"`
+	Processing           types.Int64  `tfsdk:"processing"`
+	Imported             types.Int64  `tfsdk:"imported"`
+	Errors               types.Int64  `tfsdk:"errors"`
+	ValidationFailed     types.Int64  `tfsdk:"validation_failed"`
+	Unresolved           types.Int64  `tfsdk:"unresolved"`
+	WaitForMasterVariant types.Int64  `tfsdk:"wait_for_master_variant"`
+	Rejected             types.Int64  `tfsdk:"rejected"`
+	Canceled             types.Int64  `tfsdk:"canceled"`
+}
+
+// containerSummary is the import summary of a single container.
+type containerSummary struct {
+	Container importapi.ImportContainer
+	Summary   importapi.ImportSummary
+}
+
+type counts struct {
+	containers int
+	total      int
+	states     importapi.OperationStates
+}
+
+// errors returns the number of operations which failed and need attention.
+// Unresolved operations are not counted, they are still waiting for the
+// referenced resources to be imported.
+func (c counts) errors() int {
+	return c.states.ValidationFailed + c.states.Rejected
+}
+
+// summarize aggregates the container summaries per resource type. Containers
+// without a resource type are grouped together with a null resource type.
+func summarize(key types.String, items []containerSummary) ImportSummary {
+	perType := map[string]*counts{}
+	totals := counts{}
+
+	for _, item := range items {
+		resourceType := ""
+		if item.Container.ResourceType != nil {
+			resourceType = string(*item.Container.ResourceType)
+		}
+
+		c, ok := perType[resourceType]
+		if !ok {
+			c = &counts{}
+			perType[resourceType] = c
+		}
+		for _, target := range []*counts{c, &totals} {
+			target.containers++
+			target.total += item.Summary.Total
+			target.states.Processing += item.Summary.States.Processing
+			target.states.ValidationFailed += item.Summary.States.ValidationFailed
+			target.states.Unresolved += item.Summary.States.Unresolved
+			target.states.WaitForMasterVariant += item.Summary.States.WaitForMasterVariant
+			target.states.Imported += item.Summary.States.Imported
+			target.states.Rejected += item.Summary.States.Rejected
+			target.states.Canceled += item.Summary.States.Canceled
+		}
+	}
+
+	resourceTypes := make([]string, 0, len(perType))
+	for rt := range perType {
+		resourceTypes = append(resourceTypes, rt)
+	}
+	sort.Strings(resourceTypes)
+
+	result := ImportSummary{
+		ID:            types.StringValue("import-summary"),
+		Key:           key,
+		Total:         types.Int64Value(int64(totals.total)),
+		Processing:    types.Int64Value(int64(totals.states.Processing)),
+		Imported:      types.Int64Value(int64(totals.states.Imported)),
+		Errors:        types.Int64Value(int64(totals.errors())),
+		ResourceTypes: []ResourceTypeSummary{},
+	}
+	if !key.IsNull() {
+		result.ID = key
+	}
+
+	for _, rt := range resourceTypes {
+		c := perType[rt]
+		resourceType := types.StringNull()
+		if rt != "" {
+			resourceType = types.StringValue(rt)
+		}
+		result.ResourceTypes = append(result.ResourceTypes, ResourceTypeSummary{
+			ResourceType:         resourceType,
+			Containers:           types.Int64Value(int64(c.containers)),
+			Total:                types.Int64Value(int64(c.total)),
+			Processing:           types.Int64Value(int64(c.states.Processing)),
+			Imported:             types.Int64Value(int64(c.states.Imported)),
+			Errors:               types.Int64Value(int64(c.errors())),
+			ValidationFailed:     types.Int64Value(int64(c.states.ValidationFailed)),
+			Unresolved:           types.Int64Value(int64(c.states.Unresolved)),
+			WaitForMasterVariant: types.Int64Value(int64(c.states.WaitForMasterVariant)),
+			Rejected:             types.Int64Value(int64(c.states.Rejected)),
+			Canceled:             types.Int64Value(int64(c.states.Canceled)),
+		})
+	}
+	return result
+}
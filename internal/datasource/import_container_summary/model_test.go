@@ -0,0 +1,80 @@
+package import_container_summary
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/labd/commercetools-go-sdk/importapi"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSummarize(t *testing.T) {
+	productDraft := importapi.ImportResourceTypeProductDraft
+	price := importapi.ImportResourceTypePrice
+
+	items := []containerSummary{
+		{
+			Container: importapi.ImportContainer{Key: "products-1", ResourceType: &productDraft},
+			Summary: importapi.ImportSummary{
+				Total:  10,
+				States: importapi.OperationStates{Processing: 2, Imported: 6, ValidationFailed: 1, Rejected: 1},
+			},
+		},
+		{
+			Container: importapi.ImportContainer{Key: "products-2", ResourceType: &productDraft},
+			Summary: importapi.ImportSummary{
+				Total:  5,
+				States: importapi.OperationStates{Imported: 4, Unresolved: 1},
+			},
+		},
+		{
+			Container: importapi.ImportContainer{Key: "prices", ResourceType: &price},
+			Summary: importapi.ImportSummary{
+				Total:  3,
+				States: importapi.OperationStates{Imported: 3},
+			},
+		},
+		{
+			Container: importapi.ImportContainer{Key: "mixed"},
+			Summary: importapi.ImportSummary{
+				Total:  1,
+				States: importapi.OperationStates{Canceled: 1},
+			},
+		},
+	}
+
+	result := summarize(types.StringNull(), items)
+	assert.Equal(t, types.StringValue("import-summary"), result.ID)
+	assert.Equal(t, types.Int64Value(19), result.Total)
+	assert.Equal(t, types.Int64Value(2), result.Processing)
+	assert.Equal(t, types.Int64Value(13), result.Imported)
+	assert.Equal(t, types.Int64Value(2), result.Errors)
+
+	assert.Len(t, result.ResourceTypes, 3)
+	assert.Equal(t, types.StringNull(), result.ResourceTypes[0].ResourceType)
+	assert.Equal(t, types.Int64Value(1), result.ResourceTypes[0].Canceled)
+	assert.Equal(t, types.StringValue("price"), result.ResourceTypes[1].ResourceType)
+	assert.Equal(t, ResourceTypeSummary{
+		ResourceType:         types.StringValue("product-draft"),
+		Containers:           types.Int64Value(2),
+		Total:                types.Int64Value(15),
+		Processing:           types.Int64Value(2),
+		Imported:             types.Int64Value(10),
+		Errors:               types.Int64Value(2),
+		ValidationFailed:     types.Int64Value(1),
+		Unresolved:           types.Int64Value(1),
+		WaitForMasterVariant: types.Int64Value(0),
+		Rejected:             types.Int64Value(1),
+		Canceled:             types.Int64Value(0),
+	}, result.ResourceTypes[2])
+}
+
+func TestSummarizeSingleContainer(t *testing.T) {
+	result := summarize(types.StringValue("products"), []containerSummary{
+		{Container: importapi.ImportContainer{Key: "products"}},
+	})
+	assert.Equal(t, types.StringValue("products"), result.ID)
+	assert.Equal(t, types.StringValue("products"), result.Key)
+	assert.Equal(t, types.Int64Value(0), result.Total)
+	assert.Len(t, result.ResourceTypes, 1)
+}
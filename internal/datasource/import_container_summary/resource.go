@@ -0,0 +1,183 @@
+package import_container_summary
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/labd/commercetools-go-sdk/importapi"
+
+	"github.com/labd/terraform-provider-commercetools/internal/utils"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &ImportSummarySource{}
+	_ datasource.DataSourceWithConfigure = &ImportSummarySource{}
+)
+
+// pageSize is the number of import containers retrieved per request.
+const pageSize = 500
+
+// NewDataSource is a helper function to simplify the data source implementation.
+func NewDataSource() datasource.DataSource {
+	return &ImportSummarySource{}
+}
+
+// ImportSummarySource is the data source implementation.
+type ImportSummarySource struct {
+	client *importapi.ByProjectKeyRequestBuilder
+}
+
+// Metadata returns the data source type name.
+func (d *ImportSummarySource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_import_container_summary"
+}
+
+// Schema defines the schema for the data source.
+func (d *ImportSummarySource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	countAttribute := func(description string) schema.Int64Attribute {
+		return schema.Int64Attribute{
+			Description: description,
+			Computed:    true,
+		}
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Fetches the import summaries of Import Containers of the commercetools Import API. " +
+			"The number of import operations in each processing state is reported per resource type, " +
+			"which can for example be used to gate a pipeline on the status of an import.\n\n" +
+			"Errors are the operations in the `validationFailed` or `rejected` state.\n\n" +
+			"See also the [Import Summary API Documentation](https://docs.commercetools.com/import-export/import-summary)",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The key of the Import Container, or `import-summary` when all containers are summarized",
+				Computed:    true,
+			},
+			"key": schema.StringAttribute{
+				Description: "Key of the Import Container to summarize. If not set all Import Containers of the project are summarized",
+				Optional:    true,
+			},
+			"total":      countAttribute("Total number of import operations"),
+			"processing": countAttribute("Number of import operations in the `processing` state"),
+			"imported":   countAttribute("Number of import operations in the `imported` state"),
+			"errors":     countAttribute("Number of import operations in the `validationFailed` or `rejected` state"),
+			"resource_types": schema.ListAttribute{
+				Description: "The import summary per resource type of the Import Containers. Each entry " +
+					"contains the `resource_type`, the number of `containers` and the number of import " +
+					"operations: `total`, `processing`, `imported`, `errors`, `validation_failed`, " +
+					"`unresolved`, `wait_for_master_variant`, `rejected` and `canceled`. Containers " +
+					"without a resource type are grouped in an entry without `resource_type`",
+				Computed: true,
+				ElementType: types.ObjectType{
+					AttrTypes: map[string]attr.Type{
+						"resource_type":           types.StringType,
+						"containers":              types.Int64Type,
+						"total":                   types.Int64Type,
+						"processing":              types.Int64Type,
+						"imported":                types.Int64Type,
+						"errors":                  types.Int64Type,
+						"validation_failed":       types.Int64Type,
+						"unresolved":              types.Int64Type,
+						"wait_for_master_variant": types.Int64Type,
+						"rejected":                types.Int64Type,
+						"canceled":                types.Int64Type,
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *ImportSummarySource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	data := req.ProviderData.(*utils.ProviderData)
+	d.client = data.ImportClient
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *ImportSummarySource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	if d.client == nil {
+		resp.Diagnostics.AddError("Unable to read import summary", utils.ImportClientMissingError)
+		return
+	}
+
+	var config ImportSummary
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	containers, err := d.containers(ctx, config)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to read import containers",
+			err.Error(),
+		)
+		return
+	}
+
+	items := make([]containerSummary, 0, len(containers))
+	for _, container := range containers {
+		summary, err := d.client.ImportContainers().
+			WithImportContainerKeyValue(container.Key).
+			ImportSummaries().
+			Get().
+			Execute(ctx)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to read import summary",
+				fmt.Sprintf("Could not retrieve the import summary of container %s: %s", container.Key, err.Error()),
+			)
+			return
+		}
+		items = append(items, containerSummary{Container: container, Summary: *summary})
+	}
+
+	state := summarize(config.Key, items)
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// containers returns the container given by the key, or all containers of the
+// project if no key is set.
+func (d *ImportSummarySource) containers(ctx context.Context, config ImportSummary) ([]importapi.ImportContainer, error) {
+	if !config.Key.IsNull() {
+		container, err := d.client.ImportContainers().
+			WithImportContainerKeyValue(config.Key.ValueString()).
+			Get().
+			Execute(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return []importapi.ImportContainer{*container}, nil
+	}
+
+	var result []importapi.ImportContainer
+	for offset := 0; ; offset += pageSize {
+		page, err := d.client.ImportContainers().
+			Get().
+			Limit(pageSize).
+			Offset(offset).
+			Execute(ctx)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, page.Results...)
+		if len(page.Results) < pageSize {
+			return result, nil
+		}
+	}
+}
@@ -0,0 +1,152 @@
+package region
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/labd/terraform-provider-commercetools/internal/iso3166"
+	"github.com/labd/terraform-provider-commercetools/internal/utils"
+)
+
+const (
+	stateFormatName = "name"
+	stateFormatCode = "code"
+)
+
+// Region maps the data source schema data.
+type Region struct {
+	ID          types.String   `tfsdk:"id"`
+	Alias       types.String   `tfsdk:"alias"`
+	Exclude     []types.String `tfsdk:"exclude"`
+	StateFormat types.String   `tfsdk:"state_format"`
+	Countries   []types.String `tfsdk:"countries"`
+	Locations   []Location     `tfsdk:"locations"`
+}
+
+// Location is a country, or a state of a country, of the region.
+type Location struct {
+	Country types.String `tfsdk:"country"`
+	State   types.String `tfsdk:"state"`
+}
+
+var locationAttrTypes = map[string]attr.Type{
+	"country": types.StringType,
+	"state":   types.StringType,
+}
+
+var euCountries = []string{
+	"AT", "BE", "BG", "CY", "CZ", "DE", "DK", "EE", "ES", "FI", "FR", "GR", "HR", "HU",
+	"IE", "IT", "LT", "LU", "LV", "MT", "NL", "PL", "PT", "RO", "SE", "SI", "SK",
+}
+
+// aliases are the regions by alias. Regions consist either of whole countries,
+// or of the states of a single country.
+var aliases = map[string]func() []Location{
+	"EU":            countries(euCountries...),
+	"EEA":           countries(append([]string{"IS", "LI", "NO"}, euCountries...)...),
+	"EFTA":          countries("CH", "IS", "LI", "NO"),
+	"DACH":          countries("AT", "CH", "DE"),
+	"BENELUX":       countries("BE", "LU", "NL"),
+	"NORDICS":       countries("DK", "FI", "IS", "NO", "SE"),
+	"US":            states("US"),
+	"US-contiguous": states("US", "US-AK", "US-HI"),
+}
+
+// Aliases returns the supported region aliases.
+func Aliases() []string {
+	result := make([]string, 0, len(aliases))
+	for alias := range aliases {
+		result = append(result, alias)
+	}
+	sort.Strings(result)
+	return result
+}
+
+func countries(codes ...string) func() []Location {
+	codes = slices.Clone(codes)
+	sort.Strings(codes)
+	return func() []Location {
+		result := make([]Location, len(codes))
+		for i, code := range codes {
+			result[i] = Location{Country: types.StringValue(code), State: types.StringNull()}
+		}
+		return result
+	}
+}
+
+// states returns the states and districts of the country, without the
+// excluded subdivisions. Outlying areas, which have their own country codes,
+// are not included.
+func states(country string, exclude ...string) func() []Location {
+	return func() []Location {
+		var result []Location
+		for _, s := range iso3166.Subdivisions(country) {
+			if (s.Type != "State" && s.Type != "District") || slices.Contains(exclude, s.Code) {
+				continue
+			}
+			result = append(result, Location{Country: types.StringValue(country), State: types.StringValue(s.Code)})
+		}
+		return result
+	}
+}
+
+// expand sets the countries and locations of the region alias.
+func (r *Region) expand() error {
+	alias := r.Alias.ValueString()
+	locations, ok := aliases[alias]
+	if !ok {
+		return fmt.Errorf("unknown region alias %q", alias)
+	}
+
+	candidates := locations()
+	keys := make([]string, len(candidates))
+	for i, l := range candidates {
+		keys[i] = l.key()
+	}
+	excluded := map[string]bool{}
+	for _, e := range r.Exclude {
+		code := e.ValueString()
+		if !slices.Contains(keys, code) {
+			message := fmt.Sprintf("%q is not part of region %s", code, alias)
+			if suggestion := utils.ClosestMatch(code, keys); suggestion != "" {
+				message += fmt.Sprintf(", did you mean %q?", suggestion)
+			}
+			return fmt.Errorf("%s", message)
+		}
+		excluded[code] = true
+	}
+
+	r.ID = r.Alias
+	r.Countries = []types.String{}
+	r.Locations = []Location{}
+	for _, l := range candidates {
+		if excluded[l.key()] {
+			continue
+		}
+		if !slices.Contains(r.Countries, l.Country) {
+			r.Countries = append(r.Countries, l.Country)
+		}
+		if !l.State.IsNull() {
+			s, _ := iso3166.LookupSubdivision(l.Country.ValueString(), l.State.ValueString())
+			if r.StateFormat.ValueString() == stateFormatCode {
+				l.State = types.StringValue(s.ShortCode())
+			} else {
+				l.State = types.StringValue(s.Name)
+			}
+		}
+		r.Locations = append(r.Locations, l)
+	}
+	return nil
+}
+
+// key returns the country code, or the subdivision code for states.
+func (l Location) key() string {
+	if l.State.IsNull() {
+		return l.Country.ValueString()
+	}
+	return l.State.ValueString()
+}
@@ -0,0 +1,59 @@
+package region
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRegionExpandCountries(t *testing.T) {
+	r := Region{
+		Alias:   types.StringValue("DACH"),
+		Exclude: []types.String{types.StringValue("CH")},
+	}
+	require.NoError(t, r.expand())
+	assert.Equal(t, types.StringValue("DACH"), r.ID)
+	assert.Equal(t, []types.String{types.StringValue("AT"), types.StringValue("DE")}, r.Countries)
+	assert.Equal(t, []Location{
+		{Country: types.StringValue("AT"), State: types.StringNull()},
+		{Country: types.StringValue("DE"), State: types.StringNull()},
+	}, r.Locations)
+
+	r = Region{Alias: types.StringValue("EEA")}
+	require.NoError(t, r.expand())
+	assert.Len(t, r.Countries, 30)
+	assert.Equal(t, types.StringValue("AT"), r.Countries[0])
+}
+
+func TestRegionExpandStates(t *testing.T) {
+	r := Region{Alias: types.StringValue("US")}
+	require.NoError(t, r.expand())
+	assert.Equal(t, []types.String{types.StringValue("US")}, r.Countries)
+	assert.Len(t, r.Locations, 51)
+	assert.Equal(t, Location{Country: types.StringValue("US"), State: types.StringValue("Alaska")}, r.Locations[0])
+
+	r = Region{
+		Alias:       types.StringValue("US-contiguous"),
+		Exclude:     []types.String{types.StringValue("US-DC")},
+		StateFormat: types.StringValue("code"),
+	}
+	require.NoError(t, r.expand())
+	assert.Len(t, r.Locations, 48)
+	assert.Equal(t, Location{Country: types.StringValue("US"), State: types.StringValue("AL")}, r.Locations[0])
+}
+
+func TestRegionExpandInvalidExclude(t *testing.T) {
+	r := Region{
+		Alias:   types.StringValue("US-contiguous"),
+		Exclude: []types.String{types.StringValue("US-AK")},
+	}
+	assert.EqualError(t, r.expand(), `"US-AK" is not part of region US-contiguous, did you mean "US-AL"?`)
+
+	r = Region{
+		Alias:   types.StringValue("EU"),
+		Exclude: []types.String{types.StringValue("CH")},
+	}
+	assert.EqualError(t, r.expand(), `"CH" is not part of region EU, did you mean "CY"?`)
+}
@@ -0,0 +1,129 @@
+package region
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource                   = &RegionSource{}
+	_ datasource.DataSourceWithValidateConfig = &RegionSource{}
+)
+
+// NewDataSource is a helper function to simplify the data source implementation.
+func NewDataSource() datasource.DataSource {
+	return &RegionSource{}
+}
+
+// RegionSource is the data source implementation.
+type RegionSource struct{}
+
+// Metadata returns the data source type name.
+func (d *RegionSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_region"
+}
+
+// Schema defines the schema for the data source.
+func (d *RegionSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Expands a region alias into its countries and locations, without calling the " +
+			"commercetools API. The locations can be used as `location` blocks of a `commercetools_shipping_zone`.\n\n" +
+			"The `EU`, `EEA`, `EFTA`, `DACH`, `BENELUX` and `NORDICS` regions consist of whole countries. " +
+			"The `US` region consists of the states and the District of Columbia, and `US-contiguous` excludes " +
+			"Alaska and Hawaii from it. Outlying areas like Puerto Rico have their own country codes and are " +
+			"not included.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The region alias",
+				Computed:    true,
+			},
+			"alias": schema.StringAttribute{
+				Description: "The region alias to expand",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(Aliases()...),
+				},
+			},
+			"exclude": schema.ListAttribute{
+				MarkdownDescription: "Country codes, or for the `US` regions subdivision codes like `US-AK`, " +
+					"to exclude from the region",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"state_format": schema.StringAttribute{
+				MarkdownDescription: "Whether the `state` of the locations is the `name` of the subdivision, " +
+					"like `California`, or its `code`, like `CA`. Defaults to `name`",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(stateFormatName, stateFormatCode),
+				},
+			},
+			"countries": schema.ListAttribute{
+				Description: "The ISO 3166-1 alpha-2 codes of the countries of the region",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+			"locations": schema.ListAttribute{
+				MarkdownDescription: "The locations of the region, with a `country` and a `state`. The `state` " +
+					"is null for regions which consist of whole countries",
+				Computed:    true,
+				ElementType: types.ObjectType{AttrTypes: locationAttrTypes},
+			},
+		},
+	}
+}
+
+// ValidateConfig checks that the excluded locations are part of the region.
+func (d *RegionSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var config Region
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if _, ok := aliases[config.Alias.ValueString()]; !ok {
+		// Unknown or invalid aliases are reported by the validator of the alias
+		return
+	}
+	for _, e := range config.Exclude {
+		if e.IsUnknown() {
+			return
+		}
+	}
+	if err := config.expand(); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("exclude"),
+			"Invalid exclude",
+			err.Error(),
+		)
+	}
+}
+
+// Read expands the region alias.
+func (d *RegionSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state Region
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := state.expand(); err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to expand region",
+			err.Error(),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
@@ -0,0 +1,43 @@
+package region_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/labd/terraform-provider-commercetools/internal/acctest"
+)
+
+func TestAccRegion(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					data "commercetools_region" "test" {
+						alias        = "US-contiguous"
+						exclude      = ["US-DC"]
+						state_format = "code"
+					}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.commercetools_region.test", "countries.#", "1"),
+					resource.TestCheckResourceAttr("data.commercetools_region.test", "locations.#", "48"),
+					resource.TestCheckResourceAttr("data.commercetools_region.test", "locations.0.country", "US"),
+					resource.TestCheckResourceAttr("data.commercetools_region.test", "locations.0.state", "AL"),
+				),
+			},
+			{
+				Config: `
+					data "commercetools_region" "test" {
+						alias   = "EU"
+						exclude = ["UK"]
+					}
+				`,
+				ExpectError: regexp.MustCompile(`"UK" is not part of region EU`),
+			},
+		},
+	})
+}
@@ -0,0 +1,285 @@
+package state_machine
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/elliotchance/pie/v2"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/labd/commercetools-go-sdk/platform"
+)
+
+// StateMachine maps the data source schema data.
+type StateMachine struct {
+	ID       types.String   `tfsdk:"id"`
+	Type     types.String   `tfsdk:"type"`
+	Validate types.Bool     `tfsdk:"validate"`
+	States   []State        `tfsdk:"states"`
+	Problems []types.String `tfsdk:"problems"`
+	DOT      types.String   `tfsdk:"dot"`
+	Mermaid  types.String   `tfsdk:"mermaid"`
+}
+
+// State is a single node of the state machine.
+type State struct {
+	ID           types.String   `tfsdk:"id"`
+	Key          types.String   `tfsdk:"key"`
+	Initial      types.Bool     `tfsdk:"initial"`
+	Terminal     types.Bool     `tfsdk:"terminal"`
+	Unrestricted types.Bool     `tfsdk:"unrestricted"`
+	Transitions  []types.String `tfsdk:"transitions"`
+}
+
+var stateAttrTypes = map[string]attr.Type{
+	"id":           types.StringType,
+	"key":          types.StringType,
+	"initial":      types.BoolType,
+	"terminal":     types.BoolType,
+	"unrestricted": types.BoolType,
+	"transitions":  types.ListType{ElemType: types.StringType},
+}
+
+// stateMachine is the graph of all states of a single type. Transitions to
+// states of another type are kept in the graph so they can be reported, but
+// they are not followed.
+type stateMachine struct {
+	stateType platform.StateTypeEnum
+	states    []platform.State
+	byID      map[string]platform.State
+}
+
+// newStateMachine creates the graph for the type from all states of the
+// project.
+func newStateMachine(stateType platform.StateTypeEnum, all []platform.State) *stateMachine {
+	m := &stateMachine{
+		stateType: stateType,
+		byID:      make(map[string]platform.State, len(all)),
+	}
+	for _, s := range all {
+		m.byID[s.ID] = s
+		if s.Type == stateType {
+			m.states = append(m.states, s)
+		}
+	}
+	sort.Slice(m.states, func(i, j int) bool {
+		return m.label(m.states[i]) < m.label(m.states[j])
+	})
+	return m
+}
+
+// isTerminal returns true if no transitions are allowed from the state. Note
+// that a state without transitions set allows transitions to all states.
+func isTerminal(s platform.State) bool {
+	return s.Transitions != nil && len(s.Transitions) == 0
+}
+
+// isUnrestricted returns true if transitions to all states are allowed.
+func isUnrestricted(s platform.State) bool {
+	return s.Transitions == nil
+}
+
+func (m *stateMachine) label(s platform.State) string {
+	if s.Key != "" {
+		return s.Key
+	}
+	return s.ID
+}
+
+// targets returns the states of the same type which can be reached from the
+// state with a single transition.
+func (m *stateMachine) targets(s platform.State) []platform.State {
+	if isUnrestricted(s) {
+		return pie.Filter(m.states, func(t platform.State) bool {
+			return t.ID != s.ID
+		})
+	}
+
+	var result []platform.State
+	for _, ref := range s.Transitions {
+		if t, ok := m.byID[ref.ID]; ok && t.Type == m.stateType {
+			result = append(result, t)
+		}
+	}
+	return result
+}
+
+// reachable returns the IDs of the states which can be reached from the
+// given states, including the states themselves.
+func (m *stateMachine) reachable(from []platform.State) map[string]bool {
+	result := map[string]bool{}
+	queue := append([]platform.State{}, from...)
+	for len(queue) > 0 {
+		s := queue[0]
+		queue = queue[1:]
+		if result[s.ID] {
+			continue
+		}
+		result[s.ID] = true
+		queue = append(queue, m.targets(s)...)
+	}
+	return result
+}
+
+// problems validates the graph as a whole: it should have exactly one initial
+// state, all states should be reachable from it, transitions should stay
+// within the type and every state should be able to reach a terminal state.
+func (m *stateMachine) problems() []string {
+	if len(m.states) == 0 {
+		return []string{fmt.Sprintf("no states of type %s found", m.stateType)}
+	}
+
+	var result []string
+
+	initial := pie.Filter(m.states, func(s platform.State) bool { return s.Initial })
+	switch len(initial) {
+	case 1:
+	case 0:
+		result = append(result, fmt.Sprintf("expected exactly one initial state of type %s, found none", m.stateType))
+	default:
+		result = append(result, fmt.Sprintf("expected exactly one initial state of type %s, found %s",
+			m.stateType, strings.Join(pie.Map(initial, m.label), ", ")))
+	}
+
+	for _, s := range m.states {
+		for _, ref := range s.Transitions {
+			t, ok := m.byID[ref.ID]
+			switch {
+			case !ok:
+				result = append(result, fmt.Sprintf("state %s has a transition to unknown state %s", m.label(s), ref.ID))
+			case t.Type != m.stateType:
+				result = append(result, fmt.Sprintf("state %s has a transition to state %s of type %s",
+					m.label(s), m.label(t), t.Type))
+			}
+		}
+	}
+
+	if len(initial) > 0 {
+		reachable := m.reachable(initial)
+		for _, s := range m.states {
+			if !reachable[s.ID] {
+				result = append(result, fmt.Sprintf("state %s is not reachable from the initial state", m.label(s)))
+			}
+		}
+	}
+
+	terminal := pie.Filter(m.states, isTerminal)
+	if len(terminal) == 0 {
+		result = append(result, fmt.Sprintf("no terminal state of type %s found, "+
+			"a terminal state has an empty list of transitions", m.stateType))
+		return result
+	}
+	for _, s := range m.states {
+		if !m.reachesTerminal(s) {
+			result = append(result, fmt.Sprintf("state %s cannot reach a terminal state", m.label(s)))
+		}
+	}
+
+	return result
+}
+
+func (m *stateMachine) reachesTerminal(s platform.State) bool {
+	for id := range m.reachable([]platform.State{s}) {
+		if isTerminal(m.byID[id]) {
+			return true
+		}
+	}
+	return false
+}
+
+// nodes returns the states of the graph for the data source.
+func (m *stateMachine) nodes() []State {
+	return pie.Map(m.states, func(s platform.State) State {
+		transitions := []types.String{}
+		for _, ref := range s.Transitions {
+			label := ref.ID
+			if t, ok := m.byID[ref.ID]; ok {
+				label = m.label(t)
+			}
+			transitions = append(transitions, types.StringValue(label))
+		}
+
+		return State{
+			ID:           types.StringValue(s.ID),
+			Key:          types.StringValue(s.Key),
+			Initial:      types.BoolValue(s.Initial),
+			Terminal:     types.BoolValue(isTerminal(s)),
+			Unrestricted: types.BoolValue(isUnrestricted(s)),
+			Transitions:  transitions,
+		}
+	})
+}
+
+// dot renders the graph in the Graphviz DOT language. Initial states get an
+// incoming edge from a point, terminal states a double border and the
+// transitions of unrestricted states are dashed.
+func (m *stateMachine) dot() string {
+	quote := func(value string) string {
+		value = strings.ReplaceAll(value, `\`, `\\`)
+		return `"` + strings.ReplaceAll(value, `"`, `\"`) + `"`
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "digraph %s {\n", quote(string(m.stateType)))
+	b.WriteString("  rankdir=LR;\n")
+	b.WriteString("  node [shape=box, style=rounded];\n")
+	b.WriteString("  \"__initial\" [shape=point, label=\"\"];\n")
+
+	for _, s := range m.states {
+		if isTerminal(s) {
+			fmt.Fprintf(&b, "  %s [peripheries=2];\n", quote(m.label(s)))
+		} else {
+			fmt.Fprintf(&b, "  %s;\n", quote(m.label(s)))
+		}
+	}
+	for _, s := range m.states {
+		if s.Initial {
+			fmt.Fprintf(&b, "  \"__initial\" -> %s;\n", quote(m.label(s)))
+		}
+	}
+	for _, s := range m.states {
+		attrs := ""
+		if isUnrestricted(s) {
+			attrs = " [style=dashed]"
+		}
+		for _, t := range m.targets(s) {
+			fmt.Fprintf(&b, "  %s -> %s%s;\n", quote(m.label(s)), quote(m.label(t)), attrs)
+		}
+	}
+	b.WriteString("}\n")
+	return b.String()
+}
+
+// mermaid renders the graph as a Mermaid state diagram. The states are
+// aliased since keys can contain characters which are not allowed in Mermaid
+// identifiers.
+func (m *stateMachine) mermaid() string {
+	alias := make(map[string]string, len(m.states))
+	for i, s := range m.states {
+		alias[s.ID] = fmt.Sprintf("s%d", i)
+	}
+
+	var b strings.Builder
+	b.WriteString("stateDiagram-v2\n")
+	for _, s := range m.states {
+		label := strings.ReplaceAll(m.label(s), `"`, "#quot;")
+		fmt.Fprintf(&b, "    state \"%s\" as %s\n", label, alias[s.ID])
+	}
+	for _, s := range m.states {
+		if s.Initial {
+			fmt.Fprintf(&b, "    [*] --> %s\n", alias[s.ID])
+		}
+	}
+	for _, s := range m.states {
+		for _, t := range m.targets(s) {
+			fmt.Fprintf(&b, "    %s --> %s\n", alias[s.ID], alias[t.ID])
+		}
+	}
+	for _, s := range m.states {
+		if isTerminal(s) {
+			fmt.Fprintf(&b, "    %s --> [*]\n", alias[s.ID])
+		}
+	}
+	return b.String()
+}
@@ -0,0 +1,143 @@
+package state_machine
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/labd/commercetools-go-sdk/platform"
+	"github.com/stretchr/testify/assert"
+)
+
+func transitions(ids ...string) []platform.StateReference {
+	result := []platform.StateReference{}
+	for _, id := range ids {
+		result = append(result, platform.StateReference{ID: id})
+	}
+	return result
+}
+
+var orderStates = []platform.State{
+	{ID: "1", Key: "open", Type: platform.StateTypeEnumOrderState, Initial: true, Transitions: transitions("2", "3")},
+	{ID: "2", Key: "shipped", Type: platform.StateTypeEnumOrderState, Transitions: transitions("4")},
+	{ID: "3", Key: "cancelled", Type: platform.StateTypeEnumOrderState, Transitions: transitions()},
+	{ID: "4", Key: "delivered", Type: platform.StateTypeEnumOrderState, Transitions: transitions()},
+	{ID: "5", Key: "paid", Type: platform.StateTypeEnumPaymentState, Initial: true, Transitions: transitions()},
+}
+
+func TestStateMachineProblems(t *testing.T) {
+	testCases := []struct {
+		name     string
+		states   []platform.State
+		expected []string
+	}{
+		{
+			name:     "valid",
+			states:   orderStates,
+			expected: nil,
+		},
+		{
+			name:     "no states",
+			states:   orderStates[4:],
+			expected: []string{"no states of type OrderState found"},
+		},
+		{
+			name: "multiple initial states",
+			states: []platform.State{
+				{ID: "1", Key: "a", Type: platform.StateTypeEnumOrderState, Initial: true, Transitions: transitions()},
+				{ID: "2", Key: "b", Type: platform.StateTypeEnumOrderState, Initial: true, Transitions: transitions()},
+			},
+			expected: []string{"expected exactly one initial state of type OrderState, found a, b"},
+		},
+		{
+			name: "unreachable and cross type",
+			states: []platform.State{
+				{ID: "1", Key: "open", Type: platform.StateTypeEnumOrderState, Initial: true, Transitions: transitions("3", "9")},
+				{ID: "2", Key: "orphan", Type: platform.StateTypeEnumOrderState, Transitions: transitions()},
+				{ID: "3", Key: "paid", Type: platform.StateTypeEnumPaymentState, Transitions: transitions()},
+			},
+			expected: []string{
+				"state open has a transition to state paid of type PaymentState",
+				"state open has a transition to unknown state 9",
+				"state orphan is not reachable from the initial state",
+				"state open cannot reach a terminal state",
+			},
+		},
+		{
+			name: "no terminal state",
+			states: []platform.State{
+				{ID: "1", Key: "a", Type: platform.StateTypeEnumOrderState, Initial: true, Transitions: transitions("2")},
+				{ID: "2", Key: "b", Type: platform.StateTypeEnumOrderState},
+			},
+			expected: []string{
+				"no terminal state of type OrderState found, a terminal state has an empty list of transitions",
+			},
+		},
+		{
+			name: "cycle without exit",
+			states: []platform.State{
+				{ID: "1", Key: "a", Type: platform.StateTypeEnumOrderState, Initial: true, Transitions: transitions("2", "4")},
+				{ID: "2", Key: "b", Type: platform.StateTypeEnumOrderState, Transitions: transitions("3")},
+				{ID: "3", Key: "c", Type: platform.StateTypeEnumOrderState, Transitions: transitions("2")},
+				{ID: "4", Key: "d", Type: platform.StateTypeEnumOrderState, Transitions: transitions()},
+			},
+			expected: []string{
+				"state b cannot reach a terminal state",
+				"state c cannot reach a terminal state",
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			m := newStateMachine(platform.StateTypeEnumOrderState, tc.states)
+			assert.Equal(t, tc.expected, m.problems())
+		})
+	}
+}
+
+func TestStateMachineNodes(t *testing.T) {
+	m := newStateMachine(platform.StateTypeEnumOrderState, orderStates)
+	nodes := m.nodes()
+	assert.Len(t, nodes, 4)
+	assert.Equal(t, State{
+		ID:           types.StringValue("1"),
+		Key:          types.StringValue("open"),
+		Initial:      types.BoolValue(true),
+		Terminal:     types.BoolValue(false),
+		Unrestricted: types.BoolValue(false),
+		Transitions:  []types.String{types.StringValue("shipped"), types.StringValue("cancelled")},
+	}, nodes[2])
+}
+
+func TestStateMachineDOT(t *testing.T) {
+	m := newStateMachine(platform.StateTypeEnumOrderState, orderStates)
+	assert.Equal(t, `digraph "OrderState" {
+  rankdir=LR;
+  node [shape=box, style=rounded];
+  "__initial" [shape=point, label=""];
+  "cancelled" [peripheries=2];
+  "delivered" [peripheries=2];
+  "open";
+  "shipped";
+  "__initial" -> "open";
+  "open" -> "shipped";
+  "open" -> "cancelled";
+  "shipped" -> "delivered";
+}
+`, m.dot())
+}
+
+func TestStateMachineMermaid(t *testing.T) {
+	states := []platform.State{
+		{ID: "1", Key: "open", Type: platform.StateTypeEnumOrderState, Initial: true},
+		{ID: "2", Key: "closed", Type: platform.StateTypeEnumOrderState, Transitions: transitions()},
+	}
+	m := newStateMachine(platform.StateTypeEnumOrderState, states)
+	assert.Equal(t, `stateDiagram-v2
+    state "closed" as s0
+    state "open" as s1
+    [*] --> s1
+    s1 --> s0
+    s0 --> [*]
+`, m.mermaid())
+}
@@ -0,0 +1,181 @@
+package state_machine
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/labd/commercetools-go-sdk/platform"
+
+	"github.com/labd/terraform-provider-commercetools/internal/utils"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &StateMachineSource{}
+	_ datasource.DataSourceWithConfigure = &StateMachineSource{}
+)
+
+// pageSize is the number of states which are retrieved per request.
+const pageSize = 500
+
+// NewDataSource is a helper function to simplify the data source implementation.
+func NewDataSource() datasource.DataSource {
+	return &StateMachineSource{}
+}
+
+// StateMachineSource is the data source implementation.
+type StateMachineSource struct {
+	client *platform.ByProjectKeyRequestBuilder
+}
+
+// Metadata returns the data source type name.
+func (d *StateMachineSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_state_machine"
+}
+
+// Schema defines the schema for the data source.
+func (d *StateMachineSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Fetches all states of a type and validates them as a whole. The states should have " +
+			"exactly one `initial` state, every state should be reachable from it, transitions should not cross " +
+			"to states of another type and every state should be able to reach a terminal state, which is a " +
+			"state with an empty list of transitions. Note that a state without transitions set allows " +
+			"transitions to all states of the type.\n\n" +
+			"The graph is also rendered as [Graphviz DOT](https://graphviz.org/doc/info/lang.html) and " +
+			"[Mermaid](https://mermaid.js.org/syntax/stateDiagram.html) text, for example to include the order " +
+			"or payment workflow in documentation.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The state type",
+				Computed:    true,
+			},
+			"type": schema.StringAttribute{
+				Description: "The state type of the workflow to validate",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						string(platform.StateTypeEnumOrderState),
+						string(platform.StateTypeEnumLineItemState),
+						string(platform.StateTypeEnumProductState),
+						string(platform.StateTypeEnumReviewState),
+						string(platform.StateTypeEnumPaymentState),
+						string(platform.StateTypeEnumQuoteRequestState),
+						string(platform.StateTypeEnumStagedQuoteState),
+						string(platform.StateTypeEnumQuoteState),
+					),
+				},
+			},
+			"validate": schema.BoolAttribute{
+				MarkdownDescription: "Whether problems with the graph result in an error, defaults to `true`. " +
+					"When disabled the problems are only reported in `problems`",
+				Optional: true,
+			},
+			"states": schema.ListAttribute{
+				MarkdownDescription: "The states of the type ordered by key. Each state contains the `id`, `key`, " +
+					"whether it is `initial` or `terminal`, whether transitions to all states are allowed " +
+					"(`unrestricted`) and the keys of the states it can transition to (`transitions`)",
+				Computed:    true,
+				ElementType: types.ObjectType{AttrTypes: stateAttrTypes},
+			},
+			"problems": schema.ListAttribute{
+				Description: "The problems found in the graph",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+			"dot": schema.StringAttribute{
+				MarkdownDescription: "The graph in the Graphviz DOT language. Terminal states have a double border " +
+					"and the transitions of unrestricted states are dashed",
+				Computed: true,
+			},
+			"mermaid": schema.StringAttribute{
+				Description: "The graph as a Mermaid state diagram",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *StateMachineSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	data := req.ProviderData.(*utils.ProviderData)
+	d.client = data.Client
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *StateMachineSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state StateMachine
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// All states are retrieved since transitions can refer to states of
+	// another type.
+	states, err := d.states(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to read states",
+			err.Error(),
+		)
+		return
+	}
+
+	m := newStateMachine(platform.StateTypeEnum(state.Type.ValueString()), states)
+	problems := m.problems()
+
+	state.ID = state.Type
+	state.States = m.nodes()
+	state.Problems = []types.String{}
+	state.DOT = types.StringValue(m.dot())
+	state.Mermaid = types.StringValue(m.mermaid())
+	for _, problem := range problems {
+		state.Problems = append(state.Problems, types.StringValue(problem))
+	}
+
+	if state.Validate.IsNull() || state.Validate.ValueBool() {
+		for _, problem := range problems {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("type"),
+				"Invalid state machine",
+				problem,
+			)
+		}
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (d *StateMachineSource) states(ctx context.Context) ([]platform.State, error) {
+	var result []platform.State
+	for {
+		page, err := d.client.States().Get().
+			Sort([]string{"id asc"}).
+			Limit(pageSize).
+			Offset(len(result)).
+			Execute(ctx)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, page.Results...)
+		if len(page.Results) < pageSize {
+			return result, nil
+		}
+	}
+}
@@ -0,0 +1,70 @@
+package state_machine_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/labd/terraform-provider-commercetools/internal/acctest"
+)
+
+func TestAccStateMachine(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStateMachineConfig(false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.commercetools_state_machine.test", "id", "QuoteState"),
+					resource.TestCheckResourceAttrSet("data.commercetools_state_machine.test", "dot"),
+					resource.TestMatchResourceAttr("data.commercetools_state_machine.test", "mermaid",
+						regexp.MustCompile(`^stateDiagram-v2`)),
+				),
+			},
+			{
+				Config:      testAccStateMachineConfig(true),
+				ExpectError: regexp.MustCompile(`cannot reach a terminal state`),
+			},
+		},
+	})
+}
+
+func testAccStateMachineConfig(validate bool) string {
+	config := `
+		resource "commercetools_state" "acctest_quote_open" {
+			key     = "acctest-quote-open"
+			type    = "QuoteState"
+			initial = true
+		}
+
+		resource "commercetools_state" "acctest_quote_loop" {
+			key  = "acctest-quote-loop"
+			type = "QuoteState"
+		}
+
+		resource "commercetools_state_transitions" "acctest_quote_open" {
+			from = commercetools_state.acctest_quote_open.id
+			to   = [commercetools_state.acctest_quote_loop.id]
+		}
+
+		resource "commercetools_state_transitions" "acctest_quote_loop" {
+			from = commercetools_state.acctest_quote_loop.id
+			to   = [commercetools_state.acctest_quote_open.id]
+		}
+	`
+	if validate {
+		return config + `
+		data "commercetools_state_machine" "test" {
+			type       = "QuoteState"
+			depends_on = [commercetools_state_transitions.acctest_quote_open, commercetools_state_transitions.acctest_quote_loop]
+		}`
+	}
+	return config + `
+		data "commercetools_state_machine" "test" {
+			type       = "QuoteState"
+			validate   = false
+			depends_on = [commercetools_state_transitions.acctest_quote_open, commercetools_state_transitions.acctest_quote_loop]
+		}`
+}
@@ -0,0 +1,105 @@
+package store
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/labd/commercetools-go-sdk/platform"
+)
+
+// Store maps the data source schema data.
+type Store struct {
+	ID                   types.String            `tfsdk:"id"`
+	Key                  types.String            `tfsdk:"key"`
+	Version              types.Int64             `tfsdk:"version"`
+	Name                 map[string]types.String `tfsdk:"name"`
+	Languages            []types.String          `tfsdk:"languages"`
+	Countries            []types.String          `tfsdk:"countries"`
+	DistributionChannels []Channel               `tfsdk:"distribution_channels"`
+	SupplyChannels       []Channel               `tfsdk:"supply_channels"`
+	ProductSelections    []ProductSelection      `tfsdk:"product_selections"`
+}
+
+// Channel is a resolved channel of the store.
+type Channel struct {
+	ID    types.String   `tfsdk:"id"`
+	Key   types.String   `tfsdk:"key"`
+	Roles []types.String `tfsdk:"roles"`
+}
+
+// ProductSelection is a resolved product selection of the store.
+type ProductSelection struct {
+	ID     types.String `tfsdk:"id"`
+	Key    types.String `tfsdk:"key"`
+	Active types.Bool   `tfsdk:"active"`
+}
+
+var channelAttrTypes = map[string]attr.Type{
+	"id":    types.StringType,
+	"key":   types.StringType,
+	"roles": types.ListType{ElemType: types.StringType},
+}
+
+var productSelectionAttrTypes = map[string]attr.Type{
+	"id":     types.StringType,
+	"key":    types.StringType,
+	"active": types.BoolType,
+}
+
+// NewStoreFromNative creates the data source data from the store. The channels
+// and product selections are expected to be expanded, otherwise only their
+// IDs are set.
+func NewStoreFromNative(s *platform.Store) Store {
+	result := Store{
+		ID:                   types.StringValue(s.ID),
+		Key:                  types.StringValue(s.Key),
+		Version:              types.Int64Value(int64(s.Version)),
+		Languages:            []types.String{},
+		Countries:            []types.String{},
+		DistributionChannels: newChannels(s.DistributionChannels),
+		SupplyChannels:       newChannels(s.SupplyChannels),
+		ProductSelections:    []ProductSelection{},
+	}
+	if s.Name != nil {
+		result.Name = make(map[string]types.String, len(*s.Name))
+		for locale, value := range *s.Name {
+			result.Name[locale] = types.StringValue(value)
+		}
+	}
+	for _, language := range s.Languages {
+		result.Languages = append(result.Languages, types.StringValue(language))
+	}
+	for _, country := range s.Countries {
+		result.Countries = append(result.Countries, types.StringValue(country.Code))
+	}
+	for _, setting := range s.ProductSelections {
+		selection := ProductSelection{
+			ID:     types.StringValue(setting.ProductSelection.ID),
+			Key:    types.StringNull(),
+			Active: types.BoolValue(setting.Active),
+		}
+		if obj := setting.ProductSelection.Obj; obj != nil && obj.Key != nil {
+			selection.Key = types.StringValue(*obj.Key)
+		}
+		result.ProductSelections = append(result.ProductSelections, selection)
+	}
+	return result
+}
+
+func newChannels(references []platform.ChannelReference) []Channel {
+	result := make([]Channel, 0, len(references))
+	for _, reference := range references {
+		channel := Channel{
+			ID:    types.StringValue(reference.ID),
+			Key:   types.StringNull(),
+			Roles: []types.String{},
+		}
+		if obj := reference.Obj; obj != nil {
+			channel.Key = types.StringValue(obj.Key)
+			for _, role := range obj.Roles {
+				channel.Roles = append(channel.Roles, types.StringValue(string(role)))
+			}
+		}
+		result = append(result, channel)
+	}
+	return result
+}
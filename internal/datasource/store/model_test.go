@@ -0,0 +1,60 @@
+package store
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/labd/commercetools-go-sdk/platform"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewStoreFromNative(t *testing.T) {
+	selectionKey := "summer"
+	store := &platform.Store{
+		ID:        "store-id",
+		Version:   3,
+		Key:       "nl",
+		Name:      &platform.LocalizedString{"nl-NL": "Nederland"},
+		Languages: []string{"nl-NL"},
+		Countries: []platform.StoreCountry{{Code: "NL"}},
+		DistributionChannels: []platform.ChannelReference{{
+			ID: "web-id",
+			Obj: &platform.Channel{
+				Key:   "web",
+				Roles: []platform.ChannelRoleEnum{platform.ChannelRoleEnumProductDistribution},
+			},
+		}},
+		SupplyChannels: []platform.ChannelReference{{ID: "warehouse-id"}},
+		ProductSelections: []platform.ProductSelectionSetting{{
+			ProductSelection: platform.ProductSelectionReference{
+				ID:  "selection-id",
+				Obj: &platform.ProductSelection{Key: &selectionKey},
+			},
+			Active: true,
+		}},
+	}
+
+	assert.Equal(t, Store{
+		ID:        types.StringValue("store-id"),
+		Key:       types.StringValue("nl"),
+		Version:   types.Int64Value(3),
+		Name:      map[string]types.String{"nl-NL": types.StringValue("Nederland")},
+		Languages: []types.String{types.StringValue("nl-NL")},
+		Countries: []types.String{types.StringValue("NL")},
+		DistributionChannels: []Channel{{
+			ID:    types.StringValue("web-id"),
+			Key:   types.StringValue("web"),
+			Roles: []types.String{types.StringValue("ProductDistribution")},
+		}},
+		SupplyChannels: []Channel{{
+			ID:    types.StringValue("warehouse-id"),
+			Key:   types.StringNull(),
+			Roles: []types.String{},
+		}},
+		ProductSelections: []ProductSelection{{
+			ID:     types.StringValue("selection-id"),
+			Key:    types.StringValue("summer"),
+			Active: types.BoolValue(true),
+		}},
+	}, NewStoreFromNative(store))
+}
@@ -0,0 +1,128 @@
+package store
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/labd/commercetools-go-sdk/platform"
+
+	"github.com/labd/terraform-provider-commercetools/internal/utils"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &StoreSource{}
+	_ datasource.DataSourceWithConfigure = &StoreSource{}
+)
+
+// NewDataSource is a helper function to simplify the data source implementation.
+func NewDataSource() datasource.DataSource {
+	return &StoreSource{}
+}
+
+// StoreSource is the data source implementation.
+type StoreSource struct {
+	client *platform.ByProjectKeyRequestBuilder
+}
+
+// Metadata returns the data source type name.
+func (d *StoreSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_store"
+}
+
+// Schema defines the schema for the data source.
+func (d *StoreSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Fetches a store by key, including the resolved distribution channels, supply " +
+			"channels and product selections. This is an easy way to wire the channels of an existing store " +
+			"into other resources, for example to check their `roles`.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "ID of the store",
+				Computed:    true,
+			},
+			"key": schema.StringAttribute{
+				Description: "Key of the store",
+				Required:    true,
+			},
+			"version": schema.Int64Attribute{
+				Description: "Current version of the store",
+				Computed:    true,
+			},
+			"name": schema.MapAttribute{
+				MarkdownDescription: "[LocalizedString](https://docs.commercetools.com/api/types#localizedstring)",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"languages": schema.ListAttribute{
+				Description: "The languages of the store",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+			"countries": schema.ListAttribute{
+				Description: "The countries of the store",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+			"distribution_channels": schema.ListAttribute{
+				MarkdownDescription: "The product distribution channels of the store, with their `id`, `key` and `roles`",
+				Computed:            true,
+				ElementType:         types.ObjectType{AttrTypes: channelAttrTypes},
+			},
+			"supply_channels": schema.ListAttribute{
+				MarkdownDescription: "The inventory supply channels of the store, with their `id`, `key` and `roles`",
+				Computed:            true,
+				ElementType:         types.ObjectType{AttrTypes: channelAttrTypes},
+			},
+			"product_selections": schema.ListAttribute{
+				MarkdownDescription: "The product selections of the store, with their `id`, `key` and whether " +
+					"they are `active`",
+				Computed:    true,
+				ElementType: types.ObjectType{AttrTypes: productSelectionAttrTypes},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *StoreSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	data := req.ProviderData.(*utils.ProviderData)
+	d.client = data.Client
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *StoreSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state Store
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	store, err := d.client.Stores().
+		WithKey(state.Key.ValueString()).
+		Get().
+		Expand([]string{"distributionChannels[*]", "supplyChannels[*]", "productSelections[*].productSelection"}).
+		Execute(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to read store",
+			err.Error(),
+		)
+		return
+	}
+
+	state = NewStoreFromNative(store)
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
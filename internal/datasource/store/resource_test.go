@@ -0,0 +1,54 @@
+package store_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/labd/terraform-provider-commercetools/internal/acctest"
+)
+
+func TestAccStoreDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource "commercetools_channel" "acctest_web" {
+						key   = "acctest-store-web"
+						roles = ["ProductDistribution"]
+					}
+
+					resource "commercetools_channel" "acctest_warehouse" {
+						key   = "acctest-store-warehouse"
+						roles = ["InventorySupply"]
+					}
+
+					resource "commercetools_store" "acctest" {
+						key                   = "acctest-store-lookup"
+						languages             = ["en-US"]
+						distribution_channels = [commercetools_channel.acctest_web.key]
+						supply_channels       = [commercetools_channel.acctest_warehouse.key]
+					}
+
+					data "commercetools_store" "test" {
+						key = commercetools_store.acctest.key
+					}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.commercetools_store.test", "id",
+						"commercetools_store.acctest", "id"),
+					resource.TestCheckResourceAttr("data.commercetools_store.test", "languages.0", "en-US"),
+					resource.TestCheckResourceAttr("data.commercetools_store.test",
+						"distribution_channels.0.key", "acctest-store-web"),
+					resource.TestCheckResourceAttr("data.commercetools_store.test",
+						"distribution_channels.0.roles.0", "ProductDistribution"),
+					resource.TestCheckResourceAttrPair("data.commercetools_store.test", "supply_channels.0.id",
+						"commercetools_channel.acctest_warehouse", "id"),
+					resource.TestCheckResourceAttr("data.commercetools_store.test", "product_selections.#", "0"),
+				),
+			},
+		},
+	})
+}
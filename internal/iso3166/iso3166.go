@@ -0,0 +1,181 @@
+// Package iso3166 contains the ISO 3166-1 countries and the ISO 3166-2
+// subdivisions, to validate the country and state of locations.
+//
+// The lists are generated from the iso_3166-1.json and iso_3166-2.json files
+// of the Debian [iso-codes] package, version 4.15.0.
+//
+// [iso-codes]: https://salsa.debian.org/iso-codes-team/iso-codes
+package iso3166
+
+import (
+	_ "embed"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/labd/terraform-provider-commercetools/internal/utils"
+)
+
+var (
+	//go:embed countries.tsv
+	countriesData string
+
+	//go:embed subdivisions.tsv
+	subdivisionsData string
+)
+
+// Country is an ISO 3166-1 country.
+type Country struct {
+	Code   string
+	Alpha3 string
+	Name   string
+}
+
+// Subdivision is an ISO 3166-2 subdivision of a country, like a state or
+// province.
+type Subdivision struct {
+	// Code is the subdivision code including the country, like US-CA
+	Code string
+	Type string
+	Name string
+}
+
+// ShortCode returns the code without the country, like CA for US-CA.
+func (s Subdivision) ShortCode() string {
+	_, code, _ := strings.Cut(s.Code, "-")
+	return code
+}
+
+// countryAliases are codes which are commonly used instead of the ISO 3166-1
+// codes.
+var countryAliases = map[string]string{
+	"UK": "GB",
+	"EL": "GR",
+}
+
+var (
+	loadOnce     sync.Once
+	countries    []Country
+	byCode       map[string]Country
+	subdivisions map[string][]Subdivision
+)
+
+func load() {
+	loadOnce.Do(func() {
+		byCode = map[string]Country{}
+		for _, line := range strings.Split(strings.TrimSpace(countriesData), "\n") {
+			fields := strings.Split(line, "\t")
+			c := Country{Code: fields[0], Alpha3: fields[1], Name: fields[2]}
+			countries = append(countries, c)
+			byCode[c.Code] = c
+		}
+
+		subdivisions = map[string][]Subdivision{}
+		for _, line := range strings.Split(strings.TrimSpace(subdivisionsData), "\n") {
+			fields := strings.Split(line, "\t")
+			s := Subdivision{Code: fields[0], Type: fields[1], Name: fields[2]}
+			country, _, _ := strings.Cut(s.Code, "-")
+			subdivisions[country] = append(subdivisions[country], s)
+		}
+	})
+}
+
+// Countries returns all countries ordered by code.
+func Countries() []Country {
+	load()
+	return countries
+}
+
+// LookupCountry returns the country with the alpha-2 code.
+func LookupCountry(code string) (Country, bool) {
+	load()
+	c, ok := byCode[code]
+	return c, ok
+}
+
+// Subdivisions returns the subdivisions of the country ordered by code.
+func Subdivisions(country string) []Subdivision {
+	load()
+	return subdivisions[country]
+}
+
+// LookupSubdivision returns the subdivision of the country for the state. The
+// state matches the name of the subdivision, ignoring case, or the code with
+// or without the country.
+func LookupSubdivision(country, state string) (Subdivision, bool) {
+	for _, s := range Subdivisions(country) {
+		if strings.EqualFold(s.Name, state) || s.Code == state || s.ShortCode() == state {
+			return s, true
+		}
+	}
+	return Subdivision{}, false
+}
+
+// CheckCountry returns a description of the problem with the country code, or
+// an empty string when it is a valid ISO 3166-1 alpha-2 code.
+func CheckCountry(code string) string {
+	if _, ok := LookupCountry(code); ok {
+		return ""
+	}
+	if suggestion := suggestCountry(code); suggestion != "" {
+		return fmt.Sprintf("%q is not an ISO 3166-1 alpha-2 country code, did you mean %q?", code, suggestion)
+	}
+	return fmt.Sprintf("%q is not an ISO 3166-1 alpha-2 country code", code)
+}
+
+func suggestCountry(code string) string {
+	upper := strings.ToUpper(code)
+	if _, ok := byCode[upper]; ok {
+		return upper
+	}
+	if alias, ok := countryAliases[upper]; ok {
+		return alias
+	}
+
+	names := make([]string, len(countries))
+	for i, c := range countries {
+		if c.Alpha3 == upper || strings.EqualFold(c.Name, code) {
+			return c.Code
+		}
+		names[i] = c.Name
+	}
+	if len(code) > 3 {
+		if name := utils.ClosestMatch(code, names); name != "" {
+			for _, c := range countries {
+				if c.Name == name {
+					return c.Code
+				}
+			}
+		}
+	}
+	return ""
+}
+
+// CheckState returns a description of the problem with the state of the
+// country, or an empty string when it matches one of the subdivisions of the
+// country. States of countries without subdivisions, or of invalid countries,
+// are not validated.
+func CheckState(country, state string) string {
+	candidates := Subdivisions(country)
+	if len(candidates) == 0 {
+		return ""
+	}
+	if _, ok := LookupSubdivision(country, state); ok {
+		return ""
+	}
+
+	names := make([]string, len(candidates))
+	for i, s := range candidates {
+		names[i] = s.Name
+	}
+	message := fmt.Sprintf("%q is not an ISO 3166-2 subdivision of %s", state, country)
+	if suggestion := utils.ClosestMatch(state, names); suggestion != "" {
+		return message + fmt.Sprintf(", did you mean %q?", suggestion)
+	}
+	if len(names) <= 10 {
+		sort.Strings(names)
+		return message + fmt.Sprintf(", the subdivisions are %s", strings.Join(names, ", "))
+	}
+	return message
+}
@@ -0,0 +1,68 @@
+package iso3166
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCountries(t *testing.T) {
+	assert.Len(t, Countries(), 249)
+
+	nl, ok := LookupCountry("NL")
+	assert.True(t, ok)
+	assert.Equal(t, Country{Code: "NL", Alpha3: "NLD", Name: "Netherlands"}, nl)
+
+	_, ok = LookupCountry("nl")
+	assert.False(t, ok)
+}
+
+func TestCheckCountry(t *testing.T) {
+	testCases := []struct {
+		code     string
+		expected string
+	}{
+		{"NL", ""},
+		{"nl", `"nl" is not an ISO 3166-1 alpha-2 country code, did you mean "NL"?`},
+		{"UK", `"UK" is not an ISO 3166-1 alpha-2 country code, did you mean "GB"?`},
+		{"DEU", `"DEU" is not an ISO 3166-1 alpha-2 country code, did you mean "DE"?`},
+		{"Germany", `"Germany" is not an ISO 3166-1 alpha-2 country code, did you mean "DE"?`},
+		{"Netherland", `"Netherland" is not an ISO 3166-1 alpha-2 country code, did you mean "NL"?`},
+		{"XX", `"XX" is not an ISO 3166-1 alpha-2 country code`},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.code, func(t *testing.T) {
+			assert.Equal(t, tc.expected, CheckCountry(tc.code))
+		})
+	}
+}
+
+func TestCheckState(t *testing.T) {
+	testCases := []struct {
+		country  string
+		state    string
+		expected string
+	}{
+		{"US", "California", ""},
+		{"US", "california", ""},
+		{"US", "US-CA", ""},
+		{"US", "CA", ""},
+		{"DE", "Bayern", ""},
+		{"US", "Californa", `"Californa" is not an ISO 3166-2 subdivision of US, did you mean "California"?`},
+		{"DE", "Niedersachsn", `"Niedersachsn" is not an ISO 3166-2 subdivision of DE, did you mean "Niedersachsen"?`},
+		{"US", "Ontario", `"Ontario" is not an ISO 3166-2 subdivision of US`},
+		{"XX", "Anything", ""},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.country+"/"+tc.state, func(t *testing.T) {
+			assert.Equal(t, tc.expected, CheckState(tc.country, tc.state))
+		})
+	}
+}
+
+func TestSubdivisionShortCode(t *testing.T) {
+	s, ok := LookupSubdivision("US", "District of Columbia")
+	assert.True(t, ok)
+	assert.Equal(t, "DC", s.ShortCode())
+	assert.Equal(t, "District", s.Type)
+}
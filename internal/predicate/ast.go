@@ -0,0 +1,92 @@
+package predicate
+
+import "strings"
+
+// Expression is a condition of a parsed predicate: *And, *Or, *Not, *Nested,
+// *Compare or *Is. With the cart grammar boolean *Value and *Function
+// operands are conditions as well.
+type Expression interface {
+	expression()
+}
+
+// Operand is a side of a comparison: *Value, *Field or *Function.
+type Operand interface {
+	operand()
+}
+
+// And matches when both conditions match.
+type And struct {
+	Left, Right Expression
+}
+
+// Or matches when either of the conditions matches.
+type Or struct {
+	Left, Right Expression
+}
+
+// Not matches when the condition doesn't match.
+type Not struct {
+	Expr Expression
+}
+
+// Nested is a predicate on the fields of an object, or on the elements of an
+// array of objects, e.g. lineItems(quantity > 1). Only used by query
+// predicates.
+type Nested struct {
+	Field string
+	Pos   int
+	Expr  Expression
+}
+
+// Compare compares an operand with one or more values. The operator is one of
+// =, !=, <, <=, >, >=, in, not in, contains, contains any or contains all.
+type Compare struct {
+	Left   Operand
+	Op     string
+	Values []Operand
+}
+
+// Is checks whether an operand is defined or empty.
+type Is struct {
+	Operand Operand
+	Check   string
+	Negate  bool
+}
+
+// Value is a literal, which is a string, a bool or a *big.Rat for numbers.
+type Value struct {
+	Value any
+}
+
+// Field is a path to a field of the resource, e.g. customer.email. Query
+// predicates only use paths of a single field.
+type Field struct {
+	Path []string
+	Pos  int
+}
+
+// Name returns the path of the field separated by dots.
+func (f *Field) Name() string {
+	return strings.Join(f.Path, ".")
+}
+
+// Function is a call of one of the functions of the grammar, e.g.
+// lineItemCount(sku = "socks").
+type Function struct {
+	Name string
+	Pos  int
+	Expr Expression
+}
+
+func (*And) expression()      {}
+func (*Or) expression()       {}
+func (*Not) expression()      {}
+func (*Nested) expression()   {}
+func (*Compare) expression()  {}
+func (*Is) expression()       {}
+func (*Value) expression()    {}
+func (*Function) expression() {}
+
+func (*Value) operand()    {}
+func (*Field) operand()    {}
+func (*Function) operand() {}
@@ -0,0 +1,238 @@
+package predicate
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+// Eval evaluates the predicate against a resource, which is the JSON
+// representation as returned by the commercetools API.
+//
+// Fields which are not present in the resource don't match any comparison.
+// Comparisons and nested predicates on arrays match when any of the elements
+// match.
+func (p *Predicate) Eval(resource []byte) (bool, error) {
+	var obj map[string]any
+	decoder := json.NewDecoder(bytes.NewReader(resource))
+	decoder.UseNumber()
+	if err := decoder.Decode(&obj); err != nil {
+		return false, fmt.Errorf("resource is not a valid JSON object: %w", err)
+	}
+	return eval(p.root, obj)
+}
+
+func eval(e Expression, obj map[string]any) (bool, error) {
+	switch n := e.(type) {
+	case *And:
+		left, err := eval(n.Left, obj)
+		if err != nil || !left {
+			return false, err
+		}
+		return eval(n.Right, obj)
+
+	case *Or:
+		left, err := eval(n.Left, obj)
+		if err != nil || left {
+			return left, err
+		}
+		return eval(n.Right, obj)
+
+	case *Not:
+		result, err := eval(n.Expr, obj)
+		return !result, err
+
+	case *Nested:
+		return evalNested(n, obj)
+	case *Compare:
+		return evalCompare(n, obj)
+	case *Is:
+		return evalIs(n, obj)
+	}
+	return false, fmt.Errorf("unsupported condition %T", e)
+}
+
+func evalNested(n *Nested, obj map[string]any) (bool, error) {
+	switch value := obj[n.Field].(type) {
+	case nil:
+		return false, nil
+	case map[string]any:
+		return eval(n.Expr, value)
+	case []any:
+		for _, item := range value {
+			elem, ok := item.(map[string]any)
+			if !ok {
+				return false, fmt.Errorf("field %q is not an array of objects", n.Field)
+			}
+			if result, err := eval(n.Expr, elem); err != nil || result {
+				return result, err
+			}
+		}
+		return false, nil
+	default:
+		return false, fmt.Errorf("field %q is not an object", n.Field)
+	}
+}
+
+func evalCompare(n *Compare, obj map[string]any) (bool, error) {
+	field := fieldName(n.Left)
+	value, ok := obj[field]
+	if !ok || value == nil {
+		return false, nil
+	}
+
+	values := make([]any, len(n.Values))
+	for i, v := range n.Values {
+		values[i] = v.(*Value).Value
+	}
+
+	switch n.Op {
+	case "contains", "contains any", "contains all":
+		items, ok := value.([]any)
+		if !ok {
+			return false, fmt.Errorf("field %q is not an array", field)
+		}
+		matches := 0
+		for _, expected := range values {
+			for _, item := range items {
+				if equal(item, expected) {
+					matches++
+					break
+				}
+			}
+		}
+		if n.Op == "contains all" {
+			return matches == len(values), nil
+		}
+		return matches > 0, nil
+	}
+
+	if items, ok := value.([]any); ok {
+		for _, item := range items {
+			if Matches(n.Op, item, values, equal, order) {
+				return true, nil
+			}
+		}
+		return false, nil
+	}
+	if _, ok := value.(map[string]any); ok {
+		return false, fmt.Errorf("field %q is an object, use %s(...) to compare its fields", field, field)
+	}
+	return Matches(n.Op, value, values, equal, order), nil
+}
+
+func evalIs(n *Is, obj map[string]any) (bool, error) {
+	field := fieldName(n.Operand)
+	value := obj[field]
+
+	var result bool
+	switch n.Check {
+	case "defined":
+		result = value != nil
+	case "empty":
+		switch v := value.(type) {
+		case nil:
+			result = true
+		case []any:
+			result = len(v) == 0
+		default:
+			return false, fmt.Errorf("field %q is not an array", field)
+		}
+	}
+	return result != n.Negate, nil
+}
+
+// fieldName returns the name of the field of a query predicate, in which
+// conditions always start with a single field.
+func fieldName(o Operand) string {
+	if f, ok := o.(*Field); ok {
+		return f.Name()
+	}
+	return ""
+}
+
+// Matches applies a comparison operator, other than the contains operators,
+// to the value. The values are compared with the equal and order functions,
+// where order returns false when the values can't be compared.
+func Matches(op string, value any, values []any, equal func(a, b any) bool, order func(a, b any) (int, bool)) bool {
+	switch op {
+	case "=":
+		return equal(value, values[0])
+	case "!=":
+		return !equal(value, values[0])
+	case "in", "not in":
+		found := false
+		for _, expected := range values {
+			if equal(value, expected) {
+				found = true
+				break
+			}
+		}
+		return found == (op == "in")
+	}
+
+	c, ok := order(value, values[0])
+	if !ok {
+		return false
+	}
+	switch op {
+	case "<":
+		return c < 0
+	case "<=":
+		return c <= 0
+	case ">":
+		return c > 0
+	case ">=":
+		return c >= 0
+	}
+	return false
+}
+
+func equal(a, b any) bool {
+	if x, ok := a.(bool); ok {
+		y, ok := b.(bool)
+		return ok && x == y
+	}
+	c, ok := order(a, b)
+	return ok && c == 0
+}
+
+// order compares two JSON strings or numbers. The second return value is false
+// if the values are of a different type and can't be compared.
+func order(a, b any) (int, bool) {
+	if x, ok := a.(string); ok {
+		y, ok := b.(string)
+		if !ok {
+			return 0, false
+		}
+		return strings.Compare(x, y), true
+	}
+
+	x, ok := Number(a)
+	if !ok {
+		return 0, false
+	}
+	y, ok := Number(b)
+	if !ok {
+		return 0, false
+	}
+	return x.Cmp(y), true
+}
+
+// Number returns the value as exact number, for numbers of the predicate and
+// JSON numbers decoded as json.Number or float64.
+func Number(value any) (*big.Rat, bool) {
+	switch v := value.(type) {
+	case *big.Rat:
+		return v, true
+	case json.Number:
+		return new(big.Rat).SetString(v.String())
+	case float64:
+		return new(big.Rat).SetFloat64(v), true
+	case int:
+		return big.NewRat(int64(v), 1), true
+	}
+	return nil, false
+}
@@ -0,0 +1,88 @@
+package predicate
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testCart = `{
+	"id": "cart-1",
+	"version": 3,
+	"country": "NL",
+	"customerId": null,
+	"taxMode": "Platform",
+	"totalPrice": {"currencyCode": "EUR", "centAmount": 12500},
+	"lineItems": [
+		{"quantity": 1, "variant": {"sku": "shirt"}},
+		{"quantity": 3, "variant": {"sku": "socks"}}
+	],
+	"customLineItems": [],
+	"custom": {"fields": {"isGift": true, "tags": ["b2b", "promo"]}}
+}`
+
+func TestEval(t *testing.T) {
+	testCases := []struct {
+		predicate string
+		expected  bool
+	}{
+		{`country = "NL"`, true},
+		{`country != "NL"`, false},
+		{`country in ("BE", "NL")`, true},
+		{`country not in ("BE", "NL")`, false},
+		{`totalPrice(centAmount > 10000 and currencyCode = "EUR")`, true},
+		{`totalPrice(centAmount < 10000)`, false},
+		{`lineItems(quantity >= 3)`, true},
+		{`lineItems(variant(sku = "shoes"))`, false},
+		{`lineItems(quantity = 1 and variant(sku = "socks"))`, false},
+		{`customLineItems is empty`, true},
+		{`lineItems is not empty`, true},
+		{`customerId is defined`, false},
+		{`not(customerId is defined)`, true},
+		{`anonymousId = "abc"`, false},
+		{`anonymousId != "abc"`, false},
+		{`custom(fields(isGift = true))`, true},
+		{`custom(fields(tags contains "promo"))`, true},
+		{`custom(fields(tags contains all ("b2b", "sale")))`, false},
+		{`custom(fields(tags contains any ("b2b", "sale")))`, true},
+		{`custom(fields(tags = "b2b"))`, true},
+		{`version > 2 or country = "BE"`, true},
+		{`country = "BE" or (version = 3 and taxMode = "Platform")`, true},
+		{`version = "3"`, false},
+		{`version = 3.0`, true},
+		{`totalPrice(centAmount >= 12500.00)`, true},
+	}
+
+	for _, tc := range testCases {
+		p, err := Parse(tc.predicate)
+		require.NoError(t, err, tc.predicate)
+
+		result, err := p.Eval([]byte(testCart))
+		assert.NoError(t, err, tc.predicate)
+		assert.Equal(t, tc.expected, result, tc.predicate)
+	}
+}
+
+func TestEvalErrors(t *testing.T) {
+	testCases := map[string]string{
+		`totalPrice = 100`:        `field "totalPrice" is an object, use totalPrice(...) to compare its fields`,
+		`country(code = "NL")`:    `field "country" is not an object`,
+		`country contains "NL"`:   `field "country" is not an array`,
+		`version is empty`:        `field "version" is not an array`,
+		`custom(fields(tags(a)))`: `expected an operator after "a" but got ")" at position 21`,
+	}
+
+	for predicate, expected := range testCases {
+		p, err := Parse(predicate)
+		if err == nil {
+			_, err = p.Eval([]byte(testCart))
+		}
+		assert.EqualError(t, err, expected, predicate)
+	}
+
+	p, err := Parse(`country = "NL"`)
+	require.NoError(t, err)
+	_, err = p.Eval([]byte(`[]`))
+	assert.ErrorContains(t, err, "resource is not a valid JSON object")
+}
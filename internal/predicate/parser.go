@@ -0,0 +1,469 @@
+// Package predicate implements a parser and evaluator for commercetools
+// [Query Predicates], as used in the conditions of API extension triggers.
+// The parser also supports the grammar of [Cart Predicates], which are
+// evaluated by the cartpredicate package.
+//
+// [Query Predicates]: https://docs.commercetools.com/api/predicates/query
+// [Cart Predicates]: https://docs.commercetools.com/api/predicates/predicate-operators
+package predicate
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// ErrUnsupported is wrapped by the errors of Parse for predicates which are
+// valid, but use constructs which are not supported, like geo location
+// predicates. Callers which only validate predicates should accept these.
+var ErrUnsupported = errors.New("not supported")
+
+// Predicate is a parsed query predicate.
+type Predicate struct {
+	root Expression
+}
+
+// Parse parses the query predicate. The returned error contains the position
+// of the first syntax error.
+func Parse(input string) (*Predicate, error) {
+	root, err := ParseExpression(input, Grammar{})
+	if err != nil {
+		return nil, err
+	}
+	return &Predicate{root: root}, nil
+}
+
+// Grammar is the variant of the predicate language to parse. The zero value
+// is the grammar of query predicates, in which a condition compares a single
+// field with values or is a nested predicate.
+type Grammar struct {
+	// Operands enables the grammar of cart predicates, which allows field
+	// paths separated by dots, literals and functions on both sides of a
+	// comparison, and boolean literals and functions as conditions.
+	Operands bool
+
+	// Functions are the names of the functions which can be used as operand,
+	// with whether they result in a boolean and can be used as a condition.
+	Functions map[string]bool
+}
+
+// ParseExpression parses the predicate with the grammar. The returned error
+// contains the position of the first syntax error.
+func ParseExpression(input string, grammar Grammar) (Expression, error) {
+	tokens, err := tokenize(input)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &parser{tokens: tokens, grammar: grammar}
+	root, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if tok := p.peek(); tok.kind != tokenEOF {
+		return nil, p.errorf(tok, "unexpected %s", tok)
+	}
+	return root, nil
+}
+
+type tokenKind int
+
+const (
+	tokenEOF tokenKind = iota
+	tokenIdent
+	tokenString
+	tokenNumber
+	tokenOperator
+	tokenLParen
+	tokenRParen
+	tokenComma
+	tokenDot
+)
+
+type token struct {
+	kind  tokenKind
+	text  string
+	value any
+	pos   int
+}
+
+func (t token) String() string {
+	switch t.kind {
+	case tokenEOF:
+		return "end of predicate"
+	case tokenString:
+		return strconv.Quote(t.value.(string))
+	}
+	return fmt.Sprintf("%q", t.text)
+}
+
+// keyword reports whether the token is the given (case-insensitive) keyword.
+func (t token) keyword(word string) bool {
+	return t.kind == tokenIdent && strings.EqualFold(t.text, word)
+}
+
+func tokenize(input string) ([]token, error) {
+	var tokens []token
+	runes := []rune(input)
+
+	for i := 0; i < len(runes); {
+		r := runes[i]
+		switch {
+		case unicode.IsSpace(r):
+			i++
+
+		case r == '(':
+			tokens = append(tokens, token{kind: tokenLParen, text: "(", pos: i})
+			i++
+		case r == ')':
+			tokens = append(tokens, token{kind: tokenRParen, text: ")", pos: i})
+			i++
+		case r == ',':
+			tokens = append(tokens, token{kind: tokenComma, text: ",", pos: i})
+			i++
+		case r == '.':
+			tokens = append(tokens, token{kind: tokenDot, text: ".", pos: i})
+			i++
+
+		case r == '=':
+			tokens = append(tokens, token{kind: tokenOperator, text: "=", pos: i})
+			i++
+		case r == '!' || r == '<' || r == '>':
+			op := string(r)
+			if i+1 < len(runes) && (runes[i+1] == '=' || (r == '<' && runes[i+1] == '>')) {
+				op += string(runes[i+1])
+			}
+			if op == "!" {
+				return nil, fmt.Errorf("unexpected %q at position %d", r, i+1)
+			}
+			tokens = append(tokens, token{kind: tokenOperator, text: op, pos: i})
+			i += len(op)
+
+		case r == '"':
+			start := i
+			var sb strings.Builder
+			i++
+			for ; i < len(runes) && runes[i] != '"'; i++ {
+				if runes[i] == '\\' && i+1 < len(runes) {
+					i++
+				}
+				sb.WriteRune(runes[i])
+			}
+			if i >= len(runes) {
+				return nil, fmt.Errorf("unterminated string at position %d", start+1)
+			}
+			i++
+			tokens = append(tokens, token{kind: tokenString, text: string(runes[start:i]), value: sb.String(), pos: start})
+
+		case unicode.IsDigit(r) || (r == '-' && i+1 < len(runes) && unicode.IsDigit(runes[i+1])):
+			start := i
+			i++
+			for i < len(runes) && (unicode.IsDigit(runes[i]) || runes[i] == '.') {
+				i++
+			}
+			text := string(runes[start:i])
+			value, ok := new(big.Rat).SetString(text)
+			if !ok {
+				return nil, fmt.Errorf("invalid number %q at position %d", text, start+1)
+			}
+			tokens = append(tokens, token{kind: tokenNumber, text: text, value: value, pos: start})
+
+		case unicode.IsLetter(r) || r == '_':
+			start := i
+			for i < len(runes) && (unicode.IsLetter(runes[i]) || unicode.IsDigit(runes[i]) || runes[i] == '_' || runes[i] == '-') {
+				i++
+			}
+			tokens = append(tokens, token{kind: tokenIdent, text: string(runes[start:i]), pos: start})
+
+		default:
+			return nil, fmt.Errorf("unexpected %q at position %d", r, i+1)
+		}
+	}
+	return append(tokens, token{kind: tokenEOF, pos: len(runes)}), nil
+}
+
+type parser struct {
+	tokens  []token
+	pos     int
+	grammar Grammar
+}
+
+func (p *parser) peek() token {
+	return p.tokens[p.pos]
+}
+
+func (p *parser) next() token {
+	tok := p.tokens[p.pos]
+	if tok.kind != tokenEOF {
+		p.pos++
+	}
+	return tok
+}
+
+func (p *parser) errorf(tok token, format string, args ...any) error {
+	return fmt.Errorf("%s at position %d", fmt.Sprintf(format, args...), tok.pos+1)
+}
+
+func (p *parser) unsupported(tok token, construct string) error {
+	return fmt.Errorf("%s are %w at position %d", construct, ErrUnsupported, tok.pos+1)
+}
+
+func (p *parser) expect(kind tokenKind, description string) (token, error) {
+	tok := p.next()
+	if tok.kind != kind {
+		return tok, p.errorf(tok, "expected %s but got %s", description, tok)
+	}
+	return tok, nil
+}
+
+func (p *parser) expectKeyword(word string) error {
+	if tok := p.next(); !tok.keyword(word) {
+		return p.errorf(tok, "expected %q but got %s", word, tok)
+	}
+	return nil
+}
+
+func (p *parser) parseOr() (Expression, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	for p.peek().keyword("or") {
+		p.next()
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		left = &Or{Left: left, Right: right}
+	}
+	return left, nil
+}
+
+func (p *parser) parseAnd() (Expression, error) {
+	left, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+	for p.peek().keyword("and") {
+		p.next()
+		right, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		left = &And{Left: left, Right: right}
+	}
+	return left, nil
+}
+
+func (p *parser) parseUnary() (Expression, error) {
+	tok := p.peek()
+	switch {
+	case tok.kind == tokenLParen:
+		return p.parseGroup()
+
+	case tok.keyword("not") && p.tokens[p.pos+1].kind == tokenLParen:
+		p.next()
+		expr, err := p.parseGroup()
+		if err != nil {
+			return nil, err
+		}
+		return &Not{Expr: expr}, nil
+	}
+	return p.parseCondition()
+}
+
+func (p *parser) parseGroup() (Expression, error) {
+	if _, err := p.expect(tokenLParen, `"("`); err != nil {
+		return nil, err
+	}
+	expr, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if _, err := p.expect(tokenRParen, `")"`); err != nil {
+		return nil, err
+	}
+	return expr, nil
+}
+
+// parseCondition parses a comparison or a nested predicate. With the cart
+// grammar it also parses an operand which results in a boolean, like true or
+// lineItemExists(...).
+func (p *parser) parseCondition() (Expression, error) {
+	start := p.peek()
+
+	var left Operand
+	if p.grammar.Operands {
+		operand, err := p.parseOperand()
+		if err != nil {
+			return nil, err
+		}
+		left = operand
+	} else {
+		if start.kind != tokenIdent {
+			return nil, p.errorf(start, "expected a field name but got %s", start)
+		}
+		p.next()
+		if p.peek().kind == tokenLParen {
+			expr, err := p.parseGroup()
+			if err != nil {
+				return nil, err
+			}
+			return &Nested{Field: start.text, Pos: start.pos, Expr: expr}, nil
+		}
+		left = &Field{Path: []string{start.text}, Pos: start.pos}
+	}
+
+	tok := p.peek()
+	switch {
+	case tok.kind == tokenOperator:
+		p.next()
+		value, err := p.parseValue()
+		if err != nil {
+			return nil, err
+		}
+		op := tok.text
+		if op == "<>" {
+			op = "!="
+		}
+		return &Compare{Left: left, Op: op, Values: []Operand{value}}, nil
+
+	case tok.keyword("in"), tok.keyword("not") && (!p.grammar.Operands || p.tokens[p.pos+1].keyword("in")):
+		op := "in"
+		if p.next().keyword("not") {
+			op = "not in"
+			if err := p.expectKeyword("in"); err != nil {
+				return nil, err
+			}
+		}
+		values, err := p.parseValues()
+		if err != nil {
+			return nil, err
+		}
+		return &Compare{Left: left, Op: op, Values: values}, nil
+
+	case tok.keyword("contains"):
+		p.next()
+		if next := p.peek(); next.keyword("any") || next.keyword("all") {
+			p.next()
+			values, err := p.parseValues()
+			if err != nil {
+				return nil, err
+			}
+			return &Compare{Left: left, Op: "contains " + strings.ToLower(next.text), Values: values}, nil
+		}
+		value, err := p.parseValue()
+		if err != nil {
+			return nil, err
+		}
+		return &Compare{Left: left, Op: "contains", Values: []Operand{value}}, nil
+
+	case tok.keyword("is"):
+		p.next()
+		negate := false
+		if p.peek().keyword("not") {
+			p.next()
+			negate = true
+		}
+		check := p.next()
+		if !check.keyword("defined") && !check.keyword("empty") {
+			return nil, p.errorf(check, `expected "defined" or "empty" but got %s`, check)
+		}
+		return &Is{Operand: left, Check: strings.ToLower(check.text), Negate: negate}, nil
+
+	case tok.keyword("within"):
+		return nil, p.unsupported(tok, "geo location predicates")
+	}
+
+	switch v := left.(type) {
+	case *Value:
+		if _, ok := v.Value.(bool); ok {
+			return v, nil
+		}
+	case *Function:
+		if p.grammar.Functions[v.Name] {
+			return v, nil
+		}
+	case *Field:
+		return nil, p.errorf(tok, "expected an operator after %q but got %s", v.Name(), tok)
+	}
+	return nil, p.errorf(start, "expected a condition but got %s", start)
+}
+
+// parseOperand parses a literal value, a field path or a function call.
+func (p *parser) parseOperand() (Operand, error) {
+	tok := p.next()
+	switch {
+	case tok.kind == tokenString, tok.kind == tokenNumber:
+		return &Value{Value: tok.value}, nil
+	case tok.keyword("true"), tok.keyword("false"):
+		return &Value{Value: tok.keyword("true")}, nil
+
+	case tok.kind == tokenIdent && p.peek().kind == tokenLParen:
+		if _, ok := p.grammar.Functions[tok.text]; !ok {
+			return nil, p.errorf(tok, "unknown function %q", tok.text)
+		}
+		expr, err := p.parseGroup()
+		if err != nil {
+			return nil, err
+		}
+		return &Function{Name: tok.text, Pos: tok.pos, Expr: expr}, nil
+
+	case tok.kind == tokenIdent:
+		path := []string{tok.text}
+		for p.peek().kind == tokenDot {
+			p.next()
+			field, err := p.expect(tokenIdent, "a field name")
+			if err != nil {
+				return nil, err
+			}
+			path = append(path, field.text)
+		}
+		return &Field{Path: path, Pos: tok.pos}, nil
+	}
+	return nil, p.errorf(tok, "expected a value or field but got %s", tok)
+}
+
+// parseValue parses the value a field is compared with, which is any operand
+// with the cart grammar or a literal value otherwise.
+func (p *parser) parseValue() (Operand, error) {
+	if p.grammar.Operands {
+		return p.parseOperand()
+	}
+
+	tok := p.next()
+	switch {
+	case tok.kind == tokenString, tok.kind == tokenNumber:
+		return &Value{Value: tok.value}, nil
+	case tok.keyword("true"):
+		return &Value{Value: true}, nil
+	case tok.keyword("false"):
+		return &Value{Value: false}, nil
+	}
+	return nil, p.errorf(tok, "expected a value but got %s", tok)
+}
+
+func (p *parser) parseValues() ([]Operand, error) {
+	if _, err := p.expect(tokenLParen, `"("`); err != nil {
+		return nil, err
+	}
+	var values []Operand
+	for {
+		value, err := p.parseValue()
+		if err != nil {
+			return nil, err
+		}
+		values = append(values, value)
+
+		tok, err := p.expect(tokenRParen, `"," or ")"`)
+		if err == nil {
+			return values, nil
+		}
+		if tok.kind != tokenComma {
+			return nil, err
+		}
+	}
+}
@@ -0,0 +1,79 @@
+package predicate
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	valid := []string{
+		`country = "NL"`,
+		`country <> "NL"`,
+		`totalPrice(centAmount > 10000 and currencyCode = "EUR")`,
+		`lineItems(quantity >= 2) or customLineItems is not empty`,
+		`not(customerId is defined)`,
+		`(country in ("NL", "BE")) and taxMode not in ("Disabled")`,
+		`custom(fields(isGift = true))`,
+		`shippingAddress(country = "DE") and lineItems(variant(sku = "a\"b"))`,
+		`addresses(key contains any ("home", "office"))`,
+		`amountPlanned(centAmount <= -1.5)`,
+		`name(en-US = "Test")`,
+		`createdAt > "2024-01-01T00:00:00.000Z" AND version != 1`,
+	}
+	for _, input := range valid {
+		_, err := Parse(input)
+		assert.NoError(t, err, input)
+	}
+
+	invalid := map[string]string{
+		``:                              `expected a field name but got end of predicate at position 1`,
+		`country = `:                    `expected a value but got end of predicate at position 11`,
+		`country == "NL"`:               `expected a value but got "=" at position 10`,
+		`country = "NL`:                 `unterminated string at position 11`,
+		`country "NL"`:                  `expected an operator after "country" but got "NL" at position 9`,
+		`totalPrice(centAmount > 1`:     `expected ")" but got end of predicate at position 26`,
+		`country in ("NL" "BE")`:        `expected "," or ")" but got "BE" at position 18`,
+		`country = "NL" and`:            `expected a field name but got end of predicate at position 19`,
+		`country = "NL" "BE"`:           `unexpected "BE" at position 16`,
+		`lineItems is set`:              `expected "defined" or "empty" but got "set" at position 14`,
+		`geoLocation within circle(1)`:  `geo location predicates are not supported at position 13`,
+		`country ! "NL"`:                `unexpected '!' at position 9`,
+		`country = "NL" && version = 1`: `unexpected '&' at position 16`,
+	}
+	for input, expected := range invalid {
+		_, err := Parse(input)
+		assert.EqualError(t, err, expected, input)
+	}
+
+	_, err := Parse(`geoLocation within circle(13.37, 52.52, 1000)`)
+	assert.ErrorIs(t, err, ErrUnsupported)
+	_, err = Parse(`country = `)
+	assert.NotErrorIs(t, err, ErrUnsupported)
+}
+
+func TestParseExpressionOperands(t *testing.T) {
+	grammar := Grammar{Operands: true, Functions: map[string]bool{"lineItemExists": true, "lineItemCount": false}}
+
+	e, err := ParseExpression(`customer.email is defined and lineItemCount(sku = "a") > 1.5`, grammar)
+	require.NoError(t, err)
+	and := e.(*And)
+	assert.Equal(t, []string{"customer", "email"}, and.Left.(*Is).Operand.(*Field).Path)
+	compare := and.Right.(*Compare)
+	assert.Equal(t, "lineItemCount", compare.Left.(*Function).Name)
+	assert.Equal(t, big.NewRat(3, 2), compare.Values[0].(*Value).Value)
+
+	_, err = ParseExpression(`lineItemExists(1 = 1) or true`, grammar)
+	assert.NoError(t, err)
+
+	_, err = ParseExpression(`lineItemCount(1 = 1)`, grammar)
+	assert.EqualError(t, err, `expected a condition but got "lineItemCount" at position 1`)
+
+	// Cart predicate constructs are not valid query predicates
+	_, err = Parse(`customer.email is defined`)
+	assert.EqualError(t, err, `expected an operator after "customer" but got "." at position 9`)
+	_, err = Parse(`1 = 1`)
+	assert.EqualError(t, err, `expected a field name but got "1" at position 1`)
+}
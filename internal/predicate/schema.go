@@ -0,0 +1,214 @@
+package predicate
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/labd/commercetools-go-sdk/platform"
+
+	"github.com/labd/terraform-provider-commercetools/internal/utils"
+)
+
+// Schema describes the fields of a resource which can be used in a predicate.
+// The fields are derived from the JSON representation of the SDK types, so
+// they follow the commercetools API.
+type Schema struct {
+	name  string
+	types []reflect.Type
+}
+
+// extensionResources are the resource types which can be used in an API
+// extension trigger. Business units are either a company or a division, so
+// the fields of both are allowed.
+var extensionResources = map[string][]any{
+	"cart":          {platform.Cart{}},
+	"order":         {platform.Order{}},
+	"payment":       {platform.Payment{}},
+	"customer":      {platform.Customer{}},
+	"quote-request": {platform.QuoteRequest{}},
+	"staged-quote":  {platform.StagedQuote{}},
+	"quote":         {platform.Quote{}},
+	"business-unit": {platform.Company{}, platform.Division{}},
+	"shopping-list": {platform.ShoppingList{}},
+}
+
+// ExtensionSchema returns the schema of a resource type which can be used in
+// an API extension trigger. False is returned for unknown resource types.
+func ExtensionSchema(resourceTypeID string) (*Schema, bool) {
+	resources, ok := extensionResources[resourceTypeID]
+	if !ok {
+		return nil, false
+	}
+
+	s := &Schema{name: resourceTypeID}
+	for _, r := range resources {
+		s.types = append(s.types, reflect.TypeOf(r))
+	}
+	return s, true
+}
+
+// ExtensionResourceTypes returns the resource types supported by
+// ExtensionSchema.
+func ExtensionResourceTypes() []string {
+	var result []string
+	for name := range extensionResources {
+		result = append(result, name)
+	}
+	sort.Strings(result)
+	return result
+}
+
+// Validate checks that the predicate only uses fields which exist in the
+// schema, and that objects are only used in nested predicates.
+func (p *Predicate) Validate(s *Schema) error {
+	return validate(p.root, s)
+}
+
+func validate(e Expression, s *Schema) error {
+	switch n := e.(type) {
+	case *And:
+		if err := validate(n.Left, s); err != nil {
+			return err
+		}
+		return validate(n.Right, s)
+
+	case *Or:
+		if err := validate(n.Left, s); err != nil {
+			return err
+		}
+		return validate(n.Right, s)
+
+	case *Not:
+		return validate(n.Expr, s)
+
+	case *Nested:
+		field, err := s.field(n.Field, n.Pos)
+		if err != nil || field == nil {
+			return err
+		}
+		if field.kind == kindScalar {
+			return fmt.Errorf("field %q of %s at position %d is not an object", n.Field, s.name, n.Pos+1)
+		}
+		return validate(n.Expr, field.schema)
+
+	case *Compare:
+		name, pos := fieldName(n.Left), fieldPos(n.Left)
+		field, err := s.field(name, pos)
+		if err != nil || field == nil {
+			return err
+		}
+		if field.kind == kindObject {
+			return fmt.Errorf(
+				"field %q of %s at position %d is an object, use %s(...) to compare its fields",
+				name, s.name, pos+1, name)
+		}
+		if strings.HasPrefix(n.Op, "contains") && !field.array {
+			return fmt.Errorf("field %q of %s at position %d is not an array", name, s.name, pos+1)
+		}
+
+	case *Is:
+		name, pos := fieldName(n.Operand), fieldPos(n.Operand)
+		field, err := s.field(name, pos)
+		if err != nil || field == nil {
+			return err
+		}
+		if n.Check == "empty" && !field.array {
+			return fmt.Errorf("field %q of %s at position %d is not an array", name, s.name, pos+1)
+		}
+	}
+	return nil
+}
+
+func fieldPos(o Operand) int {
+	if f, ok := o.(*Field); ok {
+		return f.Pos
+	}
+	return 0
+}
+
+type fieldKind int
+
+const (
+	kindScalar fieldKind = iota
+	kindObject
+	// kindDynamic is used for fields of which the structure is not known
+	// upfront, like custom fields and localized strings.
+	kindDynamic
+)
+
+type fieldInfo struct {
+	kind   fieldKind
+	array  bool
+	schema *Schema
+}
+
+var (
+	timeType      = reflect.TypeOf(time.Time{})
+	marshalerType = reflect.TypeOf((*json.Marshaler)(nil)).Elem()
+)
+
+// discriminators are the fields which the SDK adds when marshalling types
+// which are part of a polymorphic type, e.g. the typeId of references.
+var discriminators = []string{"type", "typeId", "unitType"}
+
+// field looks up a field of the schema. A nil field without error is returned
+// when the schema is dynamic, in which case the field can't be validated.
+func (s *Schema) field(name string, pos int) (*fieldInfo, error) {
+	if s == nil {
+		return nil, nil
+	}
+
+	var names []string
+	for _, t := range s.types {
+		for i := 0; i < t.NumField(); i++ {
+			f := t.Field(i)
+			jsonName := strings.Split(f.Tag.Get("json"), ",")[0]
+			if jsonName == "" || jsonName == "-" {
+				continue
+			}
+			if jsonName == name {
+				return newFieldInfo(s.name+"."+name, f.Type), nil
+			}
+			names = append(names, jsonName)
+		}
+		if t.Implements(marshalerType) && slices.Contains(discriminators, name) {
+			return &fieldInfo{kind: kindScalar}, nil
+		}
+	}
+
+	msg := fmt.Sprintf("field %q at position %d does not exist on %s", name, pos+1, s.name)
+	if suggestion := utils.ClosestMatch(name, names); suggestion != "" {
+		msg += fmt.Sprintf(", did you mean %q?", suggestion)
+	}
+	return nil, fmt.Errorf("%s", msg)
+}
+
+func newFieldInfo(name string, t reflect.Type) *fieldInfo {
+	info := &fieldInfo{}
+	for t.Kind() == reflect.Pointer || t.Kind() == reflect.Slice {
+		if t.Kind() == reflect.Slice {
+			info.array = true
+		}
+		t = t.Elem()
+	}
+
+	switch {
+	case t == timeType:
+		info.kind = kindScalar
+	case t.Kind() == reflect.Struct:
+		info.kind = kindObject
+		info.schema = &Schema{name: name, types: []reflect.Type{t}}
+	case t.Kind() == reflect.Map || t.Kind() == reflect.Interface:
+		// The nested schema is nil, so nested fields are not validated
+		info.kind = kindDynamic
+		info.array = info.array || t.Kind() == reflect.Interface
+	default:
+		info.kind = kindScalar
+	}
+	return info
+}
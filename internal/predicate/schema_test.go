@@ -0,0 +1,64 @@
+package predicate
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidate(t *testing.T) {
+	testCases := []struct {
+		resourceType string
+		predicate    string
+		expected     string
+	}{
+		{"cart", `country = "NL" and lineItems(quantity > 1 and variant(sku = "a"))`, ""},
+		{"cart", `custom(fields(anything = true))`, ""},
+		{"cart", `createdAt > "2024-01-01T00:00:00.000Z"`, ""},
+		{"cart", `lineItems(name(en = "Shirt"))`, ""},
+		{"cart", `discountCodes is not empty`, ""},
+		{"cart", `customerGroup(typeId = "customer-group" and id = "abc")`, ""},
+		{"order", `orderState = "Open" and shippingAddress(country = "DE")`, ""},
+		{"payment", `amountPlanned(centAmount > 0)`, ""},
+		{"customer", `addresses(country = "NL") and isEmailVerified = true`, ""},
+		{"business-unit", `unitType = "Division" and parentUnit(key = "acme")`, ""},
+		{"shopping-list", `lineItems(quantity > 1)`, ""},
+		{"quote", `quoteState = "Pending"`, ""},
+		{"quote-request", `quoteRequestState = "Submitted"`, ""},
+		{"staged-quote", `stagedQuoteState = "InProgress"`, ""},
+
+		{"cart", `lineItem(quantity > 1)`, `field "lineItem" at position 1 does not exist on cart, did you mean "lineItems"?`},
+		{"cart", `lineItems(quantiy > 1)`, `field "quantiy" at position 11 does not exist on cart.lineItems, did you mean "quantity"?`},
+		{"cart", `orderState = "Open"`, `field "orderState" at position 1 does not exist on cart`},
+		{"cart", `shippingAddress = "NL"`, `field "shippingAddress" of cart at position 1 is an object, use shippingAddress(...) to compare its fields`},
+		{"cart", `country(code = "NL")`, `field "country" of cart at position 1 is not an object`},
+		{"cart", `country contains "NL"`, `field "country" of cart at position 1 is not an array`},
+		{"cart", `version is empty`, `field "version" of cart at position 1 is not an array`},
+	}
+
+	for _, tc := range testCases {
+		s, ok := ExtensionSchema(tc.resourceType)
+		require.True(t, ok, tc.resourceType)
+
+		p, err := Parse(tc.predicate)
+		require.NoError(t, err, tc.predicate)
+
+		err = p.Validate(s)
+		if tc.expected == "" {
+			assert.NoError(t, err, tc.predicate)
+		} else {
+			assert.EqualError(t, err, tc.expected, tc.predicate)
+		}
+	}
+}
+
+func TestExtensionSchema(t *testing.T) {
+	_, ok := ExtensionSchema("product")
+	assert.False(t, ok)
+
+	assert.Equal(t, []string{
+		"business-unit", "cart", "customer", "order", "payment",
+		"quote", "quote-request", "shopping-list", "staged-quote",
+	}, ExtensionResourceTypes())
+}
@@ -13,13 +13,30 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/labd/commercetools-go-sdk/ctutils"
+	"github.com/labd/commercetools-go-sdk/history"
+	"github.com/labd/commercetools-go-sdk/importapi"
 	"github.com/labd/commercetools-go-sdk/platform"
+	"golang.org/x/oauth2"
 	"golang.org/x/oauth2/clientcredentials"
 
+	datasourceapiextensioncondition "github.com/labd/terraform-provider-commercetools/internal/datasource/api_extension_condition"
+	datasourcecartdiscountsimulation "github.com/labd/terraform-provider-commercetools/internal/datasource/cart_discount_simulation"
+	datasourcechangehistory "github.com/labd/terraform-provider-commercetools/internal/datasource/change_history"
+	datasourcechannel "github.com/labd/terraform-provider-commercetools/internal/datasource/channel"
+	datasourceimportcontainersummary "github.com/labd/terraform-provider-commercetools/internal/datasource/import_container_summary"
+	datasourceregion "github.com/labd/terraform-provider-commercetools/internal/datasource/region"
 	datasourcestate "github.com/labd/terraform-provider-commercetools/internal/datasource/state"
+	datasourcestatemachine "github.com/labd/terraform-provider-commercetools/internal/datasource/state_machine"
+	datasourcestore "github.com/labd/terraform-provider-commercetools/internal/datasource/store"
 	datasourcetype "github.com/labd/terraform-provider-commercetools/internal/datasource/type"
+	"github.com/labd/terraform-provider-commercetools/internal/resources/approval_rule"
 	"github.com/labd/terraform-provider-commercetools/internal/resources/associate_role"
 	"github.com/labd/terraform-provider-commercetools/internal/resources/attribute_group"
+	"github.com/labd/terraform-provider-commercetools/internal/resources/custom_object"
+	"github.com/labd/terraform-provider-commercetools/internal/resources/custom_object_container"
+	"github.com/labd/terraform-provider-commercetools/internal/resources/discount_group"
+	"github.com/labd/terraform-provider-commercetools/internal/resources/discount_priority"
+	"github.com/labd/terraform-provider-commercetools/internal/resources/import_container"
 	"github.com/labd/terraform-provider-commercetools/internal/resources/product_selection"
 	"github.com/labd/terraform-provider-commercetools/internal/resources/project"
 	"github.com/labd/terraform-provider-commercetools/internal/resources/state"
@@ -51,6 +68,12 @@ type ctProviderModel struct {
 	Scopes       types.String `tfsdk:"scopes"`
 	ApiURL       types.String `tfsdk:"api_url"`
 	TokenURL     types.String `tfsdk:"token_url"`
+	ImportApiURL types.String `tfsdk:"import_api_url"`
+
+	HistoryApiURL        types.String `tfsdk:"history_api_url"`
+	HistoryDriftWarnings types.Bool   `tfsdk:"history_drift_warnings"`
+
+	ValidateLocales types.Bool `tfsdk:"validate_locales"`
 }
 
 // Metadata returns the provider type name.
@@ -89,6 +112,22 @@ func (p *ctProvider) Schema(_ context.Context, _ provider.SchemaRequest, resp *p
 				Optional:            true,
 				MarkdownDescription: "The authentication URL of the commercetools platform. https://docs.commercetools.com/http-api-authorization",
 			},
+			"import_api_url": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The URL of the commercetools Import API. Defaults to the import host in the region of the `api_url`. https://docs.commercetools.com/import-export/",
+			},
+			"history_api_url": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The URL of the commercetools History API. Defaults to the history host in the region of the `api_url`. https://docs.commercetools.com/api/history/change-history",
+			},
+			"history_drift_warnings": schema.BoolAttribute{
+				Optional:            true,
+				MarkdownDescription: "When enabled, resources which were modified outside of Terraform report who last modified them in a warning. This uses the History API and requires the `view_audit_log` scope. Resources which the History API does not record, like API clients, API extensions, shipping methods and subscriptions, never report a warning.",
+			},
+			"validate_locales": schema.BoolAttribute{
+				Optional:            true,
+				MarkdownDescription: "When enabled, the locales of all localized strings are validated against the languages of the project during plan. This requires the `view_project_settings` scope.",
+			},
 		},
 	}
 }
@@ -144,6 +183,26 @@ func (p *ctProvider) Configure(ctx context.Context, req provider.ConfigureReques
 		authURL = config.TokenURL.ValueString()
 	}
 
+	var importApiURL string
+	if config.ImportApiURL.IsUnknown() || config.ImportApiURL.IsNull() {
+		importApiURL = os.Getenv("CTP_IMPORT_API_URL")
+	} else {
+		importApiURL = config.ImportApiURL.ValueString()
+	}
+	if importApiURL == "" {
+		importApiURL = utils.ImportApiURL(apiURL)
+	}
+
+	var historyApiURL string
+	if config.HistoryApiURL.IsUnknown() || config.HistoryApiURL.IsNull() {
+		historyApiURL = os.Getenv("CTP_HISTORY_API_URL")
+	} else {
+		historyApiURL = config.HistoryApiURL.ValueString()
+	}
+	if historyApiURL == "" {
+		historyApiURL = utils.HistoryApiURL(apiURL)
+	}
+
 	oauthScopes := strings.Split(scopesRaw, " ")
 	oauth2Config := &clientcredentials.Config{
 		ClientID:     clientID,
@@ -170,10 +229,64 @@ func (p *ctProvider) Configure(ctx context.Context, req provider.ConfigureReques
 		return
 	}
 
+	rawHTTPClient := oauth2Config.Client(context.WithValue(context.Background(), oauth2.HTTPClient, &http.Client{
+		Transport: ctutils.DebugTransport,
+	}))
+
 	data := &utils.ProviderData{
-		Client: client.WithProjectKey(projectKey),
-		Mutex:  utils.NewMutexKV(),
+		ProjectKey: projectKey,
+		Client:     client.WithProjectKey(projectKey),
+		Mutex:      utils.NewMutexKV(),
+		RawClient: utils.NewRawClient(rawHTTPClient, apiURL, projectKey,
+			fmt.Sprintf("terraform-provider-commercetools/%s", p.version)),
+	}
+
+	// The import client is optional, it is only available when we are able
+	// to determine the url of the Import API.
+	if importApiURL != "" {
+		importClient, err := importapi.NewClient(&importapi.ClientConfig{
+			URL:         importApiURL,
+			Credentials: oauth2Config,
+			UserAgent:   fmt.Sprintf("terraform-provider-commercetools/%s", p.version),
+			HTTPClient: &http.Client{
+				Transport: ctutils.DebugTransport,
+			},
+		})
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to create client",
+				"Unable to create commercetools import client:\n\n"+err.Error(),
+			)
+			return
+		}
+		data.ImportClient = importClient.WithProjectKeyValue(projectKey)
+	}
+
+	// The history client is optional as well, see the import client.
+	if historyApiURL != "" {
+		historyClient, err := history.NewClient(&history.ClientConfig{
+			URL:         historyApiURL,
+			Credentials: oauth2Config,
+			UserAgent:   fmt.Sprintf("terraform-provider-commercetools/%s", p.version),
+			HTTPClient: &http.Client{
+				Transport: ctutils.DebugTransport,
+			},
+		})
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to create client",
+				"Unable to create commercetools history client:\n\n"+err.Error(),
+			)
+			return
+		}
+		data.HistoryClient = historyClient.WithProjectKeyValue(projectKey)
+		data.HistoryDriftWarnings = config.HistoryDriftWarnings.ValueBool()
 	}
+
+	if config.ValidateLocales.ValueBool() {
+		data.Locales = utils.NewLocaleValidator(data.Client, apiURL, projectKey)
+	}
+
 	resp.DataSourceData = data
 	resp.ResourceData = data
 }
@@ -183,6 +296,14 @@ func (p *ctProvider) DataSources(_ context.Context) []func() datasource.DataSour
 	return []func() datasource.DataSource{
 		datasourcetype.NewDataSource,
 		datasourcestate.NewDataSource,
+		datasourceimportcontainersummary.NewDataSource,
+		datasourcechangehistory.NewDataSource,
+		datasourceapiextensioncondition.NewDataSource,
+		datasourcestatemachine.NewDataSource,
+		datasourcecartdiscountsimulation.NewDataSource,
+		datasourcestore.NewDataSource,
+		datasourcechannel.NewDataSource,
+		datasourceregion.NewDataSource,
 	}
 }
 
@@ -196,5 +317,11 @@ func (p *ctProvider) Resources(_ context.Context) []func() resource.Resource {
 		attribute_group.NewResource,
 		associate_role.NewResource,
 		product_selection.NewResource,
+		approval_rule.NewResource,
+		import_container.NewResource,
+		custom_object.NewResource,
+		custom_object_container.NewResource,
+		discount_group.NewResource,
+		discount_priority.NewResource,
 	}
 }
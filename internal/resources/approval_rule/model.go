@@ -0,0 +1,204 @@
+package approval_rule
+
+import (
+	"reflect"
+
+	"github.com/elliotchance/pie/v2"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/labd/commercetools-go-sdk/platform"
+
+	"github.com/labd/terraform-provider-commercetools/internal/utils"
+)
+
+// ApprovalRule represents the main schema data.
+type ApprovalRule struct {
+	ID              types.String   `tfsdk:"id"`
+	Key             types.String   `tfsdk:"key"`
+	Version         types.Int64    `tfsdk:"version"`
+	BusinessUnitKey types.String   `tfsdk:"business_unit_key"`
+	AssociateID     types.String   `tfsdk:"associate_id"`
+	Name            types.String   `tfsdk:"name"`
+	Description     types.String   `tfsdk:"description"`
+	Status          types.String   `tfsdk:"status"`
+	Predicate       types.String   `tfsdk:"predicate"`
+	Requesters      []types.String `tfsdk:"requesters"`
+	ApproverTiers   []ApproverTier `tfsdk:"approver_tier"`
+}
+
+// ApproverTier is a conjunction: approval is needed from every item in the
+// list.
+type ApproverTier struct {
+	And []ApproverDisjunction `tfsdk:"and"`
+}
+
+// ApproverDisjunction contains the alternatives: approval of one of the
+// associate roles is sufficient.
+type ApproverDisjunction struct {
+	Or []types.String `tfsdk:"or"`
+}
+
+func NewApprovalRuleFromNative(ar *platform.ApprovalRule) ApprovalRule {
+	return ApprovalRule{
+		ID:              types.StringValue(ar.ID),
+		Version:         types.Int64Value(int64(ar.Version)),
+		Key:             utils.FromOptionalString(ar.Key),
+		BusinessUnitKey: types.StringValue(ar.BusinessUnit.Key),
+		Name:            types.StringValue(ar.Name),
+		Description:     utils.FromOptionalString(ar.Description),
+		Status:          types.StringValue(string(ar.Status)),
+		Predicate:       types.StringValue(ar.Predicate),
+		Requesters: pie.Map(ar.Requesters, func(r platform.RuleRequester) types.String {
+			return types.StringValue(r.AssociateRole.Key)
+		}),
+		ApproverTiers: pie.Map(ar.Approvers.Tiers, func(c platform.ApproverConjunction) ApproverTier {
+			return ApproverTier{
+				And: pie.Map(c.And, func(d platform.ApproverDisjunction) ApproverDisjunction {
+					return ApproverDisjunction{
+						Or: pie.Map(d.Or, func(a platform.RuleApprover) types.String {
+							return types.StringValue(a.AssociateRole.Key)
+						}),
+					}
+				}),
+			}
+		}),
+	}
+}
+
+// setStateData copies the values which are not returned by commercetools
+func (ar *ApprovalRule) setStateData(o ApprovalRule) {
+	ar.AssociateID = o.AssociateID
+}
+
+func (ar ApprovalRule) draft() platform.ApprovalRuleDraft {
+	return platform.ApprovalRuleDraft{
+		Key:         utils.OptionalString(ar.Key),
+		Name:        ar.Name.ValueString(),
+		Description: utils.OptionalString(ar.Description),
+		Status:      platform.ApprovalRuleStatus(ar.Status.ValueString()),
+		Predicate:   ar.Predicate.ValueString(),
+		Approvers:   ar.approversDraft(),
+		Requesters:  ar.requestersDraft(),
+	}
+}
+
+func (ar ApprovalRule) approversDraft() platform.ApproverHierarchyDraft {
+	return platform.ApproverHierarchyDraft{
+		Tiers: pie.Map(ar.ApproverTiers, func(t ApproverTier) platform.ApproverConjunctionDraft {
+			return platform.ApproverConjunctionDraft{
+				And: pie.Map(t.And, func(d ApproverDisjunction) platform.ApproverDisjunctionDraft {
+					return platform.ApproverDisjunctionDraft{
+						Or: pie.Map(d.Or, func(key types.String) platform.RuleApproverDraft {
+							return platform.RuleApproverDraft{
+								AssociateRole: associateRoleIdentifier(key),
+							}
+						}),
+					}
+				}),
+			}
+		}),
+	}
+}
+
+func (ar ApprovalRule) requestersDraft() []platform.RuleRequesterDraft {
+	return pie.Map(ar.Requesters, func(key types.String) platform.RuleRequesterDraft {
+		return platform.RuleRequesterDraft{
+			AssociateRole: associateRoleIdentifier(key),
+		}
+	})
+}
+
+// associateRoleKeys returns all associate role keys referenced by the rule.
+// Unknown values are skipped.
+func (ar ApprovalRule) associateRoleKeys() []string {
+	var result []string
+	add := func(key types.String) {
+		if !key.IsUnknown() && !key.IsNull() && !pie.Contains(result, key.ValueString()) {
+			result = append(result, key.ValueString())
+		}
+	}
+
+	for _, key := range ar.Requesters {
+		add(key)
+	}
+	for _, tier := range ar.ApproverTiers {
+		for _, d := range tier.And {
+			for _, key := range d.Or {
+				add(key)
+			}
+		}
+	}
+	return result
+}
+
+func (ar ApprovalRule) updateActions(plan ApprovalRule) platform.ApprovalRuleUpdate {
+	result := platform.ApprovalRuleUpdate{
+		Version: int(ar.Version.ValueInt64()),
+		Actions: []platform.ApprovalRuleUpdateAction{},
+	}
+
+	// setKey
+	if !ar.Key.Equal(plan.Key) {
+		result.Actions = append(
+			result.Actions,
+			platform.ApprovalRuleSetKeyAction{Key: utils.OptionalString(plan.Key)},
+		)
+	}
+
+	// setName
+	if !ar.Name.Equal(plan.Name) {
+		result.Actions = append(
+			result.Actions,
+			platform.ApprovalRuleSetNameAction{Name: plan.Name.ValueString()},
+		)
+	}
+
+	// setDescription
+	if !ar.Description.Equal(plan.Description) {
+		result.Actions = append(
+			result.Actions,
+			platform.ApprovalRuleSetDescriptionAction{Description: utils.OptionalString(plan.Description)},
+		)
+	}
+
+	// setStatus
+	if !ar.Status.Equal(plan.Status) {
+		result.Actions = append(
+			result.Actions,
+			platform.ApprovalRuleSetStatusAction{
+				Status: platform.ApprovalRuleStatus(plan.Status.ValueString()),
+			},
+		)
+	}
+
+	// setPredicate
+	if !ar.Predicate.Equal(plan.Predicate) {
+		result.Actions = append(
+			result.Actions,
+			platform.ApprovalRuleSetPredicateAction{Predicate: plan.Predicate.ValueString()},
+		)
+	}
+
+	// setApprovers
+	if !reflect.DeepEqual(ar.ApproverTiers, plan.ApproverTiers) {
+		result.Actions = append(
+			result.Actions,
+			platform.ApprovalRuleSetApproversAction{Approvers: plan.approversDraft()},
+		)
+	}
+
+	// setRequesters
+	if !reflect.DeepEqual(ar.Requesters, plan.Requesters) {
+		result.Actions = append(
+			result.Actions,
+			platform.ApprovalRuleSetRequestersAction{Requesters: plan.requestersDraft()},
+		)
+	}
+
+	return result
+}
+
+func associateRoleIdentifier(key types.String) platform.AssociateRoleResourceIdentifier {
+	return platform.AssociateRoleResourceIdentifier{
+		Key: utils.StringRef(key.ValueString()),
+	}
+}
@@ -0,0 +1,194 @@
+package approval_rule
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/labd/commercetools-go-sdk/platform"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/labd/terraform-provider-commercetools/internal/utils"
+)
+
+func TestNewApprovalRuleFromNative(t *testing.T) {
+	res := &platform.ApprovalRule{
+		ID:        "rule-id",
+		Version:   2,
+		Key:       utils.StringRef("large-orders"),
+		Name:      "Large orders",
+		Status:    platform.ApprovalRuleStatusActive,
+		Predicate: "totalPrice.centAmount > 100000",
+		Approvers: platform.ApproverHierarchy{
+			Tiers: []platform.ApproverConjunction{
+				{
+					And: []platform.ApproverDisjunction{
+						{Or: []platform.RuleApprover{
+							{AssociateRole: platform.AssociateRoleKeyReference{Key: "manager"}},
+							{AssociateRole: platform.AssociateRoleKeyReference{Key: "director"}},
+						}},
+					},
+				},
+			},
+		},
+		Requesters: []platform.RuleRequester{
+			{AssociateRole: platform.AssociateRoleKeyReference{Key: "buyer"}},
+		},
+		BusinessUnit: platform.BusinessUnitKeyReference{Key: "acme"},
+	}
+
+	assert.Equal(t, ApprovalRule{
+		ID:              types.StringValue("rule-id"),
+		Version:         types.Int64Value(2),
+		Key:             types.StringValue("large-orders"),
+		BusinessUnitKey: types.StringValue("acme"),
+		Name:            types.StringValue("Large orders"),
+		Description:     types.StringNull(),
+		Status:          types.StringValue("Active"),
+		Predicate:       types.StringValue("totalPrice.centAmount > 100000"),
+		Requesters:      []types.String{types.StringValue("buyer")},
+		ApproverTiers: []ApproverTier{
+			{And: []ApproverDisjunction{
+				{Or: []types.String{types.StringValue("manager"), types.StringValue("director")}},
+			}},
+		},
+	}, NewApprovalRuleFromNative(res))
+}
+
+func TestApprovalRuleDraft(t *testing.T) {
+	rule := ApprovalRule{
+		Name:       types.StringValue("Large orders"),
+		Status:     types.StringValue("Inactive"),
+		Predicate:  types.StringValue("true"),
+		Requesters: []types.String{types.StringValue("buyer")},
+		ApproverTiers: []ApproverTier{
+			{And: []ApproverDisjunction{
+				{Or: []types.String{types.StringValue("manager")}},
+				{Or: []types.String{types.StringValue("finance")}},
+			}},
+		},
+	}
+
+	assert.Equal(t, platform.ApprovalRuleDraft{
+		Name:      "Large orders",
+		Status:    platform.ApprovalRuleStatusInactive,
+		Predicate: "true",
+		Approvers: platform.ApproverHierarchyDraft{
+			Tiers: []platform.ApproverConjunctionDraft{
+				{And: []platform.ApproverDisjunctionDraft{
+					{Or: []platform.RuleApproverDraft{
+						{AssociateRole: platform.AssociateRoleResourceIdentifier{Key: utils.StringRef("manager")}},
+					}},
+					{Or: []platform.RuleApproverDraft{
+						{AssociateRole: platform.AssociateRoleResourceIdentifier{Key: utils.StringRef("finance")}},
+					}},
+				}},
+			},
+		},
+		Requesters: []platform.RuleRequesterDraft{
+			{AssociateRole: platform.AssociateRoleResourceIdentifier{Key: utils.StringRef("buyer")}},
+		},
+	}, rule.draft())
+	assert.Equal(t, []string{"buyer", "manager", "finance"}, rule.associateRoleKeys())
+}
+
+func TestUpdateActions(t *testing.T) {
+	base := ApprovalRule{
+		Version:    types.Int64Value(1),
+		Name:       types.StringValue("Large orders"),
+		Status:     types.StringValue("Active"),
+		Predicate:  types.StringValue("true"),
+		Requesters: []types.String{types.StringValue("buyer")},
+		ApproverTiers: []ApproverTier{
+			{And: []ApproverDisjunction{
+				{Or: []types.String{types.StringValue("manager")}},
+			}},
+		},
+	}
+
+	tests := []struct {
+		name   string
+		state  ApprovalRule
+		plan   func(ApprovalRule) ApprovalRule
+		action platform.ApprovalRuleUpdate
+	}{
+		{
+			name:  "No changes",
+			state: base,
+			plan:  func(ar ApprovalRule) ApprovalRule { return ar },
+			action: platform.ApprovalRuleUpdate{
+				Version: 1,
+				Actions: []platform.ApprovalRuleUpdateAction{},
+			},
+		},
+		{
+			name:  "Change status and description",
+			state: base,
+			plan: func(ar ApprovalRule) ApprovalRule {
+				ar.Status = types.StringValue("Inactive")
+				ar.Description = types.StringValue("Orders over 1000 euro")
+				return ar
+			},
+			action: platform.ApprovalRuleUpdate{
+				Version: 1,
+				Actions: []platform.ApprovalRuleUpdateAction{
+					platform.ApprovalRuleSetDescriptionAction{Description: utils.StringRef("Orders over 1000 euro")},
+					platform.ApprovalRuleSetStatusAction{Status: platform.ApprovalRuleStatusInactive},
+				},
+			},
+		},
+		{
+			name:  "Change approvers and requesters",
+			state: base,
+			plan: func(ar ApprovalRule) ApprovalRule {
+				ar.Requesters = []types.String{types.StringValue("buyer"), types.StringValue("admin")}
+				ar.ApproverTiers = []ApproverTier{
+					{And: []ApproverDisjunction{
+						{Or: []types.String{types.StringValue("director")}},
+					}},
+				}
+				return ar
+			},
+			action: platform.ApprovalRuleUpdate{
+				Version: 1,
+				Actions: []platform.ApprovalRuleUpdateAction{
+					platform.ApprovalRuleSetApproversAction{
+						Approvers: platform.ApproverHierarchyDraft{
+							Tiers: []platform.ApproverConjunctionDraft{
+								{And: []platform.ApproverDisjunctionDraft{
+									{Or: []platform.RuleApproverDraft{
+										{AssociateRole: platform.AssociateRoleResourceIdentifier{Key: utils.StringRef("director")}},
+									}},
+								}},
+							},
+						},
+					},
+					platform.ApprovalRuleSetRequestersAction{
+						Requesters: []platform.RuleRequesterDraft{
+							{AssociateRole: platform.AssociateRoleResourceIdentifier{Key: utils.StringRef("buyer")}},
+							{AssociateRole: platform.AssociateRoleResourceIdentifier{Key: utils.StringRef("admin")}},
+						},
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := tt.state.updateActions(tt.plan(tt.state))
+			assert.Equal(t, tt.action, result)
+		})
+	}
+}
+
+func TestDuplicateKeys(t *testing.T) {
+	keys := []types.String{
+		types.StringValue("a"),
+		types.StringValue("b"),
+		types.StringUnknown(),
+		types.StringUnknown(),
+		types.StringValue("a"),
+		types.StringValue("a"),
+	}
+	assert.Equal(t, []string{"a"}, duplicateKeys(keys))
+	assert.Empty(t, duplicateKeys(keys[:2]))
+}
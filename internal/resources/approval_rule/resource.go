@@ -0,0 +1,457 @@
+package approval_rule
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/elliotchance/pie/v2"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/labd/commercetools-go-sdk/platform"
+
+	"github.com/labd/terraform-provider-commercetools/internal/utils"
+)
+
+var (
+	_ resource.Resource                   = &approvalRuleResource{}
+	_ resource.ResourceWithConfigure      = &approvalRuleResource{}
+	_ resource.ResourceWithImportState    = &approvalRuleResource{}
+	_ resource.ResourceWithModifyPlan     = &approvalRuleResource{}
+	_ resource.ResourceWithValidateConfig = &approvalRuleResource{}
+)
+
+type approvalRuleResource struct {
+	client *platform.ByProjectKeyRequestBuilder
+}
+
+// NewResource is a helper function to simplify the provider implementation.
+func NewResource() resource.Resource {
+	return &approvalRuleResource{}
+}
+
+// Schema implements resource.Resource.
+func (*approvalRuleResource) Schema(_ context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	roleKeysValidators := []validator.List{
+		listvalidator.SizeAtLeast(1),
+		listvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Approval Rules define which Orders of a Business Unit require approval, and by " +
+			"which Associates.\n\n" +
+			"Approval Rules are managed on behalf of an Associate of the Business Unit, this Associate " +
+			"must have the `CreateApprovalRules` and `UpdateApprovalRules` permissions. The associate " +
+			"role keys used as requesters and approvers are checked against the project at plan time.\n\n" +
+			"Approval Rules cannot be deleted in commercetools. Destroying this resource sets the status " +
+			"of the Approval Rule to `Inactive` and removes it from the Terraform state.\n\n" +
+			"See also the [Approval Rule API Documentation](https://docs.commercetools.com/api/projects/approval-rules)",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Unique identifier of the Approval Rule.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"version": schema.Int64Attribute{
+				Description: "Current version of the Approval Rule.",
+				Computed:    true,
+			},
+			"key": schema.StringAttribute{
+				Description: "User-defined unique identifier of the Approval Rule. Must be unique within " +
+					"a Business Unit.",
+				Optional: true,
+			},
+			"business_unit_key": schema.StringAttribute{
+				Description: "Key of the Business Unit the Approval Rule belongs to.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"associate_id": schema.StringAttribute{
+				Description: "ID of the Associate (Customer) acting on behalf of the Business Unit " +
+					"when managing the Approval Rule.",
+				Required: true,
+			},
+			"name": schema.StringAttribute{
+				Description: "Name of the Approval Rule.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"description": schema.StringAttribute{
+				Description: "Description of the Approval Rule.",
+				Optional:    true,
+			},
+			"status": schema.StringAttribute{
+				Description: "Indicates whether the Approval Rule should be matched against Orders " +
+					"or not. Can be `Active` or `Inactive`.",
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						string(platform.ApprovalRuleStatusActive),
+						string(platform.ApprovalRuleStatusInactive),
+					),
+				},
+			},
+			"predicate": schema.StringAttribute{
+				Description: "The [Order Predicate](https://docs.commercetools.com/api/projects/predicates#order-predicates) " +
+					"describing the Orders the Approval Rule should match against.",
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"requesters": schema.ListAttribute{
+				Description: "Keys of the Associate Roles customers must hold for their Order to " +
+					"require approval.",
+				Required:    true,
+				ElementType: types.StringType,
+				Validators:  roleKeysValidators,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"approver_tier": schema.ListNestedBlock{
+				Description: "The hierarchy of approvers within the Approval Rule. Tiers are " +
+					"evaluated in order, an Order must be approved in a tier before it moves to " +
+					"the next one.",
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"and": schema.ListNestedBlock{
+							Description: "Conjunction of approvers, approval is needed from all of " +
+								"the entries in the tier.",
+							Validators: []validator.List{
+								listvalidator.IsRequired(),
+								listvalidator.SizeAtLeast(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"or": schema.ListAttribute{
+										Description: "Keys of the Associate Roles of which one " +
+											"approval is sufficient.",
+										Required:    true,
+										ElementType: types.StringType,
+										Validators:  roleKeysValidators,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Metadata implements resource.Resource.
+func (*approvalRuleResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_approval_rule"
+}
+
+// ValidateConfig implements resource.ResourceWithValidateConfig.
+func (*approvalRuleResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config ApprovalRule
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for i, tier := range config.ApproverTiers {
+		for j, d := range tier.And {
+			if duplicates := duplicateKeys(d.Or); len(duplicates) > 0 {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("approver_tier").AtListIndex(i).AtName("and").AtListIndex(j).AtName("or"),
+					"Duplicate associate role",
+					fmt.Sprintf("The associate roles %s are listed more than once.", strings.Join(duplicates, ", ")),
+				)
+			}
+		}
+	}
+
+	if duplicates := duplicateKeys(config.Requesters); len(duplicates) > 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("requesters"),
+			"Duplicate associate role",
+			fmt.Sprintf("The associate roles %s are listed more than once.", strings.Join(duplicates, ", ")),
+		)
+	}
+}
+
+// ModifyPlan implements resource.ResourceWithModifyPlan. It verifies that the
+// referenced associate roles exist in the project.
+func (r *approvalRuleResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to validate on destroy, or when the provider isn't configured yet
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var plan ApprovalRule
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	keys := plan.associateRoleKeys()
+	if len(keys) == 0 {
+		return
+	}
+
+	quoted := pie.Map(keys, func(k string) string { return fmt.Sprintf("%q", k) })
+	result, err := r.client.AssociateRoles().
+		Get().
+		Where([]string{fmt.Sprintf("key in (%s)", strings.Join(quoted, ", "))}).
+		Limit(len(keys)).
+		Execute(ctx)
+	if err != nil {
+		resp.Diagnostics.AddWarning(
+			"Unable to validate associate roles",
+			"Could not retrieve the associate roles, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	known := pie.Map(result.Results, func(r platform.AssociateRole) string { return r.Key })
+	missing, _ := pie.Diff(keys, known)
+	if len(missing) > 0 {
+		// Associate roles created in the same apply don't exist yet, so we
+		// can only warn about them here.
+		resp.Diagnostics.AddWarning(
+			"Unknown associate role",
+			fmt.Sprintf(
+				"The associate roles %s do not exist in the project. Make sure they are created "+
+					"before the approval rule, for example by referencing the key of a "+
+					"commercetools_associate_role resource.",
+				strings.Join(missing, ", "),
+			),
+		)
+	}
+}
+
+// Create implements resource.Resource.
+func (r *approvalRuleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan ApprovalRule
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	draft := plan.draft()
+
+	var approvalRule *platform.ApprovalRule
+	err := retry.RetryContext(ctx, 20*time.Second, func() *retry.RetryError {
+		var err error
+		approvalRule, err = r.approvalRules(plan).Post(draft).Execute(ctx)
+		return utils.ProcessRemoteError(err)
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating approval rule",
+			err.Error(),
+		)
+		return
+	}
+
+	current := NewApprovalRuleFromNative(approvalRule)
+	current.setStateData(plan)
+
+	diags = resp.State.Set(ctx, current)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete implements resource.Resource. Approval rules cannot be deleted, so
+// we deactivate them instead.
+func (r *approvalRuleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Get the current state.
+	var state ApprovalRule
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if state.Status.ValueString() == string(platform.ApprovalRuleStatusInactive) {
+		return
+	}
+
+	input := platform.ApprovalRuleUpdate{
+		Version: int(state.Version.ValueInt64()),
+		Actions: []platform.ApprovalRuleUpdateAction{
+			platform.ApprovalRuleSetStatusAction{Status: platform.ApprovalRuleStatusInactive},
+		},
+	}
+
+	err := retry.RetryContext(
+		ctx,
+		5*time.Second,
+		func() *retry.RetryError {
+			_, err := r.approvalRules(state).
+				WithId(state.ID.ValueString()).
+				Post(input).
+				Execute(ctx)
+
+			return utils.ProcessRemoteError(err)
+		})
+	if err != nil && !utils.IsResourceNotFoundError(err) {
+		resp.Diagnostics.AddError(
+			"Error deleting approval rule",
+			"Could not deactivate approval rule, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+// Read implements resource.Resource.
+func (r *approvalRuleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get the current state.
+	var state ApprovalRule
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Read remote approval rule and check for errors.
+	approvalRule, err := r.approvalRules(state).WithId(state.ID.ValueString()).Get().Execute(ctx)
+	if err != nil {
+		if utils.IsResourceNotFoundError(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Error reading approval rule",
+			"Could not retrieve the approval rule, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Transform the remote platform approval rule to the
+	// tf schema matching representation.
+	current := NewApprovalRuleFromNative(approvalRule)
+	current.setStateData(state)
+
+	// Set current data as state.
+	diags = resp.State.Set(ctx, &current)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update implements resource.Resource.
+func (r *approvalRuleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan ApprovalRule
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state ApprovalRule
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := state.updateActions(plan)
+	var approvalRule *platform.ApprovalRule
+	err := retry.RetryContext(ctx, 5*time.Second, func() *retry.RetryError {
+		var err error
+		approvalRule, err = r.approvalRules(plan).
+			WithId(state.ID.ValueString()).
+			Post(input).
+			Execute(ctx)
+
+		return utils.ProcessRemoteError(err)
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating approval rule",
+			"Could not update approval rule, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	current := NewApprovalRuleFromNative(approvalRule)
+	current.setStateData(plan)
+
+	diags = resp.State.Set(ctx, current)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Configure implements resource.ResourceWithConfigure.
+func (r *approvalRuleResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data := req.ProviderData.(*utils.ProviderData)
+	r.client = data.Client
+}
+
+// ImportState implements resource.ResourceWithImportState. The import ID has
+// the format `<business_unit_key>/<associate_id>/<id>` since approval rules
+// can only be retrieved in the context of an associate.
+func (*approvalRuleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.Split(req.ID, "/")
+	if len(parts) != 3 || pie.Any(parts, func(p string) bool { return p == "" }) {
+		resp.Diagnostics.AddError(
+			"Unexpected import identifier",
+			fmt.Sprintf("Expected import identifier with format: business_unit_key/associate_id/id. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("business_unit_key"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("associate_id"), parts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), parts[2])...)
+}
+
+func (r *approvalRuleResource) approvalRules(ar ApprovalRule) *platform.ByProjectKeyAsAssociateByAssociateIdInBusinessUnitKeyByBusinessUnitKeyApprovalRulesRequestBuilder {
+	return r.client.
+		AsAssociate().
+		WithAssociateIdValue(ar.AssociateID.ValueString()).
+		InBusinessUnitKeyWithBusinessUnitKeyValue(ar.BusinessUnitKey.ValueString()).
+		ApprovalRules()
+}
+
+func duplicateKeys(keys []types.String) []string {
+	seen := map[string]bool{}
+	var result []string
+	for _, key := range keys {
+		if key.IsUnknown() || key.IsNull() {
+			continue
+		}
+		if seen[key.ValueString()] && !pie.Contains(result, key.ValueString()) {
+			result = append(result, key.ValueString())
+		}
+		seen[key.ValueString()] = true
+	}
+	return result
+}
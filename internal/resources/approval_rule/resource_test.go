@@ -0,0 +1,82 @@
+package approval_rule_test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/labd/terraform-provider-commercetools/internal/acctest"
+	"github.com/labd/terraform-provider-commercetools/internal/utils"
+)
+
+// The business unit and associate cannot be managed with this provider, so
+// they need to be provided via the environment.
+func TestApprovalRuleResource_Create(t *testing.T) {
+	businessUnitKey := os.Getenv("CTP_BUSINESS_UNIT_KEY")
+	associateID := os.Getenv("CTP_ASSOCIATE_ID")
+	if os.Getenv("TF_ACC") == "1" && (businessUnitKey == "" || associateID == "") {
+		t.Skip("CTP_BUSINESS_UNIT_KEY and CTP_ASSOCIATE_ID must be set for approval rule acceptance tests")
+	}
+
+	rn := "commercetools_approval_rule.large_orders"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testApprovalRuleConfig(businessUnitKey, associateID, "Active"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(rn, "name", "Large orders"),
+					resource.TestCheckResourceAttr(rn, "status", "Active"),
+					resource.TestCheckResourceAttr(rn, "requesters.0", "approval-rule-buyer"),
+					resource.TestCheckResourceAttr(rn, "approver_tier.#", "1"),
+					resource.TestCheckResourceAttr(rn, "approver_tier.0.and.0.or.0", "approval-rule-manager"),
+				),
+			},
+			{
+				Config: testApprovalRuleConfig(businessUnitKey, associateID, "Inactive"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(rn, "status", "Inactive"),
+				),
+			},
+		},
+	})
+}
+
+func testApprovalRuleConfig(businessUnitKey, associateID, status string) string {
+	return utils.HCLTemplate(`
+		resource "commercetools_associate_role" "buyer" {
+			key         = "approval-rule-buyer"
+			name        = "Buyer"
+			permissions = ["CreateMyOrdersFromMyCarts"]
+		}
+
+		resource "commercetools_associate_role" "manager" {
+			key         = "approval-rule-manager"
+			name        = "Manager"
+			permissions = ["UpdateApprovalFlows"]
+		}
+
+		resource "commercetools_approval_rule" "large_orders" {
+			business_unit_key = "{{ .business_unit_key }}"
+			associate_id      = "{{ .associate_id }}"
+			key               = "large-orders"
+			name              = "Large orders"
+			status            = "{{ .status }}"
+			predicate         = "totalPrice.centAmount > 100000"
+			requesters        = [commercetools_associate_role.buyer.key]
+
+			approver_tier {
+				and {
+					or = [commercetools_associate_role.manager.key]
+				}
+			}
+		}
+	`, map[string]any{
+		"business_unit_key": businessUnitKey,
+		"associate_id":      associateID,
+		"status":            status,
+	})
+}
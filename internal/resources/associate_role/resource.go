@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/labd/commercetools-go-sdk/history"
 	"github.com/labd/commercetools-go-sdk/platform"
 
 	"github.com/labd/terraform-provider-commercetools/internal/utils"
@@ -25,7 +26,8 @@ var (
 )
 
 type associateRoleResource struct {
-	client *platform.ByProjectKeyRequestBuilder
+	client  *platform.ByProjectKeyRequestBuilder
+	history *history.ByProjectKeyRequestBuilder
 }
 
 // NewResource is a helper function to simplify the provider implementation.
@@ -174,6 +176,10 @@ func (r *associateRoleResource) Read(ctx context.Context, req resource.ReadReque
 	// Transform the remote platform associate role to the
 	// tf schema matching representation.
 	current := NewAssociateRoleFromNative(associateRole)
+	resp.Diagnostics.Append(utils.LastModifiedByWarning(
+		ctx, r.history, history.ChangeHistoryResourceTypeAssociateRole,
+		state.ID.ValueString(), state.Version, current.Version,
+	)...)
 
 	// Set current data as state.
 	diags = resp.State.Set(ctx, &current)
@@ -235,6 +241,7 @@ func (r *associateRoleResource) Configure(_ context.Context, req resource.Config
 
 	data := req.ProviderData.(*utils.ProviderData)
 	r.client = data.Client
+	r.history = data.DriftReporter()
 }
 
 // ImportState implements resource.ResourceWithImportState.
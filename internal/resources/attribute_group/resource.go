@@ -23,6 +23,7 @@ var (
 	_ resource.Resource                = &Resource{}
 	_ resource.ResourceWithConfigure   = &Resource{}
 	_ resource.ResourceWithImportState = &Resource{}
+	_ resource.ResourceWithModifyPlan  = &Resource{}
 )
 
 func NewResource() resource.Resource {
@@ -30,8 +31,9 @@ func NewResource() resource.Resource {
 }
 
 type Resource struct {
-	client *platform.ByProjectKeyRequestBuilder
-	mutex  *utils.MutexKV
+	client  *platform.ByProjectKeyRequestBuilder
+	mutex   *utils.MutexKV
+	locales *utils.LocaleValidator
 }
 
 // Metadata returns the data source type name.
@@ -108,6 +110,13 @@ func (r *Resource) Configure(_ context.Context, req resource.ConfigureRequest, _
 	data := req.ProviderData.(*utils.ProviderData)
 	r.client = data.Client
 	r.mutex = data.Mutex
+	r.locales = data.Locales
+}
+
+// ModifyPlan validates the locales of the localized strings when
+// validate_locales is enabled.
+func (r *Resource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	resp.Diagnostics.Append(r.locales.ValidatePlan(ctx, req.Plan)...)
 }
 
 // Create creates the resource and sets the initial Terraform state.
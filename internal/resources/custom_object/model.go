@@ -0,0 +1,85 @@
+package custom_object
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/labd/commercetools-go-sdk/platform"
+
+	"github.com/labd/terraform-provider-commercetools/internal/customtypes"
+	"github.com/labd/terraform-provider-commercetools/internal/utils"
+)
+
+type CustomObject struct {
+	ID         types.String          `tfsdk:"id"`
+	Container  types.String          `tfsdk:"container"`
+	Key        types.String          `tfsdk:"key"`
+	Value      customtypes.JSONValue `tfsdk:"value"`
+	JSONSchema types.String          `tfsdk:"json_schema"`
+	Version    types.Int64           `tfsdk:"version"`
+}
+
+func NewCustomObjectFromNative(o *platform.CustomObject) (CustomObject, error) {
+	value, err := customtypes.NewJSONValue(o.Value)
+	if err != nil {
+		return CustomObject{}, err
+	}
+
+	return CustomObject{
+		ID:         types.StringValue(o.ID),
+		Container:  types.StringValue(o.Container),
+		Key:        types.StringValue(o.Key),
+		Value:      value,
+		JSONSchema: types.StringNull(),
+		Version:    types.Int64Value(int64(o.Version)),
+	}, nil
+}
+
+func (c CustomObject) draft() (platform.CustomObjectDraft, error) {
+	value, err := c.Value.Decode()
+	if err != nil {
+		return platform.CustomObjectDraft{}, err
+	}
+
+	return platform.CustomObjectDraft{
+		Container: c.Container.ValueString(),
+		Key:       c.Key.ValueString(),
+		Value:     value,
+	}, nil
+}
+
+// moved returns true if the object is stored under another container or key
+// in the plan.
+func (c CustomObject) moved(plan CustomObject) bool {
+	return !c.Container.Equal(plan.Container) || !c.Key.Equal(plan.Key)
+}
+
+// valueChanged returns true if the value of the plan is a different JSON
+// document than the value of the state.
+func (c CustomObject) valueChanged(plan CustomObject) bool {
+	current, err := c.Value.Decode()
+	if err != nil {
+		return true
+	}
+	planned, err := plan.Value.Decode()
+	if err != nil {
+		return true
+	}
+	return !customtypes.JSONEqual(current, planned)
+}
+
+// validateValue validates the value against the JSON schema. Nothing is
+// validated if either of them is not known yet.
+func (c CustomObject) validateValue() ([]string, error) {
+	if c.JSONSchema.IsNull() || c.JSONSchema.IsUnknown() {
+		return nil, nil
+	}
+	schema, err := utils.ParseJSONSchema(c.JSONSchema.ValueString())
+	if err != nil {
+		return nil, err
+	}
+
+	value, err := c.Value.Decode()
+	if err != nil {
+		return nil, nil
+	}
+	return utils.ValidateJSONSchema(schema, value), nil
+}
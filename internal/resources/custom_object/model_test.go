@@ -0,0 +1,149 @@
+package custom_object
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/labd/commercetools-go-sdk/platform"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/labd/terraform-provider-commercetools/internal/customtypes"
+)
+
+func jsonValue(value attr.Value) customtypes.JSONValue {
+	return customtypes.JSONValue{DynamicValue: basetypes.NewDynamicValue(value)}
+}
+
+var objectValue = jsonValue(types.ObjectValueMust(
+	map[string]attr.Type{"number": types.NumberType, "enabled": types.BoolType},
+	map[string]attr.Value{"number": types.NumberValue(big.NewFloat(10)), "enabled": types.BoolValue(true)},
+))
+
+func TestNewCustomObjectFromNative(t *testing.T) {
+	res, err := NewCustomObjectFromNative(&platform.CustomObject{
+		ID:        "d9ec7ba4-4ae0-4e3b-a8df-3f8b5e1a2c90",
+		Version:   3,
+		Container: "flags",
+		Key:       "checkout",
+		Value:     map[string]any{"enabled": true, "number": 10.0},
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, types.StringValue("d9ec7ba4-4ae0-4e3b-a8df-3f8b5e1a2c90"), res.ID)
+	assert.Equal(t, types.StringValue("flags"), res.Container)
+	assert.Equal(t, types.StringValue("checkout"), res.Key)
+	assert.Equal(t, types.Int64Value(3), res.Version)
+	assert.True(t, res.JSONSchema.IsNull())
+
+	value, err := res.Value.Decode()
+	require.NoError(t, err)
+	assert.Equal(t, map[string]any{"enabled": true, "number": json.Number("10")}, value)
+}
+
+func TestCustomObjectDraft(t *testing.T) {
+	testCases := []struct {
+		name     string
+		value    customtypes.JSONValue
+		expected any
+	}{
+		{
+			name:     "object",
+			value:    objectValue,
+			expected: map[string]any{"enabled": true, "number": json.Number("10")},
+		},
+		{
+			name:     "JSON encoded string",
+			value:    jsonValue(types.StringValue(`{"number":10}`)),
+			expected: map[string]any{"number": json.Number("10")},
+		},
+		{
+			name:     "scalar",
+			value:    jsonValue(types.NumberValue(big.NewFloat(20))),
+			expected: json.Number("20"),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			c := CustomObject{
+				Container: types.StringValue("flags"),
+				Key:       types.StringValue("checkout"),
+				Value:     tc.value,
+			}
+			draft, err := c.draft()
+			require.NoError(t, err)
+			assert.Equal(t, platform.CustomObjectDraft{
+				Container: "flags",
+				Key:       "checkout",
+				Value:     tc.expected,
+			}, draft)
+
+			data, err := json.Marshal(draft)
+			require.NoError(t, err)
+			assert.NotEmpty(t, data)
+		})
+	}
+}
+
+func TestCustomObjectChanges(t *testing.T) {
+	state := CustomObject{
+		Container: types.StringValue("flags"),
+		Key:       types.StringValue("checkout"),
+		Value:     jsonValue(types.StringValue(`{"number": 10.0, "enabled": true}`)),
+	}
+
+	plan := state
+	plan.Value = objectValue
+	assert.False(t, state.moved(plan))
+	assert.False(t, state.valueChanged(plan))
+
+	plan.Value = jsonValue(types.StringValue(`{"number": 11, "enabled": true}`))
+	assert.True(t, state.valueChanged(plan))
+
+	plan.Value = customtypes.NewJSONUnknown()
+	assert.True(t, state.valueChanged(plan))
+
+	plan = state
+	plan.Key = types.StringValue("search")
+	assert.True(t, state.moved(plan))
+}
+
+func TestCustomObjectValidateValue(t *testing.T) {
+	c := CustomObject{
+		Value:      objectValue,
+		JSONSchema: types.StringValue(`{"type": "object", "required": ["enabled", "percentage"]}`),
+	}
+	problems, err := c.validateValue()
+	require.NoError(t, err)
+	assert.Equal(t, []string{`value: missing required property "percentage"`}, problems)
+
+	c.Value = customtypes.NewJSONUnknown()
+	problems, err = c.validateValue()
+	require.NoError(t, err)
+	assert.Empty(t, problems)
+
+	c.JSONSchema = types.StringValue(`{"$ref": "#/$defs/config"}`)
+	_, err = c.validateValue()
+	assert.ErrorContains(t, err, `unsupported keyword "$ref"`)
+}
+
+func TestUpgradeDataV0(t *testing.T) {
+	prior := CustomObjectV0{
+		ID:        types.StringValue("d9ec7ba4-4ae0-4e3b-a8df-3f8b5e1a2c90"),
+		Container: types.StringValue("flags"),
+		Key:       types.StringValue("checkout"),
+		Value:     types.StringValue(`{"number":10}`),
+		Version:   types.Int64Value(2),
+	}
+
+	res := upgradeDataV0(prior)
+	assert.Equal(t, prior.ID, res.ID)
+	assert.Equal(t, prior.Version, res.Version)
+	assert.True(t, res.JSONSchema.IsNull())
+	assert.Equal(t, jsonValue(types.StringValue(`{"number":10}`)), res.Value)
+}
@@ -0,0 +1,417 @@
+package custom_object
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/labd/commercetools-go-sdk/history"
+	"github.com/labd/commercetools-go-sdk/platform"
+
+	"github.com/labd/terraform-provider-commercetools/internal/customtypes"
+	"github.com/labd/terraform-provider-commercetools/internal/customvalidator"
+	"github.com/labd/terraform-provider-commercetools/internal/utils"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &customObjectResource{}
+	_ resource.ResourceWithConfigure      = &customObjectResource{}
+	_ resource.ResourceWithImportState    = &customObjectResource{}
+	_ resource.ResourceWithModifyPlan     = &customObjectResource{}
+	_ resource.ResourceWithValidateConfig = &customObjectResource{}
+	_ resource.ResourceWithUpgradeState   = &customObjectResource{}
+)
+
+// NewResource is a helper function to simplify the provider implementation.
+func NewResource() resource.Resource {
+	return &customObjectResource{}
+}
+
+// customObjectResource is the resource implementation.
+type customObjectResource struct {
+	client  *platform.ByProjectKeyRequestBuilder
+	history *history.ByProjectKeyRequestBuilder
+	mutex   *utils.MutexKV
+}
+
+// Metadata returns the resource type name.
+func (r *customObjectResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_custom_object"
+}
+
+// Schema defines the schema for the resource.
+func (r *customObjectResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Custom objects are a way to store arbitrary JSON-formatted data on the commercetools platform. " +
+			"It allows you to persist data that does not fit the standard data model. This frees your application " +
+			"completely from any third-party persistence solution and means that all your data stays on the " +
+			"commercetools platform. Existing custom objects can be imported with the ID `<container>/<key>`.\n\n" +
+			"See also the [Custom Object API Documentation](https://docs.commercetools.com/api/projects/custom-objects)",
+		Version: 1,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"container": schema.StringAttribute{
+				Description: "A namespace to group custom objects matching the pattern '[-_~.a-zA-Z0-9]+'",
+				Required:    true,
+			},
+			"key": schema.StringAttribute{
+				Description: "String matching the pattern '[-_~.a-zA-Z0-9]+'",
+				Required:    true,
+			},
+			"value": schema.DynamicAttribute{
+				MarkdownDescription: "The value of the custom object, which can be any HCL value: an object, " +
+					"a list, a string, a number or a boolean. Values are compared as JSON documents, so the order " +
+					"of keys and the formatting of numbers do not cause a difference. For backwards compatibility " +
+					"a string containing a JSON document, for example the result of `jsonencode()`, is stored as " +
+					"the decoded document",
+				Required:   true,
+				CustomType: customtypes.JSONType{},
+			},
+			"json_schema": schema.StringAttribute{
+				MarkdownDescription: "A [JSON Schema](https://json-schema.org/) the value is validated against " +
+					"when planning, for example `jsonencode({ type = \"object\", required = [\"enabled\"] })`. " +
+					"The keywords `type`, `enum`, `const`, `properties`, `required`, `additionalProperties`, " +
+					"`minProperties`, `maxProperties`, `items`, `minItems`, `maxItems`, `uniqueItems`, " +
+					"`minLength`, `maxLength`, `pattern`, `minimum`, `maximum`, `exclusiveMinimum`, " +
+					"`exclusiveMaximum`, `multipleOf`, `allOf`, `anyOf`, `oneOf` and `not` are supported. " +
+					"References (`$ref`) are not supported. The schema is not stored in commercetools",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+					customvalidator.JSON(),
+				},
+			},
+			"version": schema.Int64Attribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *customObjectResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	data := req.ProviderData.(*utils.ProviderData)
+	r.client = data.Client
+	r.history = data.DriftReporter()
+	r.mutex = data.Mutex
+}
+
+// UpgradeState implements resource.ResourceWithUpgradeState.
+func (r *customObjectResource) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: {
+			PriorSchema:   &CustomObjectResourceV0,
+			StateUpgrader: upgradeStateV0,
+		},
+	}
+}
+
+// ValidateConfig validates the value against the JSON schema.
+func (r *customObjectResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config CustomObject
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	problems, err := config.validateValue()
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("json_schema"),
+			"Invalid JSON schema",
+			err.Error(),
+		)
+		return
+	}
+	for _, problem := range problems {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("value"),
+			"Value does not match the JSON schema",
+			problem,
+		)
+	}
+}
+
+// ModifyPlan marks the id and version as unknown when the custom object is
+// changed. Changes to the value which result in the same JSON document, for
+// example when switching from jsonencode() to an HCL object, do not change the
+// custom object.
+func (r *customObjectResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to do on create and destroy
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, state CustomObject
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	switch {
+	case state.moved(plan):
+		plan.ID = types.StringUnknown()
+		plan.Version = types.Int64Unknown()
+	case state.valueChanged(plan):
+		plan.Version = types.Int64Unknown()
+	default:
+		return
+	}
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, plan)...)
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *customObjectResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan CustomObject
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	res, err := r.post(ctx, plan, nil)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating custom object",
+			"Could not create custom object, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(res.ID)
+	plan.Version = types.Int64Value(int64(res.Version))
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *customObjectResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state CustomObject
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	res, err := r.client.CustomObjects().
+		WithContainerAndKey(state.Container.ValueString(), state.Key.ValueString()).
+		Get().
+		Execute(ctx)
+	if err != nil {
+		if utils.IsResourceNotFoundError(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Error reading custom object",
+			"Could not retrieve custom object, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	current, err := NewCustomObjectFromNative(res)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading custom object",
+			"Could not convert the value of the custom object: "+err.Error(),
+		)
+		return
+	}
+	current.JSONSchema = state.JSONSchema
+	resp.Diagnostics.Append(utils.LastModifiedByWarning(
+		ctx, r.history, history.ChangeHistoryResourceTypeKeyValueDocument,
+		state.ID.ValueString(), state.Version, current.Version,
+	)...)
+
+	diags = resp.State.Set(ctx, current)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *customObjectResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan CustomObject
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state CustomObject
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	switch {
+	case state.moved(plan):
+		// If the container or key has changed we need to delete the old
+		// object and create the new object. We first create the new object
+		// and then delete the old one
+		res, err := r.post(ctx, plan, nil)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error updating custom object",
+				"Could not create custom object, unexpected error: "+err.Error(),
+			)
+			return
+		}
+		plan.ID = types.StringValue(res.ID)
+		plan.Version = types.Int64Value(int64(res.Version))
+
+		err = retry.RetryContext(ctx, 20*time.Second, func() *retry.RetryError {
+			_, err := r.client.CustomObjects().
+				WithContainerAndKey(state.Container.ValueString(), state.Key.ValueString()).
+				Delete().
+				Version(int(state.Version.ValueInt64())).
+				DataErasure(true).
+				Execute(ctx)
+			return utils.ProcessRemoteError(err)
+		})
+		if err != nil {
+			// Store the new object so it is not created again
+			resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+			resp.Diagnostics.AddError(
+				"Error updating custom object",
+				"Could not delete the previous custom object, unexpected error: "+err.Error(),
+			)
+			return
+		}
+
+	case state.valueChanged(plan):
+		// Update the value by creating an object with the same key. The
+		// value of the existing object is then replaced
+		res, err := r.post(ctx, plan, utils.IntRef(int(state.Version.ValueInt64())))
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error updating custom object",
+				"Could not update custom object, unexpected error: "+err.Error(),
+			)
+			return
+		}
+		plan.ID = types.StringValue(res.ID)
+		plan.Version = types.Int64Value(int64(res.Version))
+
+	default:
+		plan.ID = state.ID
+		plan.Version = state.Version
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *customObjectResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state CustomObject
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	container := state.Container.ValueString()
+	key := state.Key.ValueString()
+
+	// Lock to prevent concurrent updates due to Version number conflicts
+	r.mutex.Lock(state.ID.ValueString())
+	defer r.mutex.Unlock(state.ID.ValueString())
+
+	res, err := r.client.CustomObjects().WithContainerAndKey(container, key).Get().Execute(ctx)
+	if err != nil {
+		if utils.IsResourceNotFoundError(err) {
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error deleting custom object",
+			fmt.Sprintf("Could not get custom object with container %s and key %s, unexpected error: %s",
+				container, key, err),
+		)
+		return
+	}
+
+	err = retry.RetryContext(ctx, 20*time.Second, func() *retry.RetryError {
+		_, err := r.client.CustomObjects().
+			WithContainerAndKey(container, key).
+			Delete().
+			Version(res.Version).
+			DataErasure(false).
+			Execute(ctx)
+		return utils.ProcessRemoteError(err)
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting custom object",
+			fmt.Sprintf("Could not delete custom object with container %s and key %s, unexpected error: %s",
+				container, key, err),
+		)
+		return
+	}
+}
+
+// ImportState imports the custom object by its container and key, separated
+// by a slash, for example `my-container/my-key`.
+func (r *customObjectResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	container, key, ok := strings.Cut(req.ID, "/")
+	if !ok || container == "" || key == "" {
+		resp.Diagnostics.AddError(
+			"Invalid import ID",
+			fmt.Sprintf("Expected an import ID in the format <container>/<key>, got %q", req.ID),
+		)
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("container"), container)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("key"), key)...)
+}
+
+func (r *customObjectResource) post(ctx context.Context, plan CustomObject, version *int) (*platform.CustomObject, error) {
+	draft, err := plan.draft()
+	if err != nil {
+		return nil, err
+	}
+	draft.Version = version
+
+	var res *platform.CustomObject
+	err = retry.RetryContext(ctx, 20*time.Second, func() *retry.RetryError {
+		var err error
+		res, err = r.client.CustomObjects().Post(draft).Execute(ctx)
+		return utils.ProcessRemoteError(err)
+	})
+	return res, err
+}
@@ -0,0 +1,180 @@
+package custom_object_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/labd/terraform-provider-commercetools/internal/acctest"
+	"github.com/labd/terraform-provider-commercetools/internal/utils"
+)
+
+func TestAccCustomObjectCreate_basic(t *testing.T) {
+	rn := "commercetools_custom_object.test_number"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCustomObjectDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCustomObjectConfig("test_number", "foobar", "value", "jsonencode({ number = 10 })"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(rn, "container", "foobar"),
+					resource.TestCheckResourceAttr(rn, "key", "value"),
+					resource.TestCheckResourceAttr(rn, "value", "{\"number\":10}"),
+					resource.TestCheckResourceAttr(rn, "version", "1"),
+				),
+			},
+			{
+				Config: testAccCustomObjectConfig("test_number", "foobar", "value", "jsonencode({ number = 20 })"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(rn, "value", "{\"number\":20}"),
+					resource.TestCheckResourceAttr(rn, "version", "2"),
+				),
+			},
+			{
+				// The same document as an HCL object doesn't change the
+				// custom object
+				Config: testAccCustomObjectConfig("test_number", "foobar", "value", "{ number = 20 }"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(rn, "value.number", "20"),
+					resource.TestCheckResourceAttr(rn, "version", "2"),
+				),
+			},
+			{
+				Config: testAccCustomObjectConfig("test_number", "foobar", "newvalue", "{ number = 20 }"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(rn, "container", "foobar"),
+					resource.TestCheckResourceAttr(rn, "key", "newvalue"),
+					resource.TestCheckResourceAttr(rn, "value.number", "20"),
+					resource.TestCheckResourceAttr(rn, "version", "1"),
+				),
+			},
+			{
+				Config: testAccCustomObjectConfig("test_number", "newbar", "newvalue", "{ number = 20 }"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(rn, "container", "newbar"),
+					resource.TestCheckResourceAttr(rn, "key", "newvalue"),
+					resource.TestCheckResourceAttr(rn, "value.number", "20"),
+					resource.TestCheckResourceAttr(rn, "version", "1"),
+				),
+			},
+			{
+				Config: testAccCustomObjectConfig("scalar_value", "foobar", "somekey1", "20"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("commercetools_custom_object.scalar_value", "key", "somekey1"),
+					resource.TestCheckResourceAttr("commercetools_custom_object.scalar_value", "value", "20"),
+					resource.TestCheckResourceAttr("commercetools_custom_object.scalar_value", "version", "1"),
+				),
+			},
+			{
+				ResourceName:      "commercetools_custom_object.scalar_value",
+				ImportState:       true,
+				ImportStateId:     "foobar/somekey1",
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccCustomObjectCreate_object(t *testing.T) {
+	rn := "commercetools_custom_object.test_nested"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCustomObjectDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCustomObjectConfig("test_nested", "foobar", "nested", `{
+					address = {
+						street = "foo"
+						number = 10
+					}
+					user = {
+						name = "John"
+						last_name = "Smith"
+					}
+					tags = ["a", "b"]
+				}`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(rn, "key", "nested"),
+					resource.TestCheckResourceAttr(rn, "value.address.street", "foo"),
+					resource.TestCheckResourceAttr(rn, "value.address.number", "10"),
+					resource.TestCheckResourceAttr(rn, "value.user.last_name", "Smith"),
+					resource.TestCheckResourceAttr(rn, "value.tags.#", "2"),
+					resource.TestCheckResourceAttr(rn, "version", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCustomObjectCreate_jsonSchema(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCustomObjectDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: utils.HCLTemplate(`
+					resource "commercetools_custom_object" "flag" {
+						container = "foobar"
+						key       = "flag"
+						value     = { enabled = "yes" }
+						json_schema = jsonencode({
+							type     = "object"
+							required = ["enabled"]
+							properties = {
+								enabled = { type = "boolean" }
+							}
+						})
+					}
+				`, map[string]any{}),
+				ExpectError: regexp.MustCompile(`value/enabled: expected boolean, got string`),
+			},
+		},
+	})
+}
+
+func testAccCustomObjectConfig(name, container, key, value string) string {
+	return utils.HCLTemplate(`
+		resource "commercetools_custom_object" "{{ .name }}" {
+			container = "{{ .container }}"
+			key       = "{{ .key }}"
+			value     = {{ .value }}
+		}`,
+		map[string]any{
+			"name":      name,
+			"container": container,
+			"key":       key,
+			"value":     value,
+		})
+}
+
+func testAccCheckCustomObjectDestroy(s *terraform.State) error {
+	client, err := acctest.GetClient()
+	if err != nil {
+		return err
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "commercetools_custom_object" {
+			continue
+		}
+		container := rs.Primary.Attributes["container"]
+		response, err := client.CustomObjects().WithContainer(container).Get().Execute(context.Background())
+		if err != nil {
+			return err
+		}
+		if response.Count > 0 {
+			return fmt.Errorf("custom object container (%s) still exists", container)
+		}
+	}
+	return nil
+}
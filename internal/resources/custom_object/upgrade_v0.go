@@ -0,0 +1,56 @@
+package custom_object
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+
+	"github.com/labd/terraform-provider-commercetools/internal/customtypes"
+)
+
+// CustomObjectResourceV0 is the schema of the resource when it was part of the
+// SDK provider. The value was stored as a JSON encoded string.
+var CustomObjectResourceV0 = schema.Schema{
+	Attributes: map[string]schema.Attribute{
+		"id":        schema.StringAttribute{Computed: true},
+		"container": schema.StringAttribute{Required: true},
+		"key":       schema.StringAttribute{Required: true},
+		"value":     schema.StringAttribute{Required: true},
+		"version":   schema.Int64Attribute{Computed: true},
+	},
+}
+
+type CustomObjectV0 struct {
+	ID        types.String `tfsdk:"id"`
+	Container types.String `tfsdk:"container"`
+	Key       types.String `tfsdk:"key"`
+	Value     types.String `tfsdk:"value"`
+	Version   types.Int64  `tfsdk:"version"`
+}
+
+// Upgrade from V0 to V1. The JSON encoded string is kept as is, since it is
+// equal to the decoded document.
+func upgradeStateV0(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+	var prior CustomObjectV0
+	resp.Diagnostics.Append(req.State.Get(ctx, &prior)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	upgraded := upgradeDataV0(prior)
+	resp.Diagnostics.Append(resp.State.Set(ctx, upgraded)...)
+}
+
+func upgradeDataV0(prior CustomObjectV0) CustomObject {
+	return CustomObject{
+		ID:         prior.ID,
+		Container:  prior.Container,
+		Key:        prior.Key,
+		Value:      customtypes.JSONValue{DynamicValue: basetypes.NewDynamicValue(prior.Value)},
+		JSONSchema: types.StringNull(),
+		Version:    prior.Version,
+	}
+}
@@ -0,0 +1,143 @@
+package custom_object_container
+
+import (
+	"encoding/json"
+	"reflect"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/labd/commercetools-go-sdk/platform"
+)
+
+// CustomObjectContainer represents the main schema data. The values are JSON
+// encoded, the versions are tracked per key.
+type CustomObjectContainer struct {
+	ID        types.String            `tfsdk:"id"`
+	Container types.String            `tfsdk:"container"`
+	Values    map[string]types.String `tfsdk:"values"`
+	Versions  types.Map               `tfsdk:"versions"`
+	Exclusive types.Bool              `tfsdk:"exclusive"`
+}
+
+// NewCustomObjectContainerFromNative creates the container from the remote
+// objects. Only the keys managed in the state are included, unless the
+// container is exclusive or imported, in which case all keys are included.
+// Values which are equal to the state apart from formatting keep the state
+// value.
+func NewCustomObjectContainerFromNative(state CustomObjectContainer, objects []platform.CustomObject) (CustomObjectContainer, error) {
+	all := state.Values == nil || state.Exclusive.ValueBool()
+
+	values := map[string]types.String{}
+	versions := map[string]attr.Value{}
+	for _, o := range objects {
+		current, managed := state.Values[o.Key]
+		if !managed && !all {
+			continue
+		}
+
+		data, err := json.Marshal(o.Value)
+		if err != nil {
+			return CustomObjectContainer{}, err
+		}
+		value := types.StringValue(string(data))
+		if managed && jsonEqual(current.ValueString(), value.ValueString()) {
+			value = current
+		}
+
+		values[o.Key] = value
+		versions[o.Key] = types.Int64Value(int64(o.Version))
+	}
+
+	return CustomObjectContainer{
+		ID:        state.Container,
+		Container: state.Container,
+		Values:    values,
+		Versions:  types.MapValueMust(types.Int64Type, versions),
+		Exclusive: state.Exclusive,
+	}, nil
+}
+
+// version returns the tracked version of the key, or nil if the key is not
+// tracked yet.
+func (c CustomObjectContainer) version(key string) *int {
+	if c.Versions.IsNull() || c.Versions.IsUnknown() {
+		return nil
+	}
+	value, ok := c.Versions.Elements()[key].(types.Int64)
+	if !ok || value.IsNull() || value.IsUnknown() {
+		return nil
+	}
+	version := int(value.ValueInt64())
+	return &version
+}
+
+// drafts returns the drafts for the keys which are new or have a different
+// value than the state. Existing keys include the version of the state, so
+// changes made outside of Terraform are not overwritten.
+func (c CustomObjectContainer) drafts(plan CustomObjectContainer) []platform.CustomObjectDraft {
+	var result []platform.CustomObjectDraft
+	for _, key := range sortedKeys(plan.Values) {
+		value := plan.Values[key].ValueString()
+		if current, ok := c.Values[key]; ok && jsonEqual(current.ValueString(), value) {
+			continue
+		}
+
+		var data any
+		_ = json.Unmarshal([]byte(value), &data)
+		result = append(result, platform.CustomObjectDraft{
+			Container: plan.Container.ValueString(),
+			Key:       key,
+			Value:     data,
+			Version:   c.version(key),
+		})
+	}
+	return result
+}
+
+// removed returns the keys of the state which are not in the plan.
+func (c CustomObjectContainer) removed(plan CustomObjectContainer) []string {
+	var result []string
+	for _, key := range sortedKeys(c.Values) {
+		if _, ok := plan.Values[key]; !ok {
+			result = append(result, key)
+		}
+	}
+	return result
+}
+
+// unmanaged returns the remote objects which are not in the plan nor in the
+// state.
+func (c CustomObjectContainer) unmanaged(plan CustomObjectContainer, objects []platform.CustomObject) []platform.CustomObject {
+	var result []platform.CustomObject
+	for _, o := range objects {
+		_, planned := plan.Values[o.Key]
+		_, managed := c.Values[o.Key]
+		if !planned && !managed {
+			result = append(result, o)
+		}
+	}
+	return result
+}
+
+// jsonEqual returns true if both values are the same JSON document, apart
+// from formatting.
+func jsonEqual(a, b string) bool {
+	var va, vb any
+	if err := json.Unmarshal([]byte(a), &va); err != nil {
+		return a == b
+	}
+	if err := json.Unmarshal([]byte(b), &vb); err != nil {
+		return a == b
+	}
+	return reflect.DeepEqual(va, vb)
+}
+
+func sortedKeys[T any](m map[string]T) []string {
+	result := make([]string, 0, len(m))
+	for k := range m {
+		result = append(result, k)
+	}
+	sort.Strings(result)
+	return result
+}
@@ -0,0 +1,138 @@
+package custom_object_container
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/labd/commercetools-go-sdk/platform"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/labd/terraform-provider-commercetools/internal/utils"
+)
+
+var remoteObjects = []platform.CustomObject{
+	{Container: "flags", Key: "checkout", Version: 3, Value: map[string]any{"enabled": true}},
+	{Container: "flags", Key: "search", Version: 1, Value: "v2"},
+	{Container: "flags", Key: "unmanaged", Version: 7, Value: 42.0},
+}
+
+func versions(values map[string]int64) types.Map {
+	elements := map[string]attr.Value{}
+	for k, v := range values {
+		elements[k] = types.Int64Value(v)
+	}
+	return types.MapValueMust(types.Int64Type, elements)
+}
+
+func TestNewCustomObjectContainerFromNative(t *testing.T) {
+	testCases := []struct {
+		name     string
+		state    CustomObjectContainer
+		expected CustomObjectContainer
+	}{
+		{
+			name: "managed keys",
+			state: CustomObjectContainer{
+				Container: types.StringValue("flags"),
+				Values: map[string]types.String{
+					"checkout": types.StringValue(`{ "enabled": true }`),
+					"search":   types.StringValue(`"v1"`),
+					"removed":  types.StringValue(`1`),
+				},
+			},
+			expected: CustomObjectContainer{
+				ID:        types.StringValue("flags"),
+				Container: types.StringValue("flags"),
+				Values: map[string]types.String{
+					"checkout": types.StringValue(`{ "enabled": true }`),
+					"search":   types.StringValue(`"v2"`),
+				},
+				Versions: versions(map[string]int64{"checkout": 3, "search": 1}),
+			},
+		},
+		{
+			name: "exclusive",
+			state: CustomObjectContainer{
+				Container: types.StringValue("flags"),
+				Values: map[string]types.String{
+					"checkout": types.StringValue(`{"enabled":true}`),
+				},
+				Exclusive: types.BoolValue(true),
+			},
+			expected: CustomObjectContainer{
+				ID:        types.StringValue("flags"),
+				Container: types.StringValue("flags"),
+				Values: map[string]types.String{
+					"checkout":  types.StringValue(`{"enabled":true}`),
+					"search":    types.StringValue(`"v2"`),
+					"unmanaged": types.StringValue(`42`),
+				},
+				Versions:  versions(map[string]int64{"checkout": 3, "search": 1, "unmanaged": 7}),
+				Exclusive: types.BoolValue(true),
+			},
+		},
+		{
+			name: "import",
+			state: CustomObjectContainer{
+				Container: types.StringValue("flags"),
+			},
+			expected: CustomObjectContainer{
+				ID:        types.StringValue("flags"),
+				Container: types.StringValue("flags"),
+				Values: map[string]types.String{
+					"checkout":  types.StringValue(`{"enabled":true}`),
+					"search":    types.StringValue(`"v2"`),
+					"unmanaged": types.StringValue(`42`),
+				},
+				Versions: versions(map[string]int64{"checkout": 3, "search": 1, "unmanaged": 7}),
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			result, err := NewCustomObjectContainerFromNative(tc.state, remoteObjects)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, result)
+		})
+	}
+}
+
+func TestCustomObjectContainerChanges(t *testing.T) {
+	state := CustomObjectContainer{
+		Container: types.StringValue("flags"),
+		Values: map[string]types.String{
+			"checkout": types.StringValue(`{"enabled":true}`),
+			"search":   types.StringValue(`"v2"`),
+			"legacy":   types.StringValue(`true`),
+		},
+		Versions: versions(map[string]int64{"checkout": 3, "search": 1, "legacy": 2}),
+	}
+	plan := CustomObjectContainer{
+		Container: types.StringValue("flags"),
+		Values: map[string]types.String{
+			"checkout": types.StringValue(`{ "enabled": true }`),
+			"search":   types.StringValue(`"v3"`),
+			"new":      types.StringValue(`[1, 2]`),
+		},
+		Versions: types.MapUnknown(types.Int64Type),
+	}
+
+	assert.Equal(t, []platform.CustomObjectDraft{
+		{Container: "flags", Key: "new", Value: []any{1.0, 2.0}},
+		{Container: "flags", Key: "search", Value: "v3", Version: utils.IntRef(1)},
+	}, state.drafts(plan))
+
+	assert.Equal(t, []string{"legacy"}, state.removed(plan))
+
+	assert.Equal(t, []platform.CustomObject{remoteObjects[2]}, state.unmanaged(plan, remoteObjects))
+}
+
+func TestJSONEqual(t *testing.T) {
+	assert.True(t, jsonEqual(`{"a": 1, "b": [true]}`, `{"b":[true],"a":1}`))
+	assert.False(t, jsonEqual(`{"a": 1}`, `{"a": 2}`))
+	assert.True(t, jsonEqual(`not json`, `not json`))
+	assert.False(t, jsonEqual(`not json`, `"not json"`))
+}
@@ -0,0 +1,320 @@
+package custom_object_container
+
+import (
+	"context"
+	"regexp"
+	"time"
+
+	"github.com/elliotchance/pie/v2"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/labd/commercetools-go-sdk/platform"
+
+	"github.com/labd/terraform-provider-commercetools/internal/customvalidator"
+	"github.com/labd/terraform-provider-commercetools/internal/utils"
+)
+
+var (
+	_ resource.Resource                = &customObjectContainerResource{}
+	_ resource.ResourceWithConfigure   = &customObjectContainerResource{}
+	_ resource.ResourceWithImportState = &customObjectContainerResource{}
+)
+
+// pageSize is the number of custom objects which are retrieved per request.
+const pageSize = 500
+
+var namePattern = regexp.MustCompile("^[-_~.a-zA-Z0-9]+$")
+
+type customObjectContainerResource struct {
+	client *platform.ByProjectKeyRequestBuilder
+}
+
+// NewResource is a helper function to simplify the provider implementation.
+func NewResource() resource.Resource {
+	return &customObjectContainerResource{}
+}
+
+// Schema implements resource.Resource.
+func (*customObjectContainerResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages the custom objects of a container as a set, for example to store feature " +
+			"flags or storefront configuration. The objects are created, updated and deleted together and " +
+			"their versions are tracked per key, so changes made outside of Terraform are not overwritten. " +
+			"Use `commercetools_custom_object` to manage a single custom object.\n\n" +
+			"See also the [Custom Object API Documentation](https://docs.commercetools.com/api/projects/custom-objects)",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The name of the container",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"container": schema.StringAttribute{
+				Description: "A namespace to group custom objects matching the pattern '[-_~.a-zA-Z0-9]+'",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 256),
+					stringvalidator.RegexMatches(namePattern,
+						"Container can only contain alphanumeric characters and the characters -_~."),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"values": schema.MapAttribute{
+				MarkdownDescription: "The JSON encoded values of the custom objects by key, for example " +
+					"`jsonencode({ enabled = true })`. Keys match the pattern '[-_~.a-zA-Z0-9]+'",
+				Required:    true,
+				ElementType: types.StringType,
+				Validators: []validator.Map{
+					mapvalidator.KeysAre(
+						stringvalidator.LengthBetween(1, 256),
+						stringvalidator.RegexMatches(namePattern,
+							"Key can only contain alphanumeric characters and the characters -_~."),
+					),
+					mapvalidator.ValueStringsAre(customvalidator.JSON()),
+				},
+			},
+			"versions": schema.MapAttribute{
+				Description: "The current version of the custom objects by key",
+				Computed:    true,
+				ElementType: types.Int64Type,
+			},
+			"exclusive": schema.BoolAttribute{
+				MarkdownDescription: "When true the keys in the container which are not in `values` are " +
+					"removed. Otherwise these keys are left untouched. Defaults to `false`",
+				Optional: true,
+			},
+		},
+	}
+}
+
+// Metadata implements resource.Resource.
+func (*customObjectContainerResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_custom_object_container"
+}
+
+// Configure implements resource.ResourceWithConfigure.
+func (r *customObjectContainerResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	data := req.ProviderData.(*utils.ProviderData)
+	r.client = data.Client
+}
+
+// Create implements resource.Resource.
+func (r *customObjectContainerResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan CustomObjectContainer
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	current, err := r.apply(ctx, CustomObjectContainer{}, plan)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating custom object container",
+			err.Error(),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, current)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read implements resource.Resource.
+func (r *customObjectContainerResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state CustomObjectContainer
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	objects, err := r.objects(ctx, state.Container.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading custom object container",
+			"Could not retrieve the custom objects, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	current, err := NewCustomObjectContainerFromNative(state, objects)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading custom object container",
+			err.Error(),
+		)
+		return
+	}
+
+	// All objects have been removed outside of Terraform
+	if len(current.Values) == 0 && len(state.Values) > 0 {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	diags = resp.State.Set(ctx, current)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update implements resource.Resource.
+func (r *customObjectContainerResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan CustomObjectContainer
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state CustomObjectContainer
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	current, err := r.apply(ctx, state, plan)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating custom object container",
+			err.Error(),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, current)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete implements resource.Resource.
+func (r *customObjectContainerResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state CustomObjectContainer
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for _, key := range sortedKeys(state.Values) {
+		if err := r.delete(ctx, state.Container.ValueString(), key, state.version(key)); err != nil {
+			resp.Diagnostics.AddError(
+				"Error deleting custom object container",
+				"Could not delete custom object "+key+", unexpected error: "+err.Error(),
+			)
+			return
+		}
+	}
+}
+
+// ImportState implements resource.ResourceWithImportState. All keys of the
+// container are imported.
+func (*customObjectContainerResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("container"), req.ID)...)
+}
+
+// apply creates or updates the changed keys and deletes the removed keys.
+// When the container is exclusive the unmanaged keys are deleted as well.
+func (r *customObjectContainerResource) apply(ctx context.Context, state, plan CustomObjectContainer) (CustomObjectContainer, error) {
+	container := plan.Container.ValueString()
+
+	for _, draft := range state.drafts(plan) {
+		err := retry.RetryContext(ctx, 20*time.Second, func() *retry.RetryError {
+			_, err := r.client.CustomObjects().Post(draft).Execute(ctx)
+			return utils.ProcessRemoteError(err)
+		})
+		if err != nil {
+			return CustomObjectContainer{}, err
+		}
+	}
+
+	for _, key := range state.removed(plan) {
+		if err := r.delete(ctx, container, key, state.version(key)); err != nil {
+			return CustomObjectContainer{}, err
+		}
+	}
+
+	objects, err := r.objects(ctx, container)
+	if err != nil {
+		return CustomObjectContainer{}, err
+	}
+
+	if plan.Exclusive.ValueBool() {
+		for _, o := range state.unmanaged(plan, objects) {
+			version := o.Version
+			if err := r.delete(ctx, container, o.Key, &version); err != nil {
+				return CustomObjectContainer{}, err
+			}
+		}
+	}
+
+	managed := pie.Filter(objects, func(o platform.CustomObject) bool {
+		_, ok := plan.Values[o.Key]
+		return ok
+	})
+	current, err := NewCustomObjectContainerFromNative(plan, managed)
+	if err != nil {
+		return CustomObjectContainer{}, err
+	}
+	current.Values = plan.Values
+	return current, nil
+}
+
+// delete removes the custom object. Objects which are already removed are
+// ignored.
+func (r *customObjectContainerResource) delete(ctx context.Context, container, key string, version *int) error {
+	err := retry.RetryContext(ctx, 20*time.Second, func() *retry.RetryError {
+		req := r.client.CustomObjects().WithContainerAndKey(container, key).Delete()
+		if version != nil {
+			req = req.Version(*version)
+		}
+		_, err := req.Execute(ctx)
+		return utils.ProcessRemoteError(err)
+	})
+	if err != nil && !utils.IsResourceNotFoundError(err) {
+		return err
+	}
+	return nil
+}
+
+// objects returns all custom objects of the container.
+func (r *customObjectContainerResource) objects(ctx context.Context, container string) ([]platform.CustomObject, error) {
+	var result []platform.CustomObject
+	for {
+		page, err := r.client.CustomObjects().WithContainer(container).Get().
+			Sort([]string{"key asc"}).
+			Limit(pageSize).
+			Offset(len(result)).
+			Execute(ctx)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, page.Results...)
+		if len(page.Results) < pageSize {
+			return result, nil
+		}
+	}
+}
@@ -0,0 +1,128 @@
+package custom_object_container_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/labd/commercetools-go-sdk/platform"
+
+	"github.com/labd/terraform-provider-commercetools/internal/acctest"
+	"github.com/labd/terraform-provider-commercetools/internal/utils"
+)
+
+func TestCustomObjectContainerResource_Create(t *testing.T) {
+	rn := "commercetools_custom_object_container.flags"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testCustomObjectContainerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testCustomObjectContainerConfig(false, `
+					checkout = jsonencode({ enabled = true })
+					search   = jsonencode("v1")
+				`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(rn, "id", "terraform-flags"),
+					resource.TestCheckResourceAttr(rn, "values.%", "2"),
+					resource.TestCheckResourceAttr(rn, "values.checkout", `{"enabled":true}`),
+					resource.TestCheckResourceAttr(rn, "versions.checkout", "1"),
+					resource.TestCheckResourceAttr(rn, "versions.search", "1"),
+				),
+			},
+			{
+				PreConfig: func() {
+					client, err := acctest.GetClient()
+					if err != nil {
+						t.Fatal(err)
+					}
+					_, err = client.CustomObjects().Post(platform.CustomObjectDraft{
+						Container: "terraform-flags",
+						Key:       "unmanaged",
+						Value:     true,
+					}).Execute(context.Background())
+					if err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testCustomObjectContainerConfig(false, `
+					checkout = jsonencode({ enabled = false })
+				`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(rn, "values.%", "1"),
+					resource.TestCheckResourceAttr(rn, "versions.checkout", "2"),
+					testCustomObjectExists("unmanaged", true),
+					testCustomObjectExists("search", false),
+				),
+			},
+			{
+				Config: testCustomObjectContainerConfig(true, `
+					checkout = jsonencode({ enabled = false })
+				`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(rn, "exclusive", "true"),
+					testCustomObjectExists("unmanaged", false),
+				),
+			},
+		},
+	})
+}
+
+func testCustomObjectExists(key string, exists bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client, err := acctest.GetClient()
+		if err != nil {
+			return err
+		}
+		_, err = client.CustomObjects().WithContainerAndKey("terraform-flags", key).Get().Execute(context.Background())
+		switch {
+		case exists && err != nil:
+			return fmt.Errorf("custom object %s should exist: %w", key, err)
+		case !exists && err == nil:
+			return fmt.Errorf("custom object %s should not exist", key)
+		case !exists && !utils.IsResourceNotFoundError(err):
+			return err
+		}
+		return nil
+	}
+}
+
+func testCustomObjectContainerDestroy(s *terraform.State) error {
+	client, err := acctest.GetClient()
+	if err != nil {
+		return err
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "commercetools_custom_object_container" {
+			continue
+		}
+		response, err := client.CustomObjects().WithContainer(rs.Primary.ID).Get().Execute(context.Background())
+		if err != nil {
+			return err
+		}
+		if len(response.Results) > 0 {
+			return fmt.Errorf("custom object container %s still has objects", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testCustomObjectContainerConfig(exclusive bool, values string) string {
+	return utils.HCLTemplate(`
+		resource "commercetools_custom_object_container" "flags" {
+			container = "terraform-flags"
+			exclusive = {{ .exclusive }}
+			values = {
+				{{ .values }}
+			}
+		}
+	`, map[string]any{
+		"exclusive": exclusive,
+		"values":    values,
+	})
+}
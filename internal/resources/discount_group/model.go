@@ -0,0 +1,97 @@
+package discount_group
+
+import (
+	"reflect"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/labd/commercetools-go-sdk/platform"
+
+	"github.com/labd/terraform-provider-commercetools/internal/customtypes"
+	"github.com/labd/terraform-provider-commercetools/internal/utils"
+)
+
+// DiscountGroup is the main resource schema data
+type DiscountGroup struct {
+	ID          types.String                     `tfsdk:"id"`
+	Version     types.Int64                      `tfsdk:"version"`
+	Key         types.String                     `tfsdk:"key"`
+	Name        customtypes.LocalizedStringValue `tfsdk:"name"`
+	Description customtypes.LocalizedStringValue `tfsdk:"description"`
+	SortOrder   types.String                     `tfsdk:"sort_order"`
+	IsActive    types.Bool                       `tfsdk:"is_active"`
+}
+
+func NewDiscountGroupFromNative(n *remoteDiscountGroup) DiscountGroup {
+	return DiscountGroup{
+		ID:          types.StringValue(n.ID),
+		Version:     types.Int64Value(int64(n.Version)),
+		Key:         types.StringValue(n.Key),
+		Name:        utils.FromOptionalLocalizedString(n.Name),
+		Description: utils.FromOptionalLocalizedString(n.Description),
+		SortOrder:   types.StringValue(n.SortOrder),
+		IsActive:    types.BoolValue(n.IsActive),
+	}
+}
+
+func (d DiscountGroup) draft() discountGroupDraft {
+	return discountGroupDraft{
+		Key:         d.Key.ValueString(),
+		Name:        optionalLocalizedString(d.Name),
+		Description: optionalLocalizedString(d.Description),
+		SortOrder:   d.SortOrder.ValueString(),
+		IsActive:    d.IsActive.ValueBoolPointer(),
+	}
+}
+
+func (d DiscountGroup) updateActions(plan DiscountGroup) discountGroupUpdate {
+	result := discountGroupUpdate{
+		Version: int(d.Version.ValueInt64()),
+		Actions: []any{},
+	}
+
+	if !d.Key.Equal(plan.Key) {
+		result.Actions = append(
+			result.Actions,
+			DiscountGroupSetKeyAction{Key: plan.Key.ValueString()},
+		)
+	}
+
+	if !reflect.DeepEqual(d.Name, plan.Name) {
+		result.Actions = append(
+			result.Actions,
+			DiscountGroupSetNameAction{Name: optionalLocalizedString(plan.Name)},
+		)
+	}
+
+	if !reflect.DeepEqual(d.Description, plan.Description) {
+		result.Actions = append(
+			result.Actions,
+			DiscountGroupSetDescriptionAction{Description: optionalLocalizedString(plan.Description)},
+		)
+	}
+
+	if !d.SortOrder.Equal(plan.SortOrder) {
+		result.Actions = append(
+			result.Actions,
+			DiscountGroupSetSortOrderAction{SortOrder: plan.SortOrder.ValueString()},
+		)
+	}
+
+	if !d.IsActive.Equal(plan.IsActive) {
+		result.Actions = append(
+			result.Actions,
+			DiscountGroupSetIsActiveAction{IsActive: plan.IsActive.ValueBool()},
+		)
+	}
+
+	return result
+}
+
+// optionalLocalizedString returns nil for a null value, so the field is
+// omitted or unset.
+func optionalLocalizedString(value customtypes.LocalizedStringValue) *platform.LocalizedString {
+	if value.IsNull() || value.IsUnknown() {
+		return nil
+	}
+	return value.ValueLocalizedStringRef()
+}
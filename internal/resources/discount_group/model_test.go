@@ -0,0 +1,85 @@
+package discount_group
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/labd/commercetools-go-sdk/platform"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/labd/terraform-provider-commercetools/internal/customtypes"
+)
+
+func TestNewDiscountGroupFromNative(t *testing.T) {
+	res := NewDiscountGroupFromNative(&remoteDiscountGroup{
+		ID:        "8f4a2c1e-2f7b-4c3a-9d5e-1a2b3c4d5e6f",
+		Version:   2,
+		Key:       "brand-a",
+		Name:      &platform.LocalizedString{"en": "Brand A"},
+		SortOrder: "0.5",
+		IsActive:  true,
+	})
+
+	assert.Equal(t, DiscountGroup{
+		ID:      types.StringValue("8f4a2c1e-2f7b-4c3a-9d5e-1a2b3c4d5e6f"),
+		Version: types.Int64Value(2),
+		Key:     types.StringValue("brand-a"),
+		Name: customtypes.NewLocalizedStringValue(map[string]attr.Value{
+			"en": types.StringValue("Brand A"),
+		}),
+		Description: customtypes.NewLocalizedStringNull(),
+		SortOrder:   types.StringValue("0.5"),
+		IsActive:    types.BoolValue(true),
+	}, res)
+}
+
+func TestDiscountGroupDraft(t *testing.T) {
+	group := DiscountGroup{
+		Key:         types.StringValue("brand-a"),
+		Name:        customtypes.NewLocalizedStringNull(),
+		Description: customtypes.NewLocalizedStringNull(),
+		SortOrder:   types.StringValue("0.5"),
+		IsActive:    types.BoolValue(false),
+	}
+
+	data, err := json.Marshal(group.draft())
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"key": "brand-a", "sortOrder": "0.5", "isActive": false}`, string(data))
+}
+
+func TestDiscountGroupUpdateActions(t *testing.T) {
+	state := DiscountGroup{
+		Version: types.Int64Value(3),
+		Key:     types.StringValue("brand-a"),
+		Name: customtypes.NewLocalizedStringValue(map[string]attr.Value{
+			"en": types.StringValue("Brand A"),
+		}),
+		Description: customtypes.NewLocalizedStringNull(),
+		SortOrder:   types.StringValue("0.5"),
+		IsActive:    types.BoolValue(true),
+	}
+
+	assert.Empty(t, state.updateActions(state).Actions)
+
+	plan := state
+	plan.Key = types.StringValue("brand-b")
+	plan.Name = customtypes.NewLocalizedStringNull()
+	plan.SortOrder = types.StringValue("0.45")
+	plan.IsActive = types.BoolValue(false)
+
+	update := state.updateActions(plan)
+	data, err := json.Marshal(update)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{
+		"version": 3,
+		"actions": [
+			{"action": "setKey", "key": "brand-b"},
+			{"action": "setName"},
+			{"action": "setSortOrder", "sortOrder": "0.45"},
+			{"action": "setIsActive", "isActive": false}
+		]
+	}`, string(data))
+}
@@ -0,0 +1,96 @@
+package discount_group
+
+import (
+	"encoding/json"
+
+	"github.com/labd/commercetools-go-sdk/platform"
+)
+
+// Discount groups are not supported by the SDK yet, so the types below
+// describe the API resource. They can be removed once the SDK supports them.
+
+// remoteDiscountGroup is a discount group as returned by the API. A discount
+// group groups cart discounts, which are then applied with the sort order of
+// the group.
+type remoteDiscountGroup struct {
+	ID          string                    `json:"id"`
+	Version     int                       `json:"version"`
+	Key         string                    `json:"key"`
+	Name        *platform.LocalizedString `json:"name,omitempty"`
+	Description *platform.LocalizedString `json:"description,omitempty"`
+	SortOrder   string                    `json:"sortOrder"`
+	IsActive    bool                      `json:"isActive"`
+}
+
+type discountGroupDraft struct {
+	Key         string                    `json:"key"`
+	Name        *platform.LocalizedString `json:"name,omitempty"`
+	Description *platform.LocalizedString `json:"description,omitempty"`
+	SortOrder   string                    `json:"sortOrder"`
+	IsActive    *bool                     `json:"isActive,omitempty"`
+}
+
+type discountGroupUpdate struct {
+	Version int   `json:"version"`
+	Actions []any `json:"actions"`
+}
+
+type DiscountGroupSetKeyAction struct {
+	Key string `json:"key"`
+}
+
+func (obj DiscountGroupSetKeyAction) MarshalJSON() ([]byte, error) {
+	type Alias DiscountGroupSetKeyAction
+	return json.Marshal(struct {
+		Action string `json:"action"`
+		*Alias
+	}{Action: "setKey", Alias: (*Alias)(&obj)})
+}
+
+type DiscountGroupSetNameAction struct {
+	Name *platform.LocalizedString `json:"name,omitempty"`
+}
+
+func (obj DiscountGroupSetNameAction) MarshalJSON() ([]byte, error) {
+	type Alias DiscountGroupSetNameAction
+	return json.Marshal(struct {
+		Action string `json:"action"`
+		*Alias
+	}{Action: "setName", Alias: (*Alias)(&obj)})
+}
+
+type DiscountGroupSetDescriptionAction struct {
+	Description *platform.LocalizedString `json:"description,omitempty"`
+}
+
+func (obj DiscountGroupSetDescriptionAction) MarshalJSON() ([]byte, error) {
+	type Alias DiscountGroupSetDescriptionAction
+	return json.Marshal(struct {
+		Action string `json:"action"`
+		*Alias
+	}{Action: "setDescription", Alias: (*Alias)(&obj)})
+}
+
+type DiscountGroupSetSortOrderAction struct {
+	SortOrder string `json:"sortOrder"`
+}
+
+func (obj DiscountGroupSetSortOrderAction) MarshalJSON() ([]byte, error) {
+	type Alias DiscountGroupSetSortOrderAction
+	return json.Marshal(struct {
+		Action string `json:"action"`
+		*Alias
+	}{Action: "setSortOrder", Alias: (*Alias)(&obj)})
+}
+
+type DiscountGroupSetIsActiveAction struct {
+	IsActive bool `json:"isActive"`
+}
+
+func (obj DiscountGroupSetIsActiveAction) MarshalJSON() ([]byte, error) {
+	type Alias DiscountGroupSetIsActiveAction
+	return json.Marshal(struct {
+		Action string `json:"action"`
+		*Alias
+	}{Action: "setIsActive", Alias: (*Alias)(&obj)})
+}
@@ -0,0 +1,249 @@
+package discount_group
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+
+	"github.com/labd/terraform-provider-commercetools/internal/customtypes"
+	"github.com/labd/terraform-provider-commercetools/internal/utils"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &discountGroupResource{}
+	_ resource.ResourceWithConfigure   = &discountGroupResource{}
+	_ resource.ResourceWithImportState = &discountGroupResource{}
+	_ resource.ResourceWithModifyPlan  = &discountGroupResource{}
+)
+
+// SortOrderPattern matches a decimal number between 0 and 1, without trailing
+// zeros, as required for the sort order of discounts.
+var SortOrderPattern = regexp.MustCompile(`^0\.\d*[1-9]$`)
+
+// NewResource is a helper function to simplify the provider implementation.
+func NewResource() resource.Resource {
+	return &discountGroupResource{}
+}
+
+// discountGroupResource is the resource implementation.
+type discountGroupResource struct {
+	raw     *utils.RawClient
+	locales *utils.LocaleValidator
+}
+
+// Metadata returns the resource type name.
+func (r *discountGroupResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_discount_group"
+}
+
+// Schema defines the schema for the resource.
+func (r *discountGroupResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Discount Groups group Cart Discounts, so only the best Cart Discount of the group is " +
+			"applied to a cart. The Cart Discounts of a group are applied with the sort order of the group.\n\n" +
+			"See also the [Discount Group API Documentation](https://docs.commercetools.com/api/projects/discount-groups)",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Platform-generated unique identifier of the DiscountGroup.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"version": schema.Int64Attribute{
+				Description: "Current version of the DiscountGroup.",
+				Computed:    true,
+			},
+			"key": schema.StringAttribute{
+				Description: "User-defined unique identifier of the DiscountGroup.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(2, 256),
+					stringvalidator.RegexMatches(
+						regexp.MustCompile("^[A-Za-z0-9_-]+$"),
+						"Key must match pattern ^[A-Za-z0-9_-]+$"),
+				},
+			},
+			"name": schema.MapAttribute{
+				CustomType:  customtypes.NewLocalizedStringType(),
+				Description: "Name of the DiscountGroup as localized string.",
+				Optional:    true,
+			},
+			"description": schema.MapAttribute{
+				CustomType:  customtypes.NewLocalizedStringType(),
+				Description: "Description of the DiscountGroup as localized string.",
+				Optional:    true,
+			},
+			"sort_order": schema.StringAttribute{
+				Description: "The string must contain a number between 0 and 1. A Discount Group with greater " +
+					"sort order is prioritized higher than a Discount Group or Cart Discount with lower sort order. " +
+					"The sort order must be unambiguous among all Discount Groups and Cart Discounts.",
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(
+						SortOrderPattern,
+						"Sort order must be a decimal number between 0 and 1 without trailing zeros"),
+				},
+			},
+			"is_active": schema.BoolAttribute{
+				Description: "Only the Cart Discounts of an active Discount Group are applied to carts.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *discountGroupResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	data := req.ProviderData.(*utils.ProviderData)
+	r.raw = data.RawClient
+	r.locales = data.Locales
+}
+
+// ModifyPlan validates the locales of the localized strings when
+// validate_locales is enabled.
+func (r *discountGroupResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	resp.Diagnostics.Append(r.locales.ValidatePlan(ctx, req.Plan)...)
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *discountGroupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan DiscountGroup
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	draft := plan.draft()
+	var discountGroup remoteDiscountGroup
+	err := retry.RetryContext(ctx, 20*time.Second, func() *retry.RetryError {
+		err := r.raw.Do(ctx, http.MethodPost, "/discount-groups", draft, &discountGroup)
+		return utils.ProcessRemoteError(err)
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating discount group",
+			err.Error(),
+		)
+		return
+	}
+
+	current := NewDiscountGroupFromNative(&discountGroup)
+
+	diags = resp.State.Set(ctx, current)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *discountGroupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state DiscountGroup
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var discountGroup remoteDiscountGroup
+	err := r.raw.Do(ctx, http.MethodGet, "/discount-groups/"+url.PathEscape(state.ID.ValueString()), nil, &discountGroup)
+	if err != nil {
+		if utils.IsResourceNotFoundError(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Error reading discount group",
+			"Could not retrieve discount group, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	current := NewDiscountGroupFromNative(&discountGroup)
+
+	diags = resp.State.Set(ctx, &current)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *discountGroupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan DiscountGroup
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state DiscountGroup
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := state.updateActions(plan)
+	var discountGroup remoteDiscountGroup
+	err := retry.RetryContext(ctx, 5*time.Second, func() *retry.RetryError {
+		err := r.raw.Do(ctx, http.MethodPost, "/discount-groups/"+url.PathEscape(state.ID.ValueString()), input, &discountGroup)
+		return utils.ProcessRemoteError(err)
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating discount group",
+			"Could not update discount group, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	current := NewDiscountGroupFromNative(&discountGroup)
+
+	diags = resp.State.Set(ctx, current)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *discountGroupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state DiscountGroup
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := retry.RetryContext(ctx, 5*time.Second, func() *retry.RetryError {
+		path := fmt.Sprintf("/discount-groups/%s?version=%d", url.PathEscape(state.ID.ValueString()), state.Version.ValueInt64())
+		err := r.raw.Do(ctx, http.MethodDelete, path, nil, nil)
+		return utils.ProcessRemoteError(err)
+	})
+	if err != nil && !utils.IsResourceNotFoundError(err) {
+		resp.Diagnostics.AddError(
+			"Error deleting discount group",
+			"Could not delete discount group, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+// ImportState imports the discount group by its id.
+func (r *discountGroupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
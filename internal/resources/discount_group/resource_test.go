@@ -0,0 +1,144 @@
+package discount_group_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/labd/terraform-provider-commercetools/internal/acctest"
+	"github.com/labd/terraform-provider-commercetools/internal/utils"
+)
+
+func TestAccDiscountGroup_createAndUpdate(t *testing.T) {
+	resourceName := "commercetools_discount_group.brand"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDiscountGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDiscountGroupConfig("brand-a", "0.71", true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "key", "brand-a"),
+					resource.TestCheckResourceAttr(resourceName, "name.en", "Brand"),
+					resource.TestCheckResourceAttr(resourceName, "sort_order", "0.71"),
+					resource.TestCheckResourceAttr(resourceName, "is_active", "true"),
+					resource.TestCheckResourceAttr(resourceName, "version", "1"),
+				),
+			},
+			{
+				Config: testAccDiscountGroupConfig("brand-b", "0.72", false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "key", "brand-b"),
+					resource.TestCheckResourceAttr(resourceName, "sort_order", "0.72"),
+					resource.TestCheckResourceAttr(resourceName, "is_active", "false"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccDiscountGroup_cartDiscount(t *testing.T) {
+	resourceName := "commercetools_cart_discount.brand"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDiscountGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDiscountGroupCartDiscountConfig(true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						resourceName, "discount_group_id",
+						"commercetools_discount_group.brand", "id",
+					),
+				),
+			},
+			{
+				Config: testAccDiscountGroupCartDiscountConfig(false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "discount_group_id", ""),
+				),
+			},
+		},
+	})
+}
+
+func testAccDiscountGroupCartDiscountConfig(withGroup bool) string {
+	return utils.HCLTemplate(`
+		resource "commercetools_discount_group" "brand" {
+			key        = "tf-acc-brand"
+			sort_order = "0.61"
+		}
+
+		resource "commercetools_cart_discount" "brand" {
+			name = {
+				en = "brand discount"
+			}
+			sort_order = "0.62"
+			predicate  = "1=1"
+			{{ if .withGroup }}
+			discount_group_id = commercetools_discount_group.brand.id
+			{{ end }}
+
+			target {
+				type      = "lineItems"
+				predicate = "1=1"
+			}
+
+			value {
+				type      = "relative"
+				permyriad = 1000
+			}
+		}
+	`, map[string]any{
+		"withGroup": withGroup,
+	})
+}
+
+func testAccDiscountGroupConfig(key, sortOrder string, isActive bool) string {
+	return utils.HCLTemplate(`
+		resource "commercetools_discount_group" "brand" {
+			key        = "{{ .key }}"
+			name       = {
+				en = "Brand"
+			}
+			sort_order = "{{ .sortOrder }}"
+			is_active  = {{ .isActive }}
+		}
+	`, map[string]any{
+		"key":       key,
+		"sortOrder": sortOrder,
+		"isActive":  isActive,
+	})
+}
+
+func testAccCheckDiscountGroupDestroy(s *terraform.State) error {
+	client := acctest.GetRawClient()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "commercetools_discount_group" {
+			continue
+		}
+		err := client.Do(context.Background(), http.MethodGet, "/discount-groups/"+url.PathEscape(rs.Primary.ID), nil, nil)
+		if err == nil {
+			return fmt.Errorf("discount group (%s) still exists", rs.Primary.ID)
+		}
+		if newErr := acctest.CheckApiResult(err); newErr != nil {
+			return newErr
+		}
+	}
+	return nil
+}
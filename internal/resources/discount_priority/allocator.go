@@ -0,0 +1,188 @@
+package discount_priority
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+// The sort order of a discount is a decimal number between 0 and 1, which must
+// be unique among all discounts of the same type. A discount with a greater
+// sort order is applied before a discount with a lower sort order.
+
+// move changes the sort order of the discount with the key.
+type move struct {
+	Key       string
+	SortOrder string
+}
+
+// parseSortOrder parses the sort order as an exact rational number.
+func parseSortOrder(value string) (*big.Rat, error) {
+	r, ok := new(big.Rat).SetString(value)
+	if !ok || r.Sign() <= 0 || r.Cmp(big.NewRat(1, 1)) >= 0 {
+		return nil, fmt.Errorf("invalid sort order %q, must be a decimal number between 0 and 1", value)
+	}
+	return r, nil
+}
+
+// formatSortOrder formats the number with the given number of digits, without
+// trailing zeros as required by the API.
+func formatSortOrder(r *big.Rat, digits int) string {
+	result := r.FloatString(digits)
+	if strings.Contains(result, ".") {
+		result = strings.TrimRight(result, "0")
+	}
+	return result
+}
+
+// sortOrderKey returns a canonical representation of the sort order, so
+// "0.5" and "0.50" are considered equal.
+func sortOrderKey(value string) string {
+	r, err := parseSortOrder(value)
+	if err != nil {
+		return value
+	}
+	return r.RatString()
+}
+
+// isOrdered returns true when all keys have a sort order and the sort orders
+// are strictly decreasing in the order of the keys.
+func isOrdered(keys []string, current map[string]string) bool {
+	var previous *big.Rat
+	for _, key := range keys {
+		value, ok := current[key]
+		if !ok {
+			return false
+		}
+		r, err := parseSortOrder(value)
+		if err != nil {
+			return false
+		}
+		if previous != nil && r.Cmp(previous) >= 0 {
+			return false
+		}
+		previous = r
+	}
+	return true
+}
+
+// allocateSortOrders returns evenly spread sort orders for the keys, where the
+// first key gets the greatest sort order. Sort orders which are in use by
+// other discounts are skipped by nudging the sort order slightly upwards.
+func allocateSortOrders(keys []string, taken []string) map[string]string {
+	n := len(keys)
+	if n == 0 {
+		return map[string]string{}
+	}
+
+	// Use one digit more than needed to tell the steps apart, so there is
+	// room to nudge a sort order without passing its neighbour.
+	digits := len(fmt.Sprint(n+1)) + 1
+	scale := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(digits)), nil)
+	nudge := new(big.Rat).SetFrac(big.NewInt(1), new(big.Int).Mul(scale, big.NewInt(100)))
+
+	used := make(map[string]bool, len(taken))
+	for _, value := range taken {
+		used[sortOrderKey(value)] = true
+	}
+
+	result := make(map[string]string, n)
+	for i, key := range keys {
+		// floor((n - i) * 10^digits / (n + 1)) / 10^digits
+		numerator := new(big.Int).Mul(big.NewInt(int64(n-i)), scale)
+		numerator.Quo(numerator, big.NewInt(int64(n+1)))
+		candidate := new(big.Rat).SetFrac(numerator, scale)
+
+		for j := 0; j < 99 && used[candidate.RatString()]; j++ {
+			candidate.Add(candidate, nudge)
+		}
+		if used[candidate.RatString()] {
+			// Fall back to the middle of the remaining room, which can only
+			// be taken when the other discounts are packed very densely.
+			candidate.Add(candidate, new(big.Rat).Quo(nudge, big.NewRat(2, 1)))
+		}
+
+		used[candidate.RatString()] = true
+		result[key] = formatSortOrder(candidate, digits+4)
+	}
+	return result
+}
+
+// planMoves returns the changes to get from the current to the target sort
+// orders. Each move changes the sort order to a value which is not in use at
+// that moment, so the changes never produce duplicate sort orders. When the
+// discounts swap sort orders a temporary sort order is used to break the
+// cycle. Taken contains the sort orders of the discounts which are not
+// managed.
+func planMoves(keys []string, current, target map[string]string, taken []string) []move {
+	occupied := map[string]int{}
+	for _, value := range taken {
+		occupied[sortOrderKey(value)]++
+	}
+	for _, key := range keys {
+		if value, ok := current[key]; ok {
+			occupied[sortOrderKey(value)]++
+		}
+	}
+
+	targets := map[string]bool{}
+	var pending []string
+	for _, key := range keys {
+		targets[sortOrderKey(target[key])] = true
+		if value, ok := current[key]; !ok || sortOrderKey(value) != sortOrderKey(target[key]) {
+			pending = append(pending, key)
+		}
+	}
+
+	position := map[string]string{}
+	for key, value := range current {
+		position[key] = value
+	}
+
+	apply := func(key, value string) move {
+		if old, ok := position[key]; ok {
+			occupied[sortOrderKey(old)]--
+		}
+		occupied[sortOrderKey(value)]++
+		position[key] = value
+		return move{Key: key, SortOrder: value}
+	}
+
+	var result []move
+	for len(pending) > 0 {
+		progress := false
+		remaining := pending[:0]
+		for _, key := range pending {
+			if occupied[sortOrderKey(target[key])] == 0 {
+				result = append(result, apply(key, target[key]))
+				progress = true
+			} else {
+				remaining = append(remaining, key)
+			}
+		}
+		pending = remaining
+
+		if !progress && len(pending) > 0 {
+			// All pending discounts wait for each other, move the first one
+			// out of the way.
+			key := pending[0]
+			result = append(result, apply(key, temporarySortOrder(target[key], occupied, targets)))
+		}
+	}
+	return result
+}
+
+// temporarySortOrder returns a sort order close to the value which is neither
+// in use nor one of the targets.
+func temporarySortOrder(value string, occupied map[string]int, targets map[string]bool) string {
+	r, _ := parseSortOrder(value)
+	step := big.NewRat(1, 1_000_000_000)
+	candidate := new(big.Rat).Set(r)
+	for {
+		candidate.Sub(candidate, step)
+		key := candidate.RatString()
+		if occupied[key] == 0 && !targets[key] {
+			return formatSortOrder(candidate, 20)
+		}
+	}
+}
@@ -0,0 +1,152 @@
+package discount_priority
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAllocateSortOrders(t *testing.T) {
+	testCases := []struct {
+		name     string
+		keys     []string
+		taken    []string
+		expected map[string]string
+	}{
+		{
+			name:     "single",
+			keys:     []string{"a"},
+			expected: map[string]string{"a": "0.5"},
+		},
+		{
+			name:     "evenly spread",
+			keys:     []string{"a", "b", "c"},
+			expected: map[string]string{"a": "0.75", "b": "0.5", "c": "0.25"},
+		},
+		{
+			name:     "repeating decimals are truncated",
+			keys:     []string{"a", "b"},
+			expected: map[string]string{"a": "0.66", "b": "0.33"},
+		},
+		{
+			name:     "taken sort orders are skipped",
+			keys:     []string{"a", "b", "c"},
+			taken:    []string{"0.5", "0.7501", "0.750"},
+			expected: map[string]string{"a": "0.7502", "b": "0.5001", "c": "0.25"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			result := allocateSortOrders(tc.keys, tc.taken)
+			assert.Equal(t, tc.expected, result)
+			assert.True(t, isOrdered(tc.keys, result))
+		})
+	}
+}
+
+func TestAllocateSortOrdersMany(t *testing.T) {
+	keys := make([]string, 250)
+	for i := range keys {
+		keys[i] = fmt.Sprintf("discount-%d", i)
+	}
+	result := allocateSortOrders(keys, []string{"0.5", "0.996"})
+
+	assert.Len(t, result, len(keys))
+	assert.True(t, isOrdered(keys, result))
+
+	seen := map[string]bool{}
+	for _, value := range result {
+		_, err := parseSortOrder(value)
+		assert.NoError(t, err)
+		assert.NotContains(t, []string{"0.5", "0.996"}, value)
+		assert.False(t, seen[value])
+		seen[value] = true
+	}
+}
+
+func TestIsOrdered(t *testing.T) {
+	keys := []string{"a", "b"}
+	assert.True(t, isOrdered(keys, map[string]string{"a": "0.9", "b": "0.1"}))
+	assert.False(t, isOrdered(keys, map[string]string{"a": "0.1", "b": "0.9"}))
+	assert.False(t, isOrdered(keys, map[string]string{"a": "0.5", "b": "0.50"}))
+	assert.False(t, isOrdered(keys, map[string]string{"a": "0.5"}))
+	assert.False(t, isOrdered(keys, map[string]string{"a": "1.5", "b": "0.1"}))
+}
+
+// applyMoves applies the moves and checks that the sort orders are unique
+// after every move.
+func applyMoves(t *testing.T, current map[string]string, taken []string, moves []move) map[string]string {
+	result := map[string]string{}
+	for key, value := range current {
+		result[key] = value
+	}
+
+	for _, m := range moves {
+		result[m.Key] = m.SortOrder
+
+		seen := map[string]bool{}
+		for _, value := range taken {
+			seen[sortOrderKey(value)] = true
+		}
+		for key, value := range result {
+			assert.False(t, seen[sortOrderKey(value)], "duplicate sort order %s for %s after move %v", value, key, m)
+			seen[sortOrderKey(value)] = true
+		}
+	}
+	return result
+}
+
+func TestPlanMoves(t *testing.T) {
+	testCases := []struct {
+		name    string
+		keys    []string
+		current map[string]string
+		target  map[string]string
+		taken   []string
+		moves   int
+	}{
+		{
+			name:    "unchanged",
+			keys:    []string{"a", "b"},
+			current: map[string]string{"a": "0.66", "b": "0.33"},
+			target:  map[string]string{"a": "0.66", "b": "0.33"},
+			moves:   0,
+		},
+		{
+			name:    "chain",
+			keys:    []string{"c", "a", "b"},
+			current: map[string]string{"a": "0.75", "b": "0.5", "c": "0.25"},
+			target:  map[string]string{"c": "0.75", "a": "0.5", "b": "0.25"},
+			moves:   4,
+		},
+		{
+			name:    "swap",
+			keys:    []string{"b", "a"},
+			current: map[string]string{"a": "0.66", "b": "0.33"},
+			target:  map[string]string{"b": "0.66", "a": "0.33"},
+			moves:   3,
+		},
+		{
+			name:    "new discount takes the place of another",
+			keys:    []string{"a", "new", "b"},
+			current: map[string]string{"a": "0.66", "b": "0.33", "new": "0.1"},
+			target:  map[string]string{"a": "0.75", "new": "0.5", "b": "0.25"},
+			taken:   []string{"0.9"},
+			moves:   3,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			moves := planMoves(tc.keys, tc.current, tc.target, tc.taken)
+			assert.Len(t, moves, tc.moves)
+
+			result := applyMoves(t, tc.current, tc.taken, moves)
+			for _, key := range tc.keys {
+				assert.Equal(t, sortOrderKey(tc.target[key]), sortOrderKey(result[key]))
+			}
+		})
+	}
+}
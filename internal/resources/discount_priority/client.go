@@ -0,0 +1,147 @@
+package discount_priority
+
+import (
+	"context"
+
+	"github.com/labd/commercetools-go-sdk/platform"
+)
+
+// pageSize is the number of discounts which are retrieved per request.
+const pageSize = 500
+
+const (
+	discountTypeCart    = "cart"
+	discountTypeProduct = "product"
+)
+
+// discount contains the fields of a cart or product discount which are needed
+// to manage its sort order.
+type discount struct {
+	ID        string
+	Version   int
+	Key       string
+	SortOrder string
+}
+
+// discountClient abstracts the differences between the cart and product
+// discount endpoints.
+type discountClient interface {
+	list(ctx context.Context) ([]discount, error)
+	get(ctx context.Context, id string) (discount, error)
+	changeSortOrder(ctx context.Context, d discount, sortOrder string) (discount, error)
+}
+
+func newDiscountClient(client *platform.ByProjectKeyRequestBuilder, discountType string) discountClient {
+	if discountType == discountTypeProduct {
+		return &productDiscountClient{client: client}
+	}
+	return &cartDiscountClient{client: client}
+}
+
+type cartDiscountClient struct {
+	client *platform.ByProjectKeyRequestBuilder
+}
+
+func newDiscountFromCartDiscount(n *platform.CartDiscount) discount {
+	result := discount{ID: n.ID, Version: n.Version, SortOrder: n.SortOrder}
+	if n.Key != nil {
+		result.Key = *n.Key
+	}
+	return result
+}
+
+func (c *cartDiscountClient) list(ctx context.Context) ([]discount, error) {
+	var result []discount
+	for {
+		page, err := c.client.CartDiscounts().Get().
+			Sort([]string{"id asc"}).
+			Limit(pageSize).
+			Offset(len(result)).
+			Execute(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for i := range page.Results {
+			result = append(result, newDiscountFromCartDiscount(&page.Results[i]))
+		}
+		if len(page.Results) < pageSize {
+			return result, nil
+		}
+	}
+}
+
+func (c *cartDiscountClient) get(ctx context.Context, id string) (discount, error) {
+	res, err := c.client.CartDiscounts().WithId(id).Get().Execute(ctx)
+	if err != nil {
+		return discount{}, err
+	}
+	return newDiscountFromCartDiscount(res), nil
+}
+
+func (c *cartDiscountClient) changeSortOrder(ctx context.Context, d discount, sortOrder string) (discount, error) {
+	input := platform.CartDiscountUpdate{
+		Version: d.Version,
+		Actions: []platform.CartDiscountUpdateAction{
+			platform.CartDiscountChangeSortOrderAction{SortOrder: sortOrder},
+		},
+	}
+	res, err := c.client.CartDiscounts().WithId(d.ID).Post(input).Execute(ctx)
+	if err != nil {
+		return discount{}, err
+	}
+	return newDiscountFromCartDiscount(res), nil
+}
+
+type productDiscountClient struct {
+	client *platform.ByProjectKeyRequestBuilder
+}
+
+func newDiscountFromProductDiscount(n *platform.ProductDiscount) discount {
+	result := discount{ID: n.ID, Version: n.Version, SortOrder: n.SortOrder}
+	if n.Key != nil {
+		result.Key = *n.Key
+	}
+	return result
+}
+
+func (c *productDiscountClient) list(ctx context.Context) ([]discount, error) {
+	var result []discount
+	for {
+		page, err := c.client.ProductDiscounts().Get().
+			Sort([]string{"id asc"}).
+			Limit(pageSize).
+			Offset(len(result)).
+			Execute(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for i := range page.Results {
+			result = append(result, newDiscountFromProductDiscount(&page.Results[i]))
+		}
+		if len(page.Results) < pageSize {
+			return result, nil
+		}
+	}
+}
+
+func (c *productDiscountClient) get(ctx context.Context, id string) (discount, error) {
+	res, err := c.client.ProductDiscounts().WithId(id).Get().Execute(ctx)
+	if err != nil {
+		return discount{}, err
+	}
+	return newDiscountFromProductDiscount(res), nil
+}
+
+func (c *productDiscountClient) changeSortOrder(ctx context.Context, d discount, sortOrder string) (discount, error) {
+	input := platform.ProductDiscountUpdate{
+		Version: d.Version,
+		Actions: []platform.ProductDiscountUpdateAction{
+			platform.ProductDiscountChangeSortOrderAction{SortOrder: sortOrder},
+		},
+	}
+	res, err := c.client.ProductDiscounts().WithId(d.ID).Post(input).Execute(ctx)
+	if err != nil {
+		return discount{}, err
+	}
+	return newDiscountFromProductDiscount(res), nil
+}
@@ -0,0 +1,48 @@
+package discount_priority
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/labd/commercetools-go-sdk/platform"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeDiscountClient rejects updates with an outdated version, like the API.
+type fakeDiscountClient struct {
+	discount discount
+	updates  []int
+}
+
+func (c *fakeDiscountClient) list(_ context.Context) ([]discount, error) {
+	return []discount{c.discount}, nil
+}
+
+func (c *fakeDiscountClient) get(_ context.Context, _ string) (discount, error) {
+	return c.discount, nil
+}
+
+func (c *fakeDiscountClient) changeSortOrder(_ context.Context, d discount, sortOrder string) (discount, error) {
+	c.updates = append(c.updates, d.Version)
+	if d.Version != c.discount.Version {
+		return discount{}, platform.ErrorResponse{StatusCode: http.StatusConflict}
+	}
+	c.discount.Version++
+	c.discount.SortOrder = sortOrder
+	return c.discount, nil
+}
+
+func TestChangeSortOrderConcurrentModification(t *testing.T) {
+	client := &fakeDiscountClient{
+		discount: discount{ID: "1", Version: 3, Key: "summer", SortOrder: "0.5"},
+	}
+	stale := discount{ID: "1", Version: 2, Key: "summer", SortOrder: "0.5"}
+
+	result, err := changeSortOrder(context.Background(), client, stale, "0.7")
+	require.NoError(t, err)
+	assert.Equal(t, []int{2, 3}, client.updates)
+	assert.Equal(t, 4, result.Version)
+	assert.Equal(t, "0.7", result.SortOrder)
+}
@@ -0,0 +1,176 @@
+package discount_priority
+
+import (
+	"context"
+	"math/big"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// DiscountPriority is the main resource schema data. The discount keys are
+// ordered by priority, the first discount is applied first.
+type DiscountPriority struct {
+	ID           types.String   `tfsdk:"id"`
+	DiscountType types.String   `tfsdk:"discount_type"`
+	DiscountKeys []types.String `tfsdk:"discount_keys"`
+	SortOrders   types.Map      `tfsdk:"sort_orders"`
+}
+
+// NewDiscountPriorityFromNative creates the resource data from the remote
+// discounts. When no discount keys are known yet, for example after an
+// import, all discounts with a key and sort order are included ordered by
+// their sort order.
+func NewDiscountPriorityFromNative(state DiscountPriority, discounts []discount) DiscountPriority {
+	keys := state.DiscountKeys
+	if keys == nil {
+		keys = prioritizedKeys(discounts)
+	}
+
+	managed := map[string]bool{}
+	for _, key := range keys {
+		managed[key.ValueString()] = true
+	}
+
+	values := map[string]attr.Value{}
+	for _, d := range discounts {
+		if d.Key != "" && managed[d.Key] && d.SortOrder != "" {
+			values[d.Key] = types.StringValue(d.SortOrder)
+		}
+	}
+
+	return DiscountPriority{
+		ID:           state.DiscountType,
+		DiscountType: state.DiscountType,
+		DiscountKeys: keys,
+		SortOrders:   types.MapValueMust(types.StringType, values),
+	}
+}
+
+// keys returns the discount keys, or false when not all keys are known yet.
+func (d DiscountPriority) keys() ([]string, bool) {
+	result := make([]string, 0, len(d.DiscountKeys))
+	for _, key := range d.DiscountKeys {
+		if key.IsUnknown() || key.IsNull() {
+			return nil, false
+		}
+		result = append(result, key.ValueString())
+	}
+	return result, true
+}
+
+// sortOrders returns the known sort orders by discount key.
+func (d DiscountPriority) sortOrders(ctx context.Context) map[string]string {
+	result := map[string]string{}
+	if d.SortOrders.IsNull() || d.SortOrders.IsUnknown() {
+		return result
+	}
+	d.SortOrders.ElementsAs(ctx, &result, false)
+	return result
+}
+
+// plannedSortOrders returns the sort orders after applying the plan. When the
+// current sort orders already have the planned priority they are kept,
+// otherwise they are unknown until the discounts are rebalanced.
+func (d DiscountPriority) plannedSortOrders(ctx context.Context, plan DiscountPriority) types.Map {
+	keys, ok := plan.keys()
+	if !ok {
+		return types.MapUnknown(types.StringType)
+	}
+
+	current := d.sortOrders(ctx)
+	if !isOrdered(keys, current) {
+		return types.MapUnknown(types.StringType)
+	}
+
+	values := map[string]attr.Value{}
+	for _, key := range keys {
+		values[key] = types.StringValue(current[key])
+	}
+	return types.MapValueMust(types.StringType, values)
+}
+
+// prioritizedKeys returns the keys of the discounts ordered by descending sort
+// order. Discounts without a key or sort order are skipped.
+func prioritizedKeys(discounts []discount) []types.String {
+	type entry struct {
+		key       string
+		sortOrder *big.Rat
+	}
+
+	var entries []entry
+	for _, d := range discounts {
+		if d.Key == "" {
+			continue
+		}
+		r, err := parseSortOrder(d.SortOrder)
+		if err != nil {
+			continue
+		}
+		entries = append(entries, entry{key: d.Key, sortOrder: r})
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].sortOrder.Cmp(entries[j].sortOrder) > 0
+	})
+
+	result := make([]types.String, len(entries))
+	for i, e := range entries {
+		result[i] = types.StringValue(e.key)
+	}
+	return result
+}
+
+// missingKeys returns the keys for which no discount exists.
+func missingKeys(keys []string, discounts []discount) []string {
+	existing := map[string]bool{}
+	for _, d := range discounts {
+		existing[d.Key] = true
+	}
+
+	var result []string
+	for _, key := range keys {
+		if !existing[key] {
+			result = append(result, key)
+		}
+	}
+	return result
+}
+
+// currentSortOrders returns the sort orders of the managed discounts by key,
+// and the sort orders which are in use by the other discounts.
+func currentSortOrders(keys []string, discounts []discount) (map[string]string, []string) {
+	managed := map[string]bool{}
+	for _, key := range keys {
+		managed[key] = true
+	}
+
+	current := map[string]string{}
+	var taken []string
+	for _, d := range discounts {
+		if d.SortOrder == "" {
+			continue
+		}
+		if d.Key != "" && managed[d.Key] {
+			current[d.Key] = d.SortOrder
+		} else {
+			taken = append(taken, d.SortOrder)
+		}
+	}
+	return current, taken
+}
+
+// targetSortOrders returns the sort orders for the keys. Discounts which are
+// already in the right order keep their sort orders, otherwise all discounts
+// are rebalanced.
+func targetSortOrders(keys []string, current map[string]string, taken []string) map[string]string {
+	if !isOrdered(keys, current) {
+		return allocateSortOrders(keys, taken)
+	}
+
+	result := make(map[string]string, len(keys))
+	for _, key := range keys {
+		result[key] = current[key]
+	}
+	return result
+}
@@ -0,0 +1,127 @@
+package discount_priority
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+var testDiscounts = []discount{
+	{ID: "1", Version: 1, Key: "summer", SortOrder: "0.4"},
+	{ID: "2", Version: 3, Key: "winter", SortOrder: "0.8"},
+	{ID: "3", Version: 1, SortOrder: "0.9"},
+	{ID: "4", Version: 2, Key: "spring", SortOrder: "0.15"},
+}
+
+func sortOrders(values map[string]string) types.Map {
+	elements := map[string]attr.Value{}
+	for key, value := range values {
+		elements[key] = types.StringValue(value)
+	}
+	return types.MapValueMust(types.StringType, elements)
+}
+
+func discountKeys(keys ...string) []types.String {
+	result := make([]types.String, len(keys))
+	for i, key := range keys {
+		result[i] = types.StringValue(key)
+	}
+	return result
+}
+
+func TestNewDiscountPriorityFromNative(t *testing.T) {
+	state := DiscountPriority{
+		DiscountType: types.StringValue("cart"),
+		DiscountKeys: discountKeys("summer", "winter", "autumn"),
+	}
+
+	res := NewDiscountPriorityFromNative(state, testDiscounts)
+	assert.Equal(t, DiscountPriority{
+		ID:           types.StringValue("cart"),
+		DiscountType: types.StringValue("cart"),
+		DiscountKeys: discountKeys("summer", "winter", "autumn"),
+		SortOrders:   sortOrders(map[string]string{"summer": "0.4", "winter": "0.8"}),
+	}, res)
+}
+
+func TestNewDiscountPriorityFromNativeImport(t *testing.T) {
+	state := DiscountPriority{
+		DiscountType: types.StringValue("product"),
+	}
+
+	res := NewDiscountPriorityFromNative(state, testDiscounts)
+	assert.Equal(t, discountKeys("winter", "summer", "spring"), res.DiscountKeys)
+	assert.Equal(t, sortOrders(map[string]string{"winter": "0.8", "summer": "0.4", "spring": "0.15"}), res.SortOrders)
+}
+
+func TestPlannedSortOrders(t *testing.T) {
+	state := DiscountPriority{
+		DiscountKeys: discountKeys("winter", "summer", "spring"),
+		SortOrders:   sortOrders(map[string]string{"winter": "0.8", "summer": "0.4", "spring": "0.15"}),
+	}
+
+	testCases := []struct {
+		name     string
+		keys     []types.String
+		expected types.Map
+	}{
+		{
+			name:     "unchanged",
+			keys:     discountKeys("winter", "summer", "spring"),
+			expected: state.SortOrders,
+		},
+		{
+			name:     "removed",
+			keys:     discountKeys("winter", "spring"),
+			expected: sortOrders(map[string]string{"winter": "0.8", "spring": "0.15"}),
+		},
+		{
+			name:     "reordered",
+			keys:     discountKeys("summer", "winter", "spring"),
+			expected: types.MapUnknown(types.StringType),
+		},
+		{
+			name:     "added",
+			keys:     discountKeys("winter", "summer", "spring", "autumn"),
+			expected: types.MapUnknown(types.StringType),
+		},
+		{
+			name:     "unknown key",
+			keys:     []types.String{types.StringValue("winter"), types.StringUnknown()},
+			expected: types.MapUnknown(types.StringType),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			plan := state
+			plan.DiscountKeys = tc.keys
+			assert.Equal(t, tc.expected, state.plannedSortOrders(context.Background(), plan))
+		})
+	}
+}
+
+func TestCurrentSortOrders(t *testing.T) {
+	keys := []string{"summer", "winter"}
+	current, taken := currentSortOrders(keys, testDiscounts)
+	assert.Equal(t, map[string]string{"summer": "0.4", "winter": "0.8"}, current)
+	assert.Equal(t, []string{"0.9", "0.15"}, taken)
+
+	assert.Equal(t, []string{"autumn"}, missingKeys([]string{"summer", "autumn"}, testDiscounts))
+}
+
+func TestTargetSortOrders(t *testing.T) {
+	current := map[string]string{"summer": "0.4", "winter": "0.8"}
+	taken := []string{"0.9", "0.5"}
+
+	assert.Equal(t, current, targetSortOrders([]string{"winter", "summer"}, current, taken))
+	assert.Equal(t,
+		map[string]string{"summer": "0.66", "winter": "0.33"},
+		targetSortOrders([]string{"summer", "winter"}, current, taken))
+	assert.Equal(t,
+		map[string]string{"winter": "0.75", "summer": "0.5001", "autumn": "0.25"},
+		targetSortOrders([]string{"winter", "summer", "autumn"}, current, taken))
+}
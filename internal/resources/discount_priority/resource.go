@@ -0,0 +1,279 @@
+package discount_priority
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/labd/commercetools-go-sdk/platform"
+
+	"github.com/labd/terraform-provider-commercetools/internal/utils"
+)
+
+var (
+	_ resource.Resource                = &discountPriorityResource{}
+	_ resource.ResourceWithConfigure   = &discountPriorityResource{}
+	_ resource.ResourceWithImportState = &discountPriorityResource{}
+	_ resource.ResourceWithModifyPlan  = &discountPriorityResource{}
+)
+
+type discountPriorityResource struct {
+	client *platform.ByProjectKeyRequestBuilder
+}
+
+// NewResource is a helper function to simplify the provider implementation.
+func NewResource() resource.Resource {
+	return &discountPriorityResource{}
+}
+
+// Schema implements resource.Resource.
+func (*discountPriorityResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the sort orders of cart or product discounts from an ordered list of discount " +
+			"keys. The first discount gets the highest priority. Sort orders are allocated so they never " +
+			"collide with other discounts, and are rebalanced when the list changes. The changes are applied " +
+			"one by one in an order which never results in duplicate sort orders.\n\n" +
+			"The `sort_order` of the managed discounts should not be set on the discount resources, in which " +
+			"case they get an initial sort order below all existing discounts. Destroying this resource leaves " +
+			"the sort orders of the discounts unchanged.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"discount_type": schema.StringAttribute{
+				Description: "The type of the discounts, either `cart` or `product`",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(discountTypeCart, discountTypeProduct),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"discount_keys": schema.ListAttribute{
+				Description: "The keys of the discounts ordered by priority. The first discount gets the " +
+					"greatest sort order and is applied first.",
+				ElementType: types.StringType,
+				Required:    true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.UniqueValues(),
+					listvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+				},
+			},
+			"sort_orders": schema.MapAttribute{
+				Description: "The sort order of each discount by key",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// Metadata implements resource.Resource.
+func (*discountPriorityResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_discount_priority"
+}
+
+// Configure implements resource.ResourceWithConfigure.
+func (r *discountPriorityResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	data := req.ProviderData.(*utils.ProviderData)
+	r.client = data.Client
+}
+
+// ModifyPlan implements resource.ResourceWithModifyPlan. The sort orders are
+// kept when the discounts already have the planned priority, for example
+// when a discount is removed from the list. Otherwise they are marked unknown,
+// which also triggers an update when the sort orders were changed outside of
+// Terraform.
+func (r *discountPriorityResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to do on create and destroy
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, state DiscountPriority
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.SortOrders = state.plannedSortOrders(ctx, plan)
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, plan)...)
+}
+
+// Create implements resource.Resource.
+func (r *discountPriorityResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan DiscountPriority
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	current, err := r.apply(ctx, plan)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating discount priority",
+			err.Error(),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, current)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read implements resource.Resource.
+func (r *discountPriorityResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state DiscountPriority
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	discounts, err := newDiscountClient(r.client, state.DiscountType.ValueString()).list(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading discount priority",
+			"Could not retrieve the discounts, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	current := NewDiscountPriorityFromNative(state, discounts)
+
+	diags = resp.State.Set(ctx, current)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update implements resource.Resource.
+func (r *discountPriorityResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan DiscountPriority
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	current, err := r.apply(ctx, plan)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating discount priority",
+			err.Error(),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, current)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete implements resource.Resource. The sort orders of the discounts are
+// left unchanged.
+func (*discountPriorityResource) Delete(_ context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+}
+
+// ImportState implements resource.ResourceWithImportState. The discount type
+// is used as id, all discounts with a key are imported ordered by their
+// current sort order.
+func (*discountPriorityResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if req.ID != discountTypeCart && req.ID != discountTypeProduct {
+		resp.Diagnostics.AddError(
+			"Invalid import id",
+			fmt.Sprintf("Expected %q or %q, got %q", discountTypeCart, discountTypeProduct, req.ID),
+		)
+		return
+	}
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("discount_type"), req.ID)...)
+}
+
+// apply changes the sort orders of the discounts to match the planned
+// priority.
+func (r *discountPriorityResource) apply(ctx context.Context, plan DiscountPriority) (DiscountPriority, error) {
+	client := newDiscountClient(r.client, plan.DiscountType.ValueString())
+	keys, _ := plan.keys()
+
+	discounts, err := client.list(ctx)
+	if err != nil {
+		return DiscountPriority{}, err
+	}
+	if missing := missingKeys(keys, discounts); len(missing) > 0 {
+		return DiscountPriority{}, fmt.Errorf(
+			"no %s discounts found with the keys %s", plan.DiscountType.ValueString(), strings.Join(missing, ", "))
+	}
+
+	byKey := map[string]int{}
+	for i, d := range discounts {
+		byKey[d.Key] = i
+	}
+
+	current, taken := currentSortOrders(keys, discounts)
+	target := targetSortOrders(keys, current, taken)
+	for _, m := range planMoves(keys, current, target, taken) {
+		i := byKey[m.Key]
+		discounts[i], err = changeSortOrder(ctx, client, discounts[i], m.SortOrder)
+		if err != nil {
+			return DiscountPriority{}, fmt.Errorf(
+				"could not change the sort order of discount %s to %s: %w", m.Key, m.SortOrder, err)
+		}
+	}
+
+	return NewDiscountPriorityFromNative(plan, discounts), nil
+}
+
+// changeSortOrder changes the sort order of the discount. When the discount
+// was modified since it was retrieved, it is retrieved again to retry with
+// its current version.
+func changeSortOrder(ctx context.Context, client discountClient, d discount, sortOrder string) (discount, error) {
+	err := retry.RetryContext(ctx, 5*time.Second, func() *retry.RetryError {
+		res, err := client.changeSortOrder(ctx, d, sortOrder)
+		if isConcurrentModification(err) {
+			current, getErr := client.get(ctx, d.ID)
+			if getErr != nil {
+				return utils.ProcessRemoteError(getErr)
+			}
+			d = current
+			return retry.RetryableError(err)
+		}
+		if err != nil {
+			return utils.ProcessRemoteError(err)
+		}
+		d = res
+		return nil
+	})
+	return d, err
+}
+
+// isConcurrentModification returns true when the API rejected an update
+// because the version of the resource is outdated.
+func isConcurrentModification(err error) bool {
+	switch e := err.(type) {
+	case platform.ErrorResponse:
+		return e.StatusCode == http.StatusConflict
+	case platform.GenericRequestError:
+		return e.StatusCode == http.StatusConflict
+	}
+	return false
+}
@@ -0,0 +1,103 @@
+package discount_priority_test
+
+import (
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/labd/terraform-provider-commercetools/internal/acctest"
+	"github.com/labd/terraform-provider-commercetools/internal/utils"
+)
+
+func TestAccDiscountPriority_cart(t *testing.T) {
+	resourceName := "commercetools_discount_priority.cart"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDiscountPriorityConfig([]string{"tf-acc-a", "tf-acc-b", "tf-acc-c"}),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", "cart"),
+					resource.TestCheckResourceAttr(resourceName, "sort_orders.%", "3"),
+					testAccCheckPriority(resourceName, "tf-acc-a", "tf-acc-b", "tf-acc-c"),
+				),
+			},
+			{
+				Config: testAccDiscountPriorityConfig([]string{"tf-acc-c", "tf-acc-a", "tf-acc-b"}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPriority(resourceName, "tf-acc-c", "tf-acc-a", "tf-acc-b"),
+				),
+			},
+			{
+				Config: testAccDiscountPriorityConfig([]string{"tf-acc-b", "tf-acc-a"}),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "sort_orders.%", "2"),
+					testAccCheckPriority(resourceName, "tf-acc-b", "tf-acc-a"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckPriority(name string, keys ...string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("resource %s not found", name)
+		}
+
+		var previous *big.Rat
+		for _, key := range keys {
+			value := rs.Primary.Attributes["sort_orders."+key]
+			r, ok := new(big.Rat).SetString(value)
+			if !ok {
+				return fmt.Errorf("invalid sort order %q for %s", value, key)
+			}
+			if previous != nil && r.Cmp(previous) >= 0 {
+				return fmt.Errorf("sort order %s of %s is not lower than its predecessor", value, key)
+			}
+			previous = r
+		}
+		return nil
+	}
+}
+
+func testAccDiscountPriorityConfig(keys []string) string {
+	return utils.HCLTemplate(`
+		{{ range $key := .all }}
+		resource "commercetools_cart_discount" "{{ $key }}" {
+			key = "{{ $key }}"
+			name = {
+				en = "{{ $key }}"
+			}
+			predicate = "1=1"
+
+			target {
+				type      = "lineItems"
+				predicate = "1=1"
+			}
+
+			value {
+				type      = "relative"
+				permyriad = 100
+			}
+		}
+		{{ end }}
+
+		resource "commercetools_discount_priority" "cart" {
+			discount_type = "cart"
+			discount_keys = [
+				{{ range .keys }}commercetools_cart_discount.{{ . }}.key,
+				{{ end }}
+			]
+		}
+	`, map[string]any{
+		"all":  []string{"tf-acc-a", "tf-acc-b", "tf-acc-c"},
+		"keys": keys,
+	})
+}
@@ -0,0 +1,54 @@
+package import_container
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/labd/commercetools-go-sdk/importapi"
+)
+
+// ImportContainer represents the main schema data.
+type ImportContainer struct {
+	ID           types.String `tfsdk:"id"`
+	Key          types.String `tfsdk:"key"`
+	Version      types.Int64  `tfsdk:"version"`
+	ResourceType types.String `tfsdk:"resource_type"`
+}
+
+func NewImportContainerFromNative(c *importapi.ImportContainer) ImportContainer {
+	container := ImportContainer{
+		ID:           types.StringValue(c.Key),
+		Key:          types.StringValue(c.Key),
+		Version:      types.Int64Value(int64(c.Version)),
+		ResourceType: types.StringNull(),
+	}
+	if c.ResourceType != nil {
+		container.ResourceType = types.StringValue(string(*c.ResourceType))
+	}
+	return container
+}
+
+func (c ImportContainer) draft() importapi.ImportContainerDraft {
+	return importapi.ImportContainerDraft{
+		Key:          c.Key.ValueString(),
+		ResourceType: c.resourceType(),
+	}
+}
+
+// updateDraft returns the draft to update the container to the plan, or nil
+// if nothing changed. Only the resource type can be changed.
+func (c ImportContainer) updateDraft(plan ImportContainer) *importapi.ImportContainerUpdateDraft {
+	if c.ResourceType.Equal(plan.ResourceType) {
+		return nil
+	}
+	return &importapi.ImportContainerUpdateDraft{
+		Version:      int(c.Version.ValueInt64()),
+		ResourceType: plan.resourceType(),
+	}
+}
+
+func (c ImportContainer) resourceType() *importapi.ImportResourceType {
+	if c.ResourceType.IsNull() || c.ResourceType.IsUnknown() {
+		return nil
+	}
+	rt := importapi.ImportResourceType(c.ResourceType.ValueString())
+	return &rt
+}
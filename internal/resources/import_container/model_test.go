@@ -0,0 +1,72 @@
+package import_container
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/labd/commercetools-go-sdk/importapi"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewImportContainerFromNative(t *testing.T) {
+	resourceType := importapi.ImportResourceTypeProductDraft
+	tests := []struct {
+		name string
+		res  *importapi.ImportContainer
+		want ImportContainer
+	}{
+		{
+			name: "Default",
+			res: &importapi.ImportContainer{
+				Key:     "products",
+				Version: 1,
+			},
+			want: ImportContainer{
+				ID:           types.StringValue("products"),
+				Key:          types.StringValue("products"),
+				Version:      types.Int64Value(1),
+				ResourceType: types.StringNull(),
+			},
+		},
+		{
+			name: "With resource type",
+			res: &importapi.ImportContainer{
+				Key:          "products",
+				Version:      2,
+				ResourceType: &resourceType,
+			},
+			want: ImportContainer{
+				ID:           types.StringValue("products"),
+				Key:          types.StringValue("products"),
+				Version:      types.Int64Value(2),
+				ResourceType: types.StringValue("product-draft"),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, NewImportContainerFromNative(tt.res))
+		})
+	}
+}
+
+func TestUpdateDraft(t *testing.T) {
+	state := ImportContainer{
+		Key:          types.StringValue("products"),
+		Version:      types.Int64Value(3),
+		ResourceType: types.StringValue("product-draft"),
+	}
+
+	assert.Nil(t, state.updateDraft(state))
+
+	plan := state
+	plan.ResourceType = types.StringNull()
+	assert.Equal(t, &importapi.ImportContainerUpdateDraft{Version: 3}, state.updateDraft(plan))
+
+	plan.ResourceType = types.StringValue("price")
+	resourceType := importapi.ImportResourceTypePrice
+	assert.Equal(t, &importapi.ImportContainerUpdateDraft{
+		Version:      3,
+		ResourceType: &resourceType,
+	}, state.updateDraft(plan))
+}
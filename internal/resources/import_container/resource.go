@@ -0,0 +1,290 @@
+package import_container
+
+import (
+	"context"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/labd/commercetools-go-sdk/importapi"
+
+	"github.com/labd/terraform-provider-commercetools/internal/utils"
+)
+
+var (
+	_ resource.Resource                = &importContainerResource{}
+	_ resource.ResourceWithConfigure   = &importContainerResource{}
+	_ resource.ResourceWithImportState = &importContainerResource{}
+)
+
+// ResourceTypes lists the resource types supported by the Import API.
+var ResourceTypes = []string{
+	string(importapi.ImportResourceTypeCategory),
+	string(importapi.ImportResourceTypeCustomer),
+	string(importapi.ImportResourceTypeInventory),
+	string(importapi.ImportResourceTypeOrder),
+	string(importapi.ImportResourceTypeOrderPatch),
+	string(importapi.ImportResourceTypePrice),
+	string(importapi.ImportResourceTypeProduct),
+	string(importapi.ImportResourceTypeProductDraft),
+	string(importapi.ImportResourceTypeProductType),
+	string(importapi.ImportResourceTypeProductVariant),
+	string(importapi.ImportResourceTypeProductVariantPatch),
+	string(importapi.ImportResourceTypeStandalonePrice),
+	string(importapi.ImportResourceTypeType),
+}
+
+type importContainerResource struct {
+	client *importapi.ByProjectKeyRequestBuilder
+}
+
+// NewResource is a helper function to simplify the provider implementation.
+func NewResource() resource.Resource {
+	return &importContainerResource{}
+}
+
+// Schema implements resource.Resource.
+func (*importContainerResource) Schema(_ context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Import Containers are used to group import requests of the commercetools Import API. " +
+			"This resource uses the Import API, see the `import_api_url` provider setting.\n\n" +
+			"See also the [Import Container API Documentation](https://docs.commercetools.com/import-export/import-container)",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The key of the Import Container.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"version": schema.Int64Attribute{
+				Description: "Current version of the Import Container.",
+				Computed:    true,
+			},
+			"key": schema.StringAttribute{
+				Description: "User-defined unique identifier of the Import Container. Keys can only " +
+					"contain alphanumeric characters, underscores and hyphens.",
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(2, 256),
+					stringvalidator.RegexMatches(
+						regexp.MustCompile("^[A-Za-z0-9_-]+$"),
+						"Key can only contain alphanumeric characters, underscores and hyphens",
+					),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"resource_type": schema.StringAttribute{
+				Description: "The resource type the Import Container is able to handle. If not set the " +
+					"Import Container is able to import all of the supported resource types.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(ResourceTypes...),
+				},
+			},
+		},
+	}
+}
+
+// Metadata implements resource.Resource.
+func (*importContainerResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_import_container"
+}
+
+// Create implements resource.Resource.
+func (r *importContainerResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError("Error creating import container", utils.ImportClientMissingError)
+		return
+	}
+
+	var plan ImportContainer
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	draft := plan.draft()
+
+	var container *importapi.ImportContainer
+	err := retry.RetryContext(ctx, 20*time.Second, func() *retry.RetryError {
+		var err error
+		container, err = r.client.ImportContainers().Post(draft).Execute(ctx)
+		return utils.ProcessRemoteError(err)
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating import container",
+			err.Error(),
+		)
+		return
+	}
+
+	current := NewImportContainerFromNative(container)
+
+	diags = resp.State.Set(ctx, current)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete implements resource.Resource.
+func (r *importContainerResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError("Error deleting import container", utils.ImportClientMissingError)
+		return
+	}
+
+	// Get the current state.
+	var state ImportContainer
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := retry.RetryContext(
+		ctx,
+		5*time.Second,
+		func() *retry.RetryError {
+			_, err := r.client.ImportContainers().
+				WithImportContainerKeyValue(state.Key.ValueString()).
+				Delete().
+				Execute(ctx)
+
+			return utils.ProcessRemoteError(err)
+		})
+	if err != nil && !utils.IsResourceNotFoundError(err) {
+		resp.Diagnostics.AddError(
+			"Error deleting import container",
+			"Could not delete import container, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+// Read implements resource.Resource.
+func (r *importContainerResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError("Error reading import container", utils.ImportClientMissingError)
+		return
+	}
+
+	// Get the current state.
+	var state ImportContainer
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Read remote import container and check for errors.
+	container, err := r.client.ImportContainers().
+		WithImportContainerKeyValue(state.ID.ValueString()).
+		Get().
+		Execute(ctx)
+	if err != nil {
+		if utils.IsResourceNotFoundError(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			"Error reading import container",
+			"Could not retrieve the import container, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Transform the remote import container to the tf schema matching
+	// representation.
+	current := NewImportContainerFromNative(container)
+
+	// Set current data as state.
+	diags = resp.State.Set(ctx, &current)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update implements resource.Resource.
+func (r *importContainerResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError("Error updating import container", utils.ImportClientMissingError)
+		return
+	}
+
+	var plan ImportContainer
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state ImportContainer
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := state.updateDraft(plan)
+	if input == nil {
+		diags = resp.State.Set(ctx, state)
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	var container *importapi.ImportContainer
+	err := retry.RetryContext(ctx, 5*time.Second, func() *retry.RetryError {
+		var err error
+		container, err = r.client.ImportContainers().
+			WithImportContainerKeyValue(state.Key.ValueString()).
+			Put(*input).
+			Execute(ctx)
+
+		return utils.ProcessRemoteError(err)
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating import container",
+			"Could not update import container, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	current := NewImportContainerFromNative(container)
+
+	diags = resp.State.Set(ctx, current)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Configure implements resource.ResourceWithConfigure.
+func (r *importContainerResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data := req.ProviderData.(*utils.ProviderData)
+	r.client = data.ImportClient
+}
+
+// ImportState implements resource.ResourceWithImportState.
+func (*importContainerResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
@@ -0,0 +1,52 @@
+package import_container_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/labd/terraform-provider-commercetools/internal/acctest"
+	"github.com/labd/terraform-provider-commercetools/internal/utils"
+)
+
+func TestImportContainerResource_Create(t *testing.T) {
+	rn := "commercetools_import_container.products"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testImportContainerConfig("product-draft"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(rn, "id", "terraform-import-container"),
+					resource.TestCheckResourceAttr(rn, "key", "terraform-import-container"),
+					resource.TestCheckResourceAttr(rn, "resource_type", "product-draft"),
+					resource.TestCheckResourceAttr("data.commercetools_import_container_summary.products", "total", "0"),
+					resource.TestCheckResourceAttr("data.commercetools_import_container_summary.products", "resource_types.0.resource_type", "product-draft"),
+				),
+			},
+			{
+				Config: testImportContainerConfig("price"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(rn, "resource_type", "price"),
+				),
+			},
+		},
+	})
+}
+
+func testImportContainerConfig(resourceType string) string {
+	return utils.HCLTemplate(`
+		resource "commercetools_import_container" "products" {
+			key           = "terraform-import-container"
+			resource_type = "{{ .resource_type }}"
+		}
+
+		data "commercetools_import_container_summary" "products" {
+			key = commercetools_import_container.products.key
+		}
+	`, map[string]any{
+		"resource_type": resourceType,
+	})
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/labd/commercetools-go-sdk/history"
 	"github.com/labd/commercetools-go-sdk/platform"
 
 	"github.com/labd/terraform-provider-commercetools/internal/customtypes"
@@ -23,10 +24,13 @@ var (
 	_ resource.Resource                = &productSelectionResource{}
 	_ resource.ResourceWithConfigure   = &productSelectionResource{}
 	_ resource.ResourceWithImportState = &productSelectionResource{}
+	_ resource.ResourceWithModifyPlan  = &productSelectionResource{}
 )
 
 type productSelectionResource struct {
-	client *platform.ByProjectKeyRequestBuilder
+	client  *platform.ByProjectKeyRequestBuilder
+	history *history.ByProjectKeyRequestBuilder
+	locales *utils.LocaleValidator
 }
 
 // NewResource is a helper function to simplify the provider implementation.
@@ -177,6 +181,10 @@ func (r *productSelectionResource) Read(ctx context.Context, req resource.ReadRe
 	// Transform the remote platform product selection to the
 	// tf schema matching representation.
 	current := NewProductSelectionFromNative(productSelection)
+	resp.Diagnostics.Append(utils.LastModifiedByWarning(
+		ctx, r.history, history.ChangeHistoryResourceTypeProductSelection,
+		state.ID.ValueString(), state.Version, current.Version,
+	)...)
 
 	// Set current data as state.
 	diags = resp.State.Set(ctx, &current)
@@ -238,6 +246,14 @@ func (r *productSelectionResource) Configure(_ context.Context, req resource.Con
 
 	data := req.ProviderData.(*utils.ProviderData)
 	r.client = data.Client
+	r.history = data.DriftReporter()
+	r.locales = data.Locales
+}
+
+// ModifyPlan validates the locales of the localized strings when
+// validate_locales is enabled.
+func (r *productSelectionResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	resp.Diagnostics.Append(r.locales.ValidatePlan(ctx, req.Plan)...)
 }
 
 // ImportState implements resource.ResourceWithImportState.
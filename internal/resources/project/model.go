@@ -15,6 +15,12 @@ const (
 	DefaultDeleteDaysAfterCreation = 15
 )
 
+var roundingModes = []string{
+	string(platform.RoundingModeHalfEven),
+	string(platform.RoundingModeHalfUp),
+	string(platform.RoundingModeHalfDown),
+}
+
 type Project struct {
 	ID      types.String `tfsdk:"id"`
 	Key     types.String `tfsdk:"key"`
@@ -25,8 +31,9 @@ type Project struct {
 	Countries  []types.String `tfsdk:"countries"`
 	Languages  []types.String `tfsdk:"languages"`
 
-	EnableSearchIndexProducts types.Bool `tfsdk:"enable_search_index_products"`
-	EnableSearchIndexOrders   types.Bool `tfsdk:"enable_search_index_orders"`
+	EnableSearchIndexProducts  types.Bool `tfsdk:"enable_search_index_products"`
+	EnableSearchIndexOrders    types.Bool `tfsdk:"enable_search_index_orders"`
+	EnableSearchIndexCustomers types.Bool `tfsdk:"enable_search_index_customers"`
 
 	// These items all have maximal one item. We don't use SingleNestedBlock
 	// here since it isn't quite robust currently.
@@ -34,6 +41,8 @@ type Project struct {
 	Carts         []Carts         `tfsdk:"carts"`
 	Messages      []Messages      `tfsdk:"messages"`
 	ExternalOAuth []ExternalOAuth `tfsdk:"external_oauth"`
+	BusinessUnits []BusinessUnits `tfsdk:"business_units"`
+	ShoppingLists []ShoppingLists `tfsdk:"shopping_lists"`
 
 	ShippingRateInputType               types.String                           `tfsdk:"shipping_rate_input_type"`
 	ShippingRateCartClassificationValue []models.CustomFieldLocalizedEnumValue `tfsdk:"shipping_rate_cart_classification_value"`
@@ -50,13 +59,17 @@ func NewProjectFromNative(n *platform.Project) Project {
 		Countries:  pie.Map(n.Countries, types.StringValue),
 		Languages:  pie.Map(n.Languages, types.StringValue),
 
-		EnableSearchIndexProducts: types.BoolValue(false),
-		EnableSearchIndexOrders:   types.BoolValue(false),
+		EnableSearchIndexProducts:  types.BoolValue(false),
+		EnableSearchIndexOrders:    types.BoolValue(false),
+		EnableSearchIndexCustomers: types.BoolValue(false),
 
 		Carts: []Carts{
 			{
 				DeleteDaysAfterLastModification: utils.FromOptionalInt(n.Carts.DeleteDaysAfterLastModification),
 				CountryTaxRateFallbackEnabled:   utils.FromOptionalBool(n.Carts.CountryTaxRateFallbackEnabled),
+				PriceRoundingMode:               types.StringNull(),
+				TaxRoundingMode:                 types.StringNull(),
+				TaxCalculationMode:              types.StringNull(),
 			},
 		},
 		Messages: []Messages{
@@ -66,6 +79,8 @@ func NewProjectFromNative(n *platform.Project) Project {
 			},
 		},
 		ExternalOAuth: []ExternalOAuth{},
+		BusinessUnits: []BusinessUnits{},
+		ShoppingLists: []ShoppingLists{},
 	}
 
 	// always set it to an empty list to avoid the wrong comparison in the update actions part
@@ -112,6 +127,44 @@ func NewProjectFromNative(n *platform.Project) Project {
 		}
 	}
 
+	if n.BusinessUnits != nil {
+		res.BusinessUnits = []BusinessUnits{
+			{
+				MyBusinessUnitStatusOnCreation:           types.StringValue(string(n.BusinessUnits.MyBusinessUnitStatusOnCreation)),
+				MyBusinessUnitAssociateRoleKeyOnCreation: types.StringNull(),
+			},
+		}
+		if n.BusinessUnits.MyBusinessUnitAssociateRoleOnCreation != nil {
+			res.BusinessUnits[0].MyBusinessUnitAssociateRoleKeyOnCreation = types.StringValue(
+				n.BusinessUnits.MyBusinessUnitAssociateRoleOnCreation.Key)
+		}
+	}
+
+	if n.ShoppingLists != nil {
+		res.ShoppingLists = []ShoppingLists{
+			{
+				DeleteDaysAfterLastModification: utils.FromOptionalInt(n.ShoppingLists.DeleteDaysAfterLastModification),
+			},
+		}
+	}
+
+	return res
+}
+
+// newProjectFromRemote creates the project including the fields which are not
+// supported by the SDK yet.
+func newProjectFromRemote(n *remoteProject) Project {
+	res := NewProjectFromNative(&n.Project)
+
+	res.Carts[0].PriceRoundingMode = utils.FromOptionalString((*string)(n.PriceRoundingMode))
+	res.Carts[0].TaxRoundingMode = utils.FromOptionalString((*string)(n.TaxRoundingMode))
+	res.Carts[0].TaxCalculationMode = utils.FromOptionalString((*string)(n.TaxCalculationMode))
+
+	if n.CustomerSearchStatus != nil {
+		enabled := *n.CustomerSearchStatus != platform.SearchIndexingConfigurationStatusDeactivated
+		res.EnableSearchIndexCustomers = types.BoolValue(enabled)
+	}
+
 	return res
 }
 
@@ -120,6 +173,21 @@ func (p *Project) setStateData(o Project) {
 		p.ExternalOAuth[0].AuthorizationHeader = o.ExternalOAuth[0].AuthorizationHeader
 	}
 
+	// The rounding and tax calculation modes are always returned by
+	// commercetools, so they are only managed when they are set
+	if len(p.Carts) > 0 {
+		prior := firstItem(o.Carts)
+		if prior.PriceRoundingMode.IsNull() {
+			p.Carts[0].PriceRoundingMode = types.StringNull()
+		}
+		if prior.TaxRoundingMode.IsNull() {
+			p.Carts[0].TaxRoundingMode = types.StringNull()
+		}
+		if prior.TaxCalculationMode.IsNull() {
+			p.Carts[0].TaxCalculationMode = types.StringNull()
+		}
+	}
+
 	// If the state has no data for carts (0 items) and the configuration is the
 	// default we match the state
 	if p.Carts[0].isDefault() && (len(o.Carts) == 0 || o.Carts[0].isDefault()) {
@@ -137,6 +205,22 @@ func (p *Project) setStateData(o Project) {
 	if len(p.Messages) > 0 && p.Messages[0].isDefault() && (len(o.Messages) == 0 || o.Messages[0].isDefault()) {
 		p.Messages = o.Messages
 	}
+
+	// The business unit and shopping list settings are only managed when the
+	// block is set, the same goes for the attributes of the business units
+	if len(o.BusinessUnits) == 0 {
+		p.BusinessUnits = o.BusinessUnits
+	} else if len(p.BusinessUnits) > 0 {
+		if o.BusinessUnits[0].MyBusinessUnitStatusOnCreation.IsNull() {
+			p.BusinessUnits[0].MyBusinessUnitStatusOnCreation = types.StringNull()
+		}
+		if o.BusinessUnits[0].MyBusinessUnitAssociateRoleKeyOnCreation.IsNull() {
+			p.BusinessUnits[0].MyBusinessUnitAssociateRoleKeyOnCreation = types.StringNull()
+		}
+	}
+	if len(o.ShoppingLists) == 0 {
+		p.ShoppingLists = o.ShoppingLists
+	}
 }
 
 func (p *Project) updateActions(plan Project) platform.ProjectUpdate {
@@ -145,11 +229,22 @@ func (p *Project) updateActions(plan Project) platform.ProjectUpdate {
 		Actions: []platform.ProjectUpdateAction{},
 	}
 
+	currentCarts, plannedCarts := firstItem(p.Carts), firstItem(plan.Carts)
+	currentBusinessUnits, plannedBusinessUnits := firstItem(p.BusinessUnits), firstItem(plan.BusinessUnits)
+
 	// changeMyBusinessUnitStatusOnCreation
-	// TODO
+	if !plannedBusinessUnits.MyBusinessUnitStatusOnCreation.IsNull() &&
+		!plannedBusinessUnits.MyBusinessUnitStatusOnCreation.Equal(currentBusinessUnits.MyBusinessUnitStatusOnCreation) {
+		result.Actions = append(result.Actions,
+			platform.ProjectChangeBusinessUnitStatusOnCreationAction{
+				Status: platform.BusinessUnitConfigurationStatus(
+					plannedBusinessUnits.MyBusinessUnitStatusOnCreation.ValueString()),
+			},
+		)
+	}
 
 	// changeCartsConfiguration
-	if !reflect.DeepEqual(p.Carts, plan.Carts) {
+	if !reflect.DeepEqual(cartsConfiguration(p.Carts), cartsConfiguration(plan.Carts)) {
 		if len(plan.Carts) == 0 {
 			result.Actions = append(result.Actions,
 				platform.ProjectChangeCartsConfigurationAction{
@@ -196,6 +291,19 @@ func (p *Project) updateActions(plan Project) platform.ProjectUpdate {
 		)
 	}
 
+	// changeCustomerSearchStatus
+	if !(p.EnableSearchIndexCustomers.ValueBool() == plan.EnableSearchIndexCustomers.ValueBool()) {
+		status := CustomerSearchStatusDeactivated
+		if plan.EnableSearchIndexCustomers.ValueBool() {
+			status = CustomerSearchStatusActivated
+		}
+		result.Actions = append(result.Actions,
+			ProjectChangeCustomerSearchStatusAction{
+				Status: status,
+			},
+		)
+	}
+
 	// changeLanguages
 	if !reflect.DeepEqual(p.Languages, plan.Languages) {
 		result.Actions = append(result.Actions,
@@ -250,6 +358,16 @@ func (p *Project) updateActions(plan Project) platform.ProjectUpdate {
 		)
 	}
 
+	// changePriceRoundingMode
+	if !plannedCarts.PriceRoundingMode.IsNull() &&
+		!plannedCarts.PriceRoundingMode.Equal(currentCarts.PriceRoundingMode) {
+		result.Actions = append(result.Actions,
+			ProjectChangePriceRoundingModeAction{
+				PriceRoundingMode: platform.RoundingMode(plannedCarts.PriceRoundingMode.ValueString()),
+			},
+		)
+	}
+
 	// changeProductSearchIndexingEnabled
 	if !(p.EnableSearchIndexProducts.ValueBool() == plan.EnableSearchIndexProducts.ValueBool()) {
 		result.Actions = append(result.Actions,
@@ -260,7 +378,33 @@ func (p *Project) updateActions(plan Project) platform.ProjectUpdate {
 	}
 
 	// changeShoppingListsConfiguration
-	// TODO
+	if len(plan.ShoppingLists) > 0 && !reflect.DeepEqual(p.ShoppingLists, plan.ShoppingLists) {
+		result.Actions = append(result.Actions,
+			platform.ProjectChangeShoppingListsConfigurationAction{
+				ShoppingListsConfiguration: plan.ShoppingLists[0].toNative(),
+			},
+		)
+	}
+
+	// changeTaxCalculationMode
+	if !plannedCarts.TaxCalculationMode.IsNull() &&
+		!plannedCarts.TaxCalculationMode.Equal(currentCarts.TaxCalculationMode) {
+		result.Actions = append(result.Actions,
+			ProjectChangeTaxCalculationModeAction{
+				TaxCalculationMode: platform.TaxCalculationMode(plannedCarts.TaxCalculationMode.ValueString()),
+			},
+		)
+	}
+
+	// changeTaxRoundingMode
+	if !plannedCarts.TaxRoundingMode.IsNull() &&
+		!plannedCarts.TaxRoundingMode.Equal(currentCarts.TaxRoundingMode) {
+		result.Actions = append(result.Actions,
+			ProjectChangeTaxRoundingModeAction{
+				TaxRoundingMode: platform.RoundingMode(plannedCarts.TaxRoundingMode.ValueString()),
+			},
+		)
+	}
 
 	// setExternalOAuth
 	if !reflect.DeepEqual(p.ExternalOAuth, plan.ExternalOAuth) {
@@ -275,6 +419,19 @@ func (p *Project) updateActions(plan Project) platform.ProjectUpdate {
 		)
 	}
 
+	// setMyBusinessUnitAssociateRoleOnCreation
+	if !plannedBusinessUnits.MyBusinessUnitAssociateRoleKeyOnCreation.IsNull() &&
+		!plannedBusinessUnits.MyBusinessUnitAssociateRoleKeyOnCreation.Equal(
+			currentBusinessUnits.MyBusinessUnitAssociateRoleKeyOnCreation) {
+		result.Actions = append(result.Actions,
+			platform.ProjectSetBusinessUnitAssociateRoleOnCreationAction{
+				AssociateRole: platform.AssociateRoleResourceIdentifier{
+					Key: plannedBusinessUnits.MyBusinessUnitAssociateRoleKeyOnCreation.ValueStringPointer(),
+				},
+			},
+		)
+	}
+
 	// setShippingRateInputType
 	if !p.ShippingRateInputType.Equal(plan.ShippingRateInputType) ||
 		!reflect.DeepEqual(p.ShippingRateCartClassificationValue, plan.ShippingRateCartClassificationValue) {
@@ -338,13 +495,19 @@ func (e ExternalOAuth) toNative() *platform.ExternalOAuth {
 }
 
 type Carts struct {
-	CountryTaxRateFallbackEnabled   types.Bool  `tfsdk:"country_tax_rate_fallback_enabled"`
-	DeleteDaysAfterLastModification types.Int64 `tfsdk:"delete_days_after_last_modification"`
+	CountryTaxRateFallbackEnabled   types.Bool   `tfsdk:"country_tax_rate_fallback_enabled"`
+	DeleteDaysAfterLastModification types.Int64  `tfsdk:"delete_days_after_last_modification"`
+	PriceRoundingMode               types.String `tfsdk:"price_rounding_mode"`
+	TaxRoundingMode                 types.String `tfsdk:"tax_rounding_mode"`
+	TaxCalculationMode              types.String `tfsdk:"tax_calculation_mode"`
 }
 
 func (c Carts) isDefault() bool {
 	return !c.CountryTaxRateFallbackEnabled.ValueBool() &&
-		c.DeleteDaysAfterLastModification.IsNull()
+		c.DeleteDaysAfterLastModification.IsNull() &&
+		c.PriceRoundingMode.IsNull() &&
+		c.TaxRoundingMode.IsNull() &&
+		c.TaxCalculationMode.IsNull()
 }
 
 func (c Carts) toNative() platform.CartsConfiguration {
@@ -353,3 +516,39 @@ func (c Carts) toNative() platform.CartsConfiguration {
 		CountryTaxRateFallbackEnabled:   utils.BoolRef(c.CountryTaxRateFallbackEnabled.ValueBool()),
 	}
 }
+
+// cartsConfiguration returns the carts without the rounding and tax
+// calculation modes, since these are changed with their own update actions.
+func cartsConfiguration(carts []Carts) []Carts {
+	return pie.Map(carts, func(c Carts) Carts {
+		return Carts{
+			CountryTaxRateFallbackEnabled:   c.CountryTaxRateFallbackEnabled,
+			DeleteDaysAfterLastModification: c.DeleteDaysAfterLastModification,
+		}
+	})
+}
+
+type BusinessUnits struct {
+	MyBusinessUnitStatusOnCreation           types.String `tfsdk:"my_business_unit_status_on_creation"`
+	MyBusinessUnitAssociateRoleKeyOnCreation types.String `tfsdk:"my_business_unit_associate_role_key_on_creation"`
+}
+
+type ShoppingLists struct {
+	DeleteDaysAfterLastModification types.Int64 `tfsdk:"delete_days_after_last_modification"`
+}
+
+func (s ShoppingLists) toNative() platform.ShoppingListsConfiguration {
+	return platform.ShoppingListsConfiguration{
+		DeleteDaysAfterLastModification: utils.OptionalInt(s.DeleteDaysAfterLastModification),
+	}
+}
+
+// firstItem returns the first item of a block with maximal one item, or the
+// zero value when the block is not set.
+func firstItem[T any](items []T) T {
+	var result T
+	if len(items) > 0 {
+		result = items[0]
+	}
+	return result
+}
@@ -32,14 +32,76 @@ func TestNewProjectFromNative(t *testing.T) {
 				Key:     types.StringValue("my-project"),
 				Name:    types.StringValue("my project"),
 
-				EnableSearchIndexProducts: types.BoolValue(false),
-				EnableSearchIndexOrders:   types.BoolValue(false),
+				EnableSearchIndexProducts:  types.BoolValue(false),
+				EnableSearchIndexOrders:    types.BoolValue(false),
+				EnableSearchIndexCustomers: types.BoolValue(false),
+
+				ExternalOAuth: []ExternalOAuth{},
+				BusinessUnits: []BusinessUnits{},
+				ShoppingLists: []ShoppingLists{},
+				Carts: []Carts{
+					{
+						CountryTaxRateFallbackEnabled:   types.BoolNull(),
+						DeleteDaysAfterLastModification: types.Int64Null(),
+						PriceRoundingMode:               types.StringNull(),
+						TaxRoundingMode:                 types.StringNull(),
+						TaxCalculationMode:              types.StringNull(),
+					},
+				},
+				Messages: []Messages{
+					{
+						Enabled:                 types.BoolValue(false),
+						DeleteDaysAfterCreation: types.Int64Value(DefaultDeleteDaysAfterCreation),
+					},
+				},
+				ShippingRateCartClassificationValue: []models.CustomFieldLocalizedEnumValue{},
+			},
+		},
+		{
+			name: "Business units and shopping lists",
+			res: &platform.Project{
+				Version: 1,
+				Key:     "my-project",
+				Name:    "my project",
+				BusinessUnits: &platform.BusinessUnitConfiguration{
+					MyBusinessUnitStatusOnCreation: platform.BusinessUnitConfigurationStatusActive,
+					MyBusinessUnitAssociateRoleOnCreation: &platform.AssociateRoleKeyReference{
+						Key: "admin",
+					},
+				},
+				ShoppingLists: &platform.ShoppingListsConfiguration{
+					DeleteDaysAfterLastModification: utils.IntRef(30),
+				},
+			},
+			want: Project{
+				Version: types.Int64Value(1),
+				ID:      types.StringValue("my-project"),
+				Key:     types.StringValue("my-project"),
+				Name:    types.StringValue("my project"),
+
+				EnableSearchIndexProducts:  types.BoolValue(false),
+				EnableSearchIndexOrders:    types.BoolValue(false),
+				EnableSearchIndexCustomers: types.BoolValue(false),
 
 				ExternalOAuth: []ExternalOAuth{},
+				BusinessUnits: []BusinessUnits{
+					{
+						MyBusinessUnitStatusOnCreation:           types.StringValue("Active"),
+						MyBusinessUnitAssociateRoleKeyOnCreation: types.StringValue("admin"),
+					},
+				},
+				ShoppingLists: []ShoppingLists{
+					{
+						DeleteDaysAfterLastModification: types.Int64Value(30),
+					},
+				},
 				Carts: []Carts{
 					{
 						CountryTaxRateFallbackEnabled:   types.BoolNull(),
 						DeleteDaysAfterLastModification: types.Int64Null(),
+						PriceRoundingMode:               types.StringNull(),
+						TaxRoundingMode:                 types.StringNull(),
+						TaxCalculationMode:              types.StringNull(),
 					},
 				},
 				Messages: []Messages{
@@ -175,6 +237,105 @@ func TestUpdateActions(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "Rounding and tax calculation modes",
+			state: Project{
+				Version: types.Int64Value(1),
+				Carts: []Carts{
+					{
+						CountryTaxRateFallbackEnabled: types.BoolValue(true),
+						PriceRoundingMode:             types.StringValue("HalfEven"),
+						TaxRoundingMode:               types.StringNull(),
+						TaxCalculationMode:            types.StringValue("LineItemLevel"),
+					},
+				},
+			},
+			plan: Project{
+				Version: types.Int64Value(1),
+				Carts: []Carts{
+					{
+						CountryTaxRateFallbackEnabled: types.BoolValue(true),
+						PriceRoundingMode:             types.StringValue("HalfUp"),
+						TaxRoundingMode:               types.StringValue("HalfDown"),
+						TaxCalculationMode:            types.StringNull(),
+					},
+				},
+			},
+			action: platform.ProjectUpdate{
+				Version: 1,
+				Actions: []platform.ProjectUpdateAction{
+					ProjectChangePriceRoundingModeAction{PriceRoundingMode: platform.RoundingModeHalfUp},
+					ProjectChangeTaxRoundingModeAction{TaxRoundingMode: platform.RoundingModeHalfDown},
+				},
+			},
+		},
+		{
+			name: "Business units, shopping lists and customer search",
+			state: Project{
+				Version: types.Int64Value(1),
+				BusinessUnits: []BusinessUnits{
+					{
+						MyBusinessUnitStatusOnCreation:           types.StringValue("Inactive"),
+						MyBusinessUnitAssociateRoleKeyOnCreation: types.StringNull(),
+					},
+				},
+				ShoppingLists: []ShoppingLists{
+					{DeleteDaysAfterLastModification: types.Int64Value(360)},
+				},
+				EnableSearchIndexCustomers: types.BoolValue(false),
+			},
+			plan: Project{
+				Version: types.Int64Value(1),
+				BusinessUnits: []BusinessUnits{
+					{
+						MyBusinessUnitStatusOnCreation:           types.StringValue("Active"),
+						MyBusinessUnitAssociateRoleKeyOnCreation: types.StringValue("admin"),
+					},
+				},
+				ShoppingLists: []ShoppingLists{
+					{DeleteDaysAfterLastModification: types.Int64Value(30)},
+				},
+				EnableSearchIndexCustomers: types.BoolValue(true),
+			},
+			action: platform.ProjectUpdate{
+				Version: 1,
+				Actions: []platform.ProjectUpdateAction{
+					platform.ProjectChangeBusinessUnitStatusOnCreationAction{
+						Status: platform.BusinessUnitConfigurationStatusActive,
+					},
+					ProjectChangeCustomerSearchStatusAction{Status: CustomerSearchStatusActivated},
+					platform.ProjectChangeShoppingListsConfigurationAction{
+						ShoppingListsConfiguration: platform.ShoppingListsConfiguration{
+							DeleteDaysAfterLastModification: utils.IntRef(30),
+						},
+					},
+					platform.ProjectSetBusinessUnitAssociateRoleOnCreationAction{
+						AssociateRole: platform.AssociateRoleResourceIdentifier{Key: utils.StringRef("admin")},
+					},
+				},
+			},
+		},
+		{
+			name: "Unmanaged settings",
+			state: Project{
+				Version: types.Int64Value(1),
+				BusinessUnits: []BusinessUnits{
+					{MyBusinessUnitStatusOnCreation: types.StringValue("Active")},
+				},
+				ShoppingLists: []ShoppingLists{
+					{DeleteDaysAfterLastModification: types.Int64Value(360)},
+				},
+			},
+			plan: Project{
+				Version:       types.Int64Value(1),
+				BusinessUnits: []BusinessUnits{},
+				ShoppingLists: []ShoppingLists{},
+			},
+			action: platform.ProjectUpdate{
+				Version: 1,
+				Actions: []platform.ProjectUpdateAction{},
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -246,6 +407,61 @@ func TestSetStateData(t *testing.T) {
 				},
 				Carts: nil,
 			},
+		}, {
+			name: "unmanaged settings",
+			state: Project{
+				Carts: []Carts{
+					{
+						CountryTaxRateFallbackEnabled: types.BoolValue(true),
+						PriceRoundingMode:             types.StringValue("HalfEven"),
+						TaxRoundingMode:               types.StringValue("HalfEven"),
+						TaxCalculationMode:            types.StringValue("LineItemLevel"),
+					},
+				},
+				BusinessUnits: []BusinessUnits{
+					{
+						MyBusinessUnitStatusOnCreation:           types.StringValue("Inactive"),
+						MyBusinessUnitAssociateRoleKeyOnCreation: types.StringValue("admin"),
+					},
+				},
+				ShoppingLists: []ShoppingLists{
+					{DeleteDaysAfterLastModification: types.Int64Value(360)},
+				},
+			},
+			plan: Project{
+				Carts: []Carts{
+					{
+						CountryTaxRateFallbackEnabled: types.BoolValue(true),
+						PriceRoundingMode:             types.StringNull(),
+						TaxRoundingMode:               types.StringValue("HalfEven"),
+						TaxCalculationMode:            types.StringNull(),
+					},
+				},
+				BusinessUnits: []BusinessUnits{
+					{
+						MyBusinessUnitStatusOnCreation:           types.StringValue("Active"),
+						MyBusinessUnitAssociateRoleKeyOnCreation: types.StringNull(),
+					},
+				},
+				ShoppingLists: []ShoppingLists{},
+			},
+			expected: Project{
+				Carts: []Carts{
+					{
+						CountryTaxRateFallbackEnabled: types.BoolValue(true),
+						PriceRoundingMode:             types.StringNull(),
+						TaxRoundingMode:               types.StringValue("HalfEven"),
+						TaxCalculationMode:            types.StringNull(),
+					},
+				},
+				BusinessUnits: []BusinessUnits{
+					{
+						MyBusinessUnitStatusOnCreation:           types.StringValue("Inactive"),
+						MyBusinessUnitAssociateRoleKeyOnCreation: types.StringNull(),
+					},
+				},
+				ShoppingLists: []ShoppingLists{},
+			},
 		},
 	}
 	for _, tt := range tests {
@@ -0,0 +1,113 @@
+package project
+
+import (
+	"encoding/json"
+
+	"github.com/labd/commercetools-go-sdk/platform"
+)
+
+// The rounding and tax calculation modes of carts and the search indexing of
+// customers are not supported by the SDK yet, so the types below extend the
+// SDK types with these fields. They can be removed once the SDK supports
+// them.
+
+// CustomerSearchStatus is the status of the customer search of a project.
+type CustomerSearchStatus string
+
+const (
+	CustomerSearchStatusActivated   CustomerSearchStatus = "Activated"
+	CustomerSearchStatusDeactivated CustomerSearchStatus = "Deactivated"
+)
+
+// remoteProject is a project as returned by the API, including the fields
+// which are not supported by the SDK.
+type remoteProject struct {
+	platform.Project
+	PriceRoundingMode    *platform.RoundingMode
+	TaxRoundingMode      *platform.RoundingMode
+	TaxCalculationMode   *platform.TaxCalculationMode
+	CustomerSearchStatus *platform.SearchIndexingConfigurationStatus
+}
+
+func (r *remoteProject) UnmarshalJSON(data []byte) error {
+	if err := json.Unmarshal(data, &r.Project); err != nil {
+		return err
+	}
+
+	var extra struct {
+		Carts struct {
+			PriceRoundingMode  *platform.RoundingMode       `json:"priceRoundingMode"`
+			TaxRoundingMode    *platform.RoundingMode       `json:"taxRoundingMode"`
+			TaxCalculationMode *platform.TaxCalculationMode `json:"taxCalculationMode"`
+		} `json:"carts"`
+		SearchIndexing struct {
+			Customers *platform.SearchIndexingConfigurationValues `json:"customers"`
+		} `json:"searchIndexing"`
+	}
+	if err := json.Unmarshal(data, &extra); err != nil {
+		return err
+	}
+	r.PriceRoundingMode = extra.Carts.PriceRoundingMode
+	r.TaxRoundingMode = extra.Carts.TaxRoundingMode
+	r.TaxCalculationMode = extra.Carts.TaxCalculationMode
+	if extra.SearchIndexing.Customers != nil {
+		r.CustomerSearchStatus = extra.SearchIndexing.Customers.Status
+	}
+	return nil
+}
+
+// ProjectChangePriceRoundingModeAction changes the default rounding mode of
+// prices in carts.
+type ProjectChangePriceRoundingModeAction struct {
+	PriceRoundingMode platform.RoundingMode `json:"priceRoundingMode"`
+}
+
+func (obj ProjectChangePriceRoundingModeAction) MarshalJSON() ([]byte, error) {
+	type Alias ProjectChangePriceRoundingModeAction
+	return json.Marshal(struct {
+		Action string `json:"action"`
+		*Alias
+	}{Action: "changePriceRoundingMode", Alias: (*Alias)(&obj)})
+}
+
+// ProjectChangeTaxRoundingModeAction changes the default rounding mode of
+// taxes in carts.
+type ProjectChangeTaxRoundingModeAction struct {
+	TaxRoundingMode platform.RoundingMode `json:"taxRoundingMode"`
+}
+
+func (obj ProjectChangeTaxRoundingModeAction) MarshalJSON() ([]byte, error) {
+	type Alias ProjectChangeTaxRoundingModeAction
+	return json.Marshal(struct {
+		Action string `json:"action"`
+		*Alias
+	}{Action: "changeTaxRoundingMode", Alias: (*Alias)(&obj)})
+}
+
+// ProjectChangeTaxCalculationModeAction changes the default tax calculation
+// mode of carts.
+type ProjectChangeTaxCalculationModeAction struct {
+	TaxCalculationMode platform.TaxCalculationMode `json:"taxCalculationMode"`
+}
+
+func (obj ProjectChangeTaxCalculationModeAction) MarshalJSON() ([]byte, error) {
+	type Alias ProjectChangeTaxCalculationModeAction
+	return json.Marshal(struct {
+		Action string `json:"action"`
+		*Alias
+	}{Action: "changeTaxCalculationMode", Alias: (*Alias)(&obj)})
+}
+
+// ProjectChangeCustomerSearchStatusAction activates or deactivates the
+// customer search.
+type ProjectChangeCustomerSearchStatusAction struct {
+	Status CustomerSearchStatus `json:"status"`
+}
+
+func (obj ProjectChangeCustomerSearchStatusAction) MarshalJSON() ([]byte, error) {
+	type Alias ProjectChangeCustomerSearchStatusAction
+	return json.Marshal(struct {
+		Action string `json:"action"`
+		*Alias
+	}{Action: "changeCustomerSearchStatus", Alias: (*Alias)(&obj)})
+}
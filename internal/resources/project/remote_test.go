@@ -0,0 +1,76 @@
+package project
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/labd/commercetools-go-sdk/platform"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRemoteProject(t *testing.T) {
+	var remote remoteProject
+	err := json.Unmarshal([]byte(`{
+		"version": 3,
+		"key": "my-project",
+		"name": "my project",
+		"countries": [],
+		"currencies": ["EUR"],
+		"languages": ["en"],
+		"createdAt": "2024-01-01T00:00:00.000Z",
+		"messages": {"enabled": false},
+		"carts": {
+			"countryTaxRateFallbackEnabled": true,
+			"priceRoundingMode": "HalfUp",
+			"taxRoundingMode": "HalfDown",
+			"taxCalculationMode": "UnitPriceLevel"
+		},
+		"searchIndexing": {
+			"customers": {"status": "Activated"}
+		}
+	}`), &remote)
+	require.NoError(t, err)
+	assert.Equal(t, 3, remote.Version)
+
+	current := newProjectFromRemote(&remote)
+	assert.Equal(t, Carts{
+		CountryTaxRateFallbackEnabled:   types.BoolValue(true),
+		DeleteDaysAfterLastModification: types.Int64Null(),
+		PriceRoundingMode:               types.StringValue("HalfUp"),
+		TaxRoundingMode:                 types.StringValue("HalfDown"),
+		TaxCalculationMode:              types.StringValue("UnitPriceLevel"),
+	}, current.Carts[0])
+	assert.Equal(t, types.BoolValue(true), current.EnableSearchIndexCustomers)
+}
+
+func TestProjectUpdateActions(t *testing.T) {
+	testCases := []struct {
+		action   platform.ProjectUpdateAction
+		expected string
+	}{
+		{
+			ProjectChangePriceRoundingModeAction{PriceRoundingMode: platform.RoundingModeHalfUp},
+			`{"action": "changePriceRoundingMode", "priceRoundingMode": "HalfUp"}`,
+		},
+		{
+			ProjectChangeTaxRoundingModeAction{TaxRoundingMode: platform.RoundingModeHalfEven},
+			`{"action": "changeTaxRoundingMode", "taxRoundingMode": "HalfEven"}`,
+		},
+		{
+			ProjectChangeTaxCalculationModeAction{TaxCalculationMode: platform.TaxCalculationModeUnitPriceLevel},
+			`{"action": "changeTaxCalculationMode", "taxCalculationMode": "UnitPriceLevel"}`,
+		},
+		{
+			ProjectChangeCustomerSearchStatusAction{Status: CustomerSearchStatusDeactivated},
+			`{"action": "changeCustomerSearchStatus", "status": "Deactivated"}`,
+		},
+	}
+
+	for _, tc := range testCases {
+		data, err := json.Marshal(tc.action)
+		require.NoError(t, err)
+		assert.JSONEq(t, tc.expected, string(data))
+	}
+}
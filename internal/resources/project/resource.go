@@ -2,6 +2,7 @@ package project
 
 import (
 	"context"
+	"net/http"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
@@ -30,6 +31,7 @@ var (
 	_ resource.Resource                = &ProjectResource{}
 	_ resource.ResourceWithConfigure   = &ProjectResource{}
 	_ resource.ResourceWithImportState = &ProjectResource{}
+	_ resource.ResourceWithModifyPlan  = &ProjectResource{}
 )
 
 // NewOrderResource is a helper function to simplify the provider implementation.
@@ -39,7 +41,8 @@ func NewResource() resource.Resource {
 
 // orderResource is the resource implementation.
 type ProjectResource struct {
-	client *platform.ByProjectKeyRequestBuilder
+	raw             *utils.RawClient
+	validateLocales bool
 }
 
 // Metadata returns the data source type name.
@@ -54,7 +57,7 @@ func (r *ProjectResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 			"the project. Updating the settings is eventually consistent, it may take up to a minute before " +
 			"a change becomes fully active.\n\n" +
 			"See also the [Project Settings API Documentation](https://docs.commercetools.com/api/projects/project)",
-		Version: 1,
+		Version: 2,
 		Attributes: map[string]schema.Attribute{
 			// The ID is only here to make testing framework happy.
 			"id": schema.StringAttribute{
@@ -128,6 +131,14 @@ func (r *ProjectResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 					boolplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"enable_search_index_customers": schema.BoolAttribute{
+				Description: "Enable the Search Indexing of customers",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
 			"shipping_rate_input_type": schema.StringAttribute{
 				Description: "Three ways to dynamically select a ShippingRatePriceTier exist. The CartValue type uses " +
 					"the sum of all line item prices, whereas CartClassification and CartScore use the " +
@@ -166,6 +177,78 @@ func (r *ProjectResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 								"projects created after December 2019.",
 							Optional: true,
 						},
+						"price_rounding_mode": schema.StringAttribute{
+							Description: "The default rounding mode for calculating prices of carts. When not set " +
+								"the rounding mode is not managed",
+							Optional: true,
+							Validators: []validator.String{
+								stringvalidator.OneOf(roundingModes...),
+							},
+						},
+						"tax_rounding_mode": schema.StringAttribute{
+							Description: "The default rounding mode for calculating taxes of carts. When not set " +
+								"the rounding mode is not managed",
+							Optional: true,
+							Validators: []validator.String{
+								stringvalidator.OneOf(roundingModes...),
+							},
+						},
+						"tax_calculation_mode": schema.StringAttribute{
+							Description: "The default tax calculation mode of carts. When not set the tax " +
+								"calculation mode is not managed",
+							Optional: true,
+							Validators: []validator.String{
+								stringvalidator.OneOf(
+									string(platform.TaxCalculationModeLineItemLevel),
+									string(platform.TaxCalculationModeUnitPriceLevel),
+								),
+							},
+						},
+					},
+				},
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+			},
+			"business_units": schema.ListNestedBlock{
+				MarkdownDescription: "[Business Unit Configuration](https://docs.commercetools.com/api/projects/project#businessunitconfiguration). " +
+					"When the block is not set the business unit settings are not managed",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"my_business_unit_status_on_creation": schema.StringAttribute{
+							Description: "Status of business units created using the My Business Unit endpoint",
+							Optional:    true,
+							Validators: []validator.String{
+								stringvalidator.OneOf(
+									string(platform.BusinessUnitConfigurationStatusActive),
+									string(platform.BusinessUnitConfigurationStatusInactive),
+								),
+							},
+						},
+						"my_business_unit_associate_role_key_on_creation": schema.StringAttribute{
+							Description: "Key of the default associate role assigned to the associate creating a " +
+								"business unit using the My Business Unit endpoint",
+							Optional: true,
+						},
+					},
+				},
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+			},
+			"shopping_lists": schema.ListNestedBlock{
+				MarkdownDescription: "[Shopping Lists Configuration](https://docs.commercetools.com/api/projects/project#shoppinglistsconfiguration). " +
+					"When the block is not set the shopping list settings are not managed",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"delete_days_after_last_modification": schema.Int64Attribute{
+							Description: "The default value for the deleteDaysAfterLastModification parameter " +
+								"of the ShoppingListDraft",
+							Optional: true,
+							Validators: []validator.Int64{
+								int64validator.AtLeast(1),
+							},
+						},
 					},
 				},
 				Validators: []validator.List{
@@ -260,7 +343,35 @@ func (r *ProjectResource) Configure(_ context.Context, req resource.ConfigureReq
 		return
 	}
 	data := req.ProviderData.(*utils.ProviderData)
-	r.client = data.Client
+	r.raw = data.RawClient
+	r.validateLocales = data.Locales != nil
+}
+
+// ModifyPlan validates the locales of the localized strings when
+// validate_locales is enabled. The project defines the languages itself, so
+// the planned languages are used instead of the current ones.
+func (r *ProjectResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if !r.validateLocales || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan Project
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if len(plan.Languages) == 0 {
+		return
+	}
+	languages := make([]string, 0, len(plan.Languages))
+	for _, l := range plan.Languages {
+		if l.IsUnknown() {
+			return
+		}
+		languages = append(languages, l.ValueString())
+	}
+	resp.Diagnostics.Append(utils.ValidatePlanLocales(ctx, req.Plan, languages)...)
 }
 
 func (p *ProjectResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
@@ -268,6 +379,9 @@ func (p *ProjectResource) UpgradeState(ctx context.Context) map[int64]resource.S
 		0: {
 			StateUpgrader: upgradeStateV0,
 		},
+		1: {
+			StateUpgrader: upgradeStateV1,
+		},
 	}
 }
 
@@ -281,7 +395,7 @@ func (r *ProjectResource) Create(ctx context.Context, req resource.CreateRequest
 		return
 	}
 
-	project, err := r.client.Get().Execute(ctx)
+	project, err := r.get(ctx)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error reading project",
@@ -289,13 +403,12 @@ func (r *ProjectResource) Create(ctx context.Context, req resource.CreateRequest
 		)
 		return
 	}
-	current := NewProjectFromNative(project)
+	current := newProjectFromRemote(project)
 
 	input := current.updateActions(plan)
-	var res *platform.Project
+	var res remoteProject
 	err = sdk_resource.RetryContext(ctx, 5*time.Second, func() *sdk_resource.RetryError {
-		var err error
-		res, err = r.client.Post(input).Execute(ctx)
+		err := r.raw.Do(ctx, http.MethodPost, "", input, &res)
 		return utils.ProcessRemoteError(err)
 	})
 	if err != nil {
@@ -303,7 +416,7 @@ func (r *ProjectResource) Create(ctx context.Context, req resource.CreateRequest
 		return
 	}
 
-	result := NewProjectFromNative(res)
+	result := newProjectFromRemote(&res)
 	result.setStateData(plan)
 
 	// Set state to fully populated data
@@ -324,7 +437,7 @@ func (r *ProjectResource) Read(ctx context.Context, req resource.ReadRequest, re
 		return
 	}
 
-	res, err := r.client.Get().Execute(ctx)
+	res, err := r.get(ctx)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error reading project",
@@ -332,7 +445,7 @@ func (r *ProjectResource) Read(ctx context.Context, req resource.ReadRequest, re
 		)
 		return
 	}
-	current := NewProjectFromNative(res)
+	current := newProjectFromRemote(res)
 	current.setStateData(state)
 
 	// Set refreshed state
@@ -363,17 +476,16 @@ func (r *ProjectResource) Update(ctx context.Context, req resource.UpdateRequest
 
 	input := state.updateActions(plan)
 
-	var res *platform.Project
+	var res remoteProject
 	err := sdk_resource.RetryContext(ctx, 5*time.Second, func() *sdk_resource.RetryError {
-		var err error
-		res, err = r.client.Post(input).Execute(ctx)
+		err := r.raw.Do(ctx, http.MethodPost, "", input, &res)
 		return utils.ProcessRemoteError(err)
 	})
 	if err != nil {
 		resp.Diagnostics.AddError("Error updating project", err.Error())
 		return
 	}
-	result := NewProjectFromNative(res)
+	result := newProjectFromRemote(&res)
 	result.setStateData(plan)
 
 	diags = resp.State.Set(ctx, result)
@@ -399,3 +511,13 @@ func (r *ProjectResource) ImportState(ctx context.Context, req resource.ImportSt
 	// Retrieve import ID and save to id attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
+
+// get retrieves the project including the fields which are not supported by
+// the SDK yet.
+func (r *ProjectResource) get(ctx context.Context) (*remoteProject, error) {
+	var project remoteProject
+	if err := r.raw.Do(ctx, http.MethodGet, "", nil, &project); err != nil {
+		return nil, err
+	}
+	return &project, nil
+}
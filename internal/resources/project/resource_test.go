@@ -3,6 +3,7 @@ package project_test
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -112,6 +113,18 @@ func TestAccProjectCreate_basic(t *testing.T) {
 						resourceName, "carts.0.country_tax_rate_fallback_enabled", "false"),
 					resource.TestCheckResourceAttr(
 						resourceName, "carts.0.delete_days_after_last_modification", "21"),
+					resource.TestCheckResourceAttr(
+						resourceName, "carts.0.price_rounding_mode", "HalfUp"),
+					resource.TestCheckResourceAttr(
+						resourceName, "carts.0.tax_rounding_mode", "HalfDown"),
+					resource.TestCheckResourceAttr(
+						resourceName, "carts.0.tax_calculation_mode", "UnitPriceLevel"),
+					resource.TestCheckResourceAttr(
+						resourceName, "business_units.0.my_business_unit_status_on_creation", "Active"),
+					resource.TestCheckResourceAttr(
+						resourceName, "shopping_lists.0.delete_days_after_last_modification", "30"),
+					resource.TestCheckResourceAttr(
+						resourceName, "enable_search_index_customers", "true"),
 				),
 			},
 			{
@@ -167,6 +180,39 @@ func TestAccProjectCreate_basic(t *testing.T) {
 	})
 }
 
+// TestAccProjectSettings_shippingRateClassification uses the shipping rate
+// cart classification values of the project in a shipping zone rate. Values
+// which are not in the project are rejected during plan.
+func TestAccProjectSettings_shippingRateClassification(t *testing.T) {
+	resourceName := "commercetools_shipping_zone_rate.classification"
+	classifications := []string{"Small", "Heavy"}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckProjectDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProjectShippingRateClassificationConfig(classifications, nil),
+				Check: resource.TestCheckResourceAttr(
+					"commercetools_project_settings.classification", "shipping_rate_cart_classification_value.#", "2",
+				),
+			},
+			{
+				Config: testAccProjectShippingRateClassificationConfig(classifications, []string{"Small", "Heavy"}),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "shipping_rate_price_tier.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "shipping_rate_price_tier.1.value", "Heavy"),
+				),
+			},
+			{
+				Config:      testAccProjectShippingRateClassificationConfig(classifications, []string{"Small", "Huge"}),
+				ExpectError: regexp.MustCompile(`"Huge" is not a shipping_rate_cart_classification_value`),
+			},
+		},
+	})
+}
+
 func testAccCheckProjectDestroy(s *terraform.State) error {
 	return nil
 }
@@ -219,10 +265,22 @@ func testAccProjectConfigUpdate(identifier string) string {
 			carts {
 				country_tax_rate_fallback_enabled = false
 				delete_days_after_last_modification = 21
+				price_rounding_mode = "HalfUp"
+				tax_rounding_mode = "HalfDown"
+				tax_calculation_mode = "UnitPriceLevel"
+			}
+
+			business_units {
+				my_business_unit_status_on_creation = "Active"
+			}
+
+			shopping_lists {
+				delete_days_after_last_modification = 30
 			}
 
 			enable_search_index_products = true
 			enable_search_index_orders = true
+			enable_search_index_customers = true
 
 			shipping_rate_input_type = "CartClassification"
 			shipping_rate_cart_classification_value {
@@ -268,3 +326,70 @@ func testAccProjectConfigDeleteOAuthAndCarts(identifier string) string {
 		"identifier": identifier,
 	})
 }
+
+func testAccProjectShippingRateClassificationConfig(classifications, tiers []string) string {
+	return utils.HCLTemplate(`
+		resource "commercetools_project_settings" "classification" {
+			name       = "Test this thing"
+			countries  = ["NL", "DE", "US"]
+			currencies = ["EUR", "USD"]
+			languages  = ["nl", "de", "en", "en-US"]
+
+			shipping_rate_input_type = "CartClassification"
+			{{ range .classifications }}
+			shipping_rate_cart_classification_value {
+				key = "{{ . }}"
+				label = {
+					"en" = "{{ . }}"
+				}
+			}
+			{{ end }}
+		}
+
+		resource "commercetools_tax_category" "classification" {
+			name = "tf-acc-classification"
+			key  = "tf-acc-classification"
+		}
+
+		resource "commercetools_shipping_method" "classification" {
+			name            = "tf-acc-classification"
+			key             = "tf-acc-classification"
+			tax_category_id = commercetools_tax_category.classification.id
+		}
+
+		resource "commercetools_shipping_zone" "classification" {
+			name = "tf-acc-classification"
+			location {
+				country = "DE"
+			}
+		}
+
+		{{ if .tiers }}
+		resource "commercetools_shipping_zone_rate" "classification" {
+			shipping_method_id = commercetools_shipping_method.classification.id
+			shipping_zone_id   = commercetools_shipping_zone.classification.id
+
+			price {
+				cent_amount   = 5000
+				currency_code = "EUR"
+			}
+
+			{{ range .tiers }}
+			shipping_rate_price_tier {
+				type  = "CartClassification"
+				value = "{{ . }}"
+
+				price {
+					cent_amount   = 1000
+					currency_code = "EUR"
+				}
+			}
+			{{ end }}
+
+			depends_on = [commercetools_project_settings.classification]
+		}
+		{{ end }}`, map[string]any{
+		"classifications": classifications,
+		"tiers":           tiers,
+	})
+}
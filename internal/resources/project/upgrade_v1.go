@@ -106,7 +106,7 @@ var ProjectResourceDataV1 = tftypes.Object{
 
 // Move from version 0 to current. Version 1 changed some items from single
 // blocks to lists with a max of 1. This was needed since sdk v2 did only
-// support that approach.
+// support that approach. The result is upgraded to version 2 as well.
 // Moved from v0 to v1 in v1.0.0.pre0, see https://github.com/labd/terraform-provider-commercetools/pull/196
 func upgradeStateV0(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
 	rawStateValue, err := req.RawState.Unmarshal(ProjectResourceDataV0)
@@ -127,29 +127,35 @@ func upgradeStateV0(ctx context.Context, req resource.UpgradeStateRequest, resp
 		return
 	}
 
-	dynamicValue, err := tfprotov6.NewDynamicValue(
-		ProjectResourceDataV1,
-		tftypes.NewValue(ProjectResourceDataV1, map[string]tftypes.Value{
-			"id":         rawState["id"],
-			"key":        rawState["key"],
-			"version":    rawState["version"],
-			"name":       rawState["name"],
-			"currencies": rawState["currencies"],
-			"countries":  rawState["countries"],
-			"languages":  rawState["languages"],
-
-			"carts":          valueToList(rawState, "carts"),
-			"messages":       valueToList(rawState, "messages"),
-			"external_oauth": valueToList(rawState, "external_oauth"),
-
-			"shipping_rate_input_type":                rawState["shipping_rate_input_type"],
-			"shipping_rate_cart_classification_value": rawState["shipping_rate_cart_classification_value"],
-
-			// Values that didn't exist yet
-			"enable_search_index_products": tftypes.NewValue(tftypes.Bool, tftypes.UnknownValue),
-			"enable_search_index_orders":   tftypes.NewValue(tftypes.Bool, tftypes.UnknownValue),
-		}),
-	)
+	upgraded, err := upgradeDataV1(map[string]tftypes.Value{
+		"id":         rawState["id"],
+		"key":        rawState["key"],
+		"version":    rawState["version"],
+		"name":       rawState["name"],
+		"currencies": rawState["currencies"],
+		"countries":  rawState["countries"],
+		"languages":  rawState["languages"],
+
+		"carts":          valueToList(rawState, "carts"),
+		"messages":       valueToList(rawState, "messages"),
+		"external_oauth": valueToList(rawState, "external_oauth"),
+
+		"shipping_rate_input_type":                rawState["shipping_rate_input_type"],
+		"shipping_rate_cart_classification_value": rawState["shipping_rate_cart_classification_value"],
+
+		// Values that didn't exist yet
+		"enable_search_index_products": tftypes.NewValue(tftypes.Bool, tftypes.UnknownValue),
+		"enable_search_index_orders":   tftypes.NewValue(tftypes.Bool, tftypes.UnknownValue),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Convert Prior State",
+			err.Error(),
+		)
+		return
+	}
+
+	dynamicValue, err := tfprotov6.NewDynamicValue(ProjectResourceDataV2, upgraded)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to Convert Upgraded State",
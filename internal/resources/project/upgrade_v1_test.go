@@ -63,14 +63,20 @@ func Test_upgradeStateV0(t *testing.T) {
 		Countries:  []types.String{},
 		Languages:  []types.String{types.StringValue("nl")},
 
-		EnableSearchIndexProducts: types.BoolUnknown(),
-		EnableSearchIndexOrders:   types.BoolUnknown(),
+		EnableSearchIndexProducts:  types.BoolUnknown(),
+		EnableSearchIndexOrders:    types.BoolUnknown(),
+		EnableSearchIndexCustomers: types.BoolUnknown(),
 
 		ExternalOAuth: []ExternalOAuth{},
+		BusinessUnits: []BusinessUnits{},
+		ShoppingLists: []ShoppingLists{},
 		Carts: []Carts{
 			{
 				CountryTaxRateFallbackEnabled:   types.BoolValue(false),
 				DeleteDaysAfterLastModification: types.Int64Value(10),
+				PriceRoundingMode:               types.StringNull(),
+				TaxRoundingMode:                 types.StringNull(),
+				TaxCalculationMode:              types.StringNull(),
 			},
 		},
 		Messages: []Messages{
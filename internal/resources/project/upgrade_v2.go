@@ -0,0 +1,174 @@
+package project
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var ProjectResourceDataV2 = tftypes.Object{
+	AttributeTypes: map[string]tftypes.Type{
+		"id":         tftypes.String,
+		"key":        tftypes.String,
+		"version":    tftypes.Number,
+		"name":       tftypes.String,
+		"currencies": tftypes.List{ElementType: tftypes.String},
+		"countries":  tftypes.List{ElementType: tftypes.String},
+		"languages":  tftypes.List{ElementType: tftypes.String},
+
+		"enable_search_index_products":  tftypes.Bool,
+		"enable_search_index_orders":    tftypes.Bool,
+		"enable_search_index_customers": tftypes.Bool,
+
+		"carts": tftypes.List{
+			ElementType: tftypes.Object{
+				AttributeTypes: map[string]tftypes.Type{
+					"country_tax_rate_fallback_enabled":   tftypes.Bool,
+					"delete_days_after_last_modification": tftypes.Number,
+					"price_rounding_mode":                 tftypes.String,
+					"tax_rounding_mode":                   tftypes.String,
+					"tax_calculation_mode":                tftypes.String,
+				},
+			},
+		},
+		"messages": tftypes.List{
+			ElementType: tftypes.Object{
+				AttributeTypes: map[string]tftypes.Type{
+					"enabled":                    tftypes.Bool,
+					"delete_days_after_creation": tftypes.Number,
+				},
+			},
+		},
+		"external_oauth": tftypes.List{
+			ElementType: tftypes.Object{
+				AttributeTypes: map[string]tftypes.Type{
+					"url":                  tftypes.String,
+					"authorization_header": tftypes.String,
+				},
+			},
+		},
+		"business_units": tftypes.List{
+			ElementType: tftypes.Object{
+				AttributeTypes: map[string]tftypes.Type{
+					"my_business_unit_status_on_creation":             tftypes.String,
+					"my_business_unit_associate_role_key_on_creation": tftypes.String,
+				},
+			},
+		},
+		"shopping_lists": tftypes.List{
+			ElementType: tftypes.Object{
+				AttributeTypes: map[string]tftypes.Type{
+					"delete_days_after_last_modification": tftypes.Number,
+				},
+			},
+		},
+		"shipping_rate_input_type": tftypes.String,
+		"shipping_rate_cart_classification_value": tftypes.List{
+			ElementType: tftypes.Object{
+				AttributeTypes: map[string]tftypes.Type{
+					"key": tftypes.String,
+					"label": tftypes.Map{
+						ElementType: tftypes.String,
+					},
+				},
+			},
+		},
+	},
+}
+
+// Move from version 1 to current. Version 2 added the business unit and
+// shopping list settings, the rounding and tax calculation modes of carts and
+// the search indexing of customers. These are not managed until they are set,
+// so existing state gets empty values for them.
+func upgradeStateV1(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+	rawStateValue, err := req.RawState.Unmarshal(ProjectResourceDataV1)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Unmarshal Prior State",
+			err.Error(),
+		)
+		return
+	}
+
+	var rawState map[string]tftypes.Value
+	if err := rawStateValue.As(&rawState); err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Convert Prior State",
+			err.Error(),
+		)
+		return
+	}
+
+	upgraded, err := upgradeDataV1(rawState)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Convert Prior State",
+			err.Error(),
+		)
+		return
+	}
+
+	dynamicValue, err := tfprotov6.NewDynamicValue(ProjectResourceDataV2, upgraded)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Convert Upgraded State",
+			err.Error(),
+		)
+		return
+	}
+
+	resp.DynamicValue = &dynamicValue
+}
+
+// upgradeDataV1 converts the attributes of version 1 to a version 2 value.
+func upgradeDataV1(state map[string]tftypes.Value) (tftypes.Value, error) {
+	carts, err := upgradeCartsV1(state["carts"])
+	if err != nil {
+		return tftypes.Value{}, err
+	}
+
+	result := make(map[string]tftypes.Value, len(ProjectResourceDataV2.AttributeTypes))
+	for key, value := range state {
+		result[key] = value
+	}
+	result["carts"] = carts
+	result["business_units"] = tftypes.NewValue(ProjectResourceDataV2.AttributeTypes["business_units"], []tftypes.Value{})
+	result["shopping_lists"] = tftypes.NewValue(ProjectResourceDataV2.AttributeTypes["shopping_lists"], []tftypes.Value{})
+
+	// Values that didn't exist yet
+	result["enable_search_index_customers"] = tftypes.NewValue(tftypes.Bool, tftypes.UnknownValue)
+
+	return tftypes.NewValue(ProjectResourceDataV2, result), nil
+}
+
+// upgradeCartsV1 adds the rounding and tax calculation modes to the carts.
+func upgradeCartsV1(value tftypes.Value) (tftypes.Value, error) {
+	listType := ProjectResourceDataV2.AttributeTypes["carts"]
+	if !value.IsKnown() {
+		return tftypes.NewValue(listType, tftypes.UnknownValue), nil
+	}
+	if value.IsNull() {
+		return tftypes.NewValue(listType, nil), nil
+	}
+
+	var items []tftypes.Value
+	if err := value.As(&items); err != nil {
+		return tftypes.Value{}, err
+	}
+
+	elementType := listType.(tftypes.List).ElementType
+	result := make([]tftypes.Value, len(items))
+	for i, item := range items {
+		var attrs map[string]tftypes.Value
+		if err := item.As(&attrs); err != nil {
+			return tftypes.Value{}, err
+		}
+		attrs["price_rounding_mode"] = tftypes.NewValue(tftypes.String, nil)
+		attrs["tax_rounding_mode"] = tftypes.NewValue(tftypes.String, nil)
+		attrs["tax_calculation_mode"] = tftypes.NewValue(tftypes.String, nil)
+		result[i] = tftypes.NewValue(elementType, attrs)
+	}
+	return tftypes.NewValue(listType, result), nil
+}
@@ -0,0 +1,106 @@
+package project
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/labd/terraform-provider-commercetools/internal/models"
+)
+
+func Test_upgradeStateV1(t *testing.T) {
+	oldState := []byte(`
+	  {
+		"carts":[
+			{
+				"country_tax_rate_fallback_enabled":true,
+				"delete_days_after_last_modification":null
+			}
+		],
+		"countries":["NL"],
+		"currencies":["EUR"],
+		"enable_search_index_orders":true,
+		"enable_search_index_products":false,
+		"external_oauth":[],
+		"id":"my-project",
+		"key":"my-project",
+		"languages":["nl"],
+		"messages":[
+			{
+				"delete_days_after_creation":15,
+				"enabled":true
+			}
+		],
+		"name":"My Project",
+		"shipping_rate_cart_classification_value":[],
+		"shipping_rate_input_type":"CartValue",
+		"version":12
+	  }
+	`)
+
+	expected := Project{
+		Version: types.Int64Value(12),
+		ID:      types.StringValue("my-project"),
+		Key:     types.StringValue("my-project"),
+		Name:    types.StringValue("My Project"),
+
+		Currencies: []types.String{types.StringValue("EUR")},
+		Countries:  []types.String{types.StringValue("NL")},
+		Languages:  []types.String{types.StringValue("nl")},
+
+		EnableSearchIndexProducts:  types.BoolValue(false),
+		EnableSearchIndexOrders:    types.BoolValue(true),
+		EnableSearchIndexCustomers: types.BoolUnknown(),
+
+		ExternalOAuth: []ExternalOAuth{},
+		BusinessUnits: []BusinessUnits{},
+		ShoppingLists: []ShoppingLists{},
+		Carts: []Carts{
+			{
+				CountryTaxRateFallbackEnabled:   types.BoolValue(true),
+				DeleteDaysAfterLastModification: types.Int64Null(),
+				PriceRoundingMode:               types.StringNull(),
+				TaxRoundingMode:                 types.StringNull(),
+				TaxCalculationMode:              types.StringNull(),
+			},
+		},
+		Messages: []Messages{
+			{
+				Enabled:                 types.BoolValue(true),
+				DeleteDaysAfterCreation: types.Int64Value(15),
+			},
+		},
+		ShippingRateInputType:               types.StringValue("CartValue"),
+		ShippingRateCartClassificationValue: []models.CustomFieldLocalizedEnumValue{},
+	}
+
+	ctx := context.Background()
+	req := resource.UpgradeStateRequest{
+		RawState: &tfprotov6.RawState{
+			JSON: oldState,
+		},
+	}
+	resp := resource.UpgradeStateResponse{}
+	upgradeStateV1(ctx, req, &resp)
+	require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics.Errors())
+	require.NotNil(t, resp.DynamicValue)
+
+	s := getCurrentSchema()
+	upgradedStateValue, err := resp.DynamicValue.Unmarshal(s.Type().TerraformType(ctx))
+	require.NoError(t, err)
+	state := tfsdk.State{
+		Raw:    upgradedStateValue,
+		Schema: s,
+	}
+
+	res := Project{}
+	diags := state.Get(ctx, &res)
+	require.False(t, diags.HasError(), diags.Errors())
+	assert.Equal(t, expected, res)
+}
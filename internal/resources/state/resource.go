@@ -15,6 +15,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	sdk_resource "github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/labd/commercetools-go-sdk/history"
 	"github.com/labd/commercetools-go-sdk/platform"
 
 	"github.com/labd/terraform-provider-commercetools/internal/customtypes"
@@ -26,6 +27,7 @@ var (
 	_ resource.Resource                = &stateResource{}
 	_ resource.ResourceWithConfigure   = &stateResource{}
 	_ resource.ResourceWithImportState = &stateResource{}
+	_ resource.ResourceWithModifyPlan  = &stateResource{}
 )
 
 // NewStateResource is a helper function to simplify the provider implementation.
@@ -35,8 +37,10 @@ func NewResource() resource.Resource {
 
 // stateResource is the resource implementation.
 type stateResource struct {
-	client *platform.ByProjectKeyRequestBuilder
-	mutex  *utils.MutexKV
+	client  *platform.ByProjectKeyRequestBuilder
+	history *history.ByProjectKeyRequestBuilder
+	mutex   *utils.MutexKV
+	locales *utils.LocaleValidator
 }
 
 // Metadata returns the data source type name.
@@ -126,7 +130,15 @@ func (r *stateResource) Configure(_ context.Context, req resource.ConfigureReque
 	}
 	data := req.ProviderData.(*utils.ProviderData)
 	r.client = data.Client
+	r.history = data.DriftReporter()
 	r.mutex = data.Mutex
+	r.locales = data.Locales
+}
+
+// ModifyPlan validates the locales of the localized strings when
+// validate_locales is enabled.
+func (r *stateResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	resp.Diagnostics.Append(r.locales.ValidatePlan(ctx, req.Plan)...)
 }
 
 // Create creates the resource and sets the initial Terraform state.
@@ -190,6 +202,10 @@ func (r *stateResource) Read(ctx context.Context, req resource.ReadRequest, resp
 	}
 
 	current := NewStateFromNative(res)
+	resp.Diagnostics.Append(utils.LastModifiedByWarning(
+		ctx, r.history, history.ChangeHistoryResourceTypeState,
+		state.ID.ValueString(), state.Version, current.Version,
+	)...)
 	current.matchDefaults(state)
 
 	// Set refreshed state
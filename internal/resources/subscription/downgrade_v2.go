@@ -91,6 +91,14 @@ func downgradeStateV2(_ context.Context, req resource.UpgradeStateRequest, resp
 			"destination": valueDestinationV1(rawState, "destination"),
 			"format":      valueToFormatV1(rawState, "format"),
 			"message":     rawState["message"],
+			"event": tftypes.NewValue(
+				SubscriptionResourceV1.AttributeTypes["event"],
+				[]tftypes.Value{},
+			),
+			"test_delivery": tftypes.NewValue(
+				SubscriptionResourceV1.AttributeTypes["test_delivery"],
+				[]tftypes.Value{},
+			),
 		}),
 	)
 	if err != nil {
@@ -0,0 +1,75 @@
+package subscription
+
+import (
+	"encoding/json"
+
+	"github.com/labd/commercetools-go-sdk/platform"
+)
+
+// The events of a subscription are not supported by the SDK yet, so the
+// types below extend the SDK types with the events field. They can be
+// removed once the SDK supports events.
+
+// EventSubscription is the native representation of an event subscription.
+type EventSubscription struct {
+	ResourceTypeId string   `json:"resourceTypeId"`
+	Types          []string `json:"types"`
+}
+
+// remoteSubscription is a subscription as returned by the API, including
+// the events.
+type remoteSubscription struct {
+	platform.Subscription
+	Events []EventSubscription
+}
+
+func (r *remoteSubscription) UnmarshalJSON(data []byte) error {
+	if err := json.Unmarshal(data, &r.Subscription); err != nil {
+		return err
+	}
+
+	var extra struct {
+		Events []EventSubscription `json:"events"`
+	}
+	if err := json.Unmarshal(data, &extra); err != nil {
+		return err
+	}
+	r.Events = extra.Events
+	return nil
+}
+
+// subscriptionDraft is the draft of a subscription including the events.
+type subscriptionDraft struct {
+	platform.SubscriptionDraft
+	Events []EventSubscription
+}
+
+func (d subscriptionDraft) MarshalJSON() ([]byte, error) {
+	data, err := json.Marshal(d.SubscriptionDraft)
+	if err != nil {
+		return nil, err
+	}
+	if len(d.Events) == 0 {
+		return data, nil
+	}
+
+	var raw map[string]any
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return nil, err
+	}
+	raw["events"] = d.Events
+	return json.Marshal(raw)
+}
+
+// SubscriptionSetEventsAction sets the events of the subscription.
+type SubscriptionSetEventsAction struct {
+	Events []EventSubscription `json:"events"`
+}
+
+func (obj SubscriptionSetEventsAction) MarshalJSON() ([]byte, error) {
+	type Alias SubscriptionSetEventsAction
+	return json.Marshal(struct {
+		Action string `json:"action"`
+		*Alias
+	}{Action: "setEvents", Alias: (*Alias)(&obj)})
+}
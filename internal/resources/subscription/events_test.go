@@ -0,0 +1,72 @@
+package subscription
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/labd/commercetools-go-sdk/platform"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSubscriptionDraftEvents(t *testing.T) {
+	s := Subscription{
+		Key: types.StringValue("my-subscription"),
+		Destination: []Destination{{
+			Type:     types.StringValue(SQS),
+			QueueURL: types.StringValue("https://sqs.eu-west-1.amazonaws.com/000000000000/my-queue"),
+			Region:   types.StringValue("eu-west-1"),
+		}},
+		Events: []Event{{
+			ResourceTypeID: types.StringValue("import-api"),
+			Types:          []types.String{types.StringValue("ImportContainerCreated")},
+		}},
+	}
+
+	data, err := json.Marshal(s.draft())
+	require.NoError(t, err)
+	assert.JSONEq(t, `{
+		"key": "my-subscription",
+		"destination": {
+			"type": "SQS",
+			"queueUrl": "https://sqs.eu-west-1.amazonaws.com/000000000000/my-queue",
+			"region": "eu-west-1",
+			"authenticationMode": "IAM"
+		},
+		"events": [{"resourceTypeId": "import-api", "types": ["ImportContainerCreated"]}]
+	}`, string(data))
+
+	s.Events = nil
+	data, err = json.Marshal(s.draft())
+	require.NoError(t, err)
+	assert.NotContains(t, string(data), "events")
+}
+
+func TestRemoteSubscription(t *testing.T) {
+	var remote remoteSubscription
+	err := json.Unmarshal([]byte(`{
+		"id": "1",
+		"version": 2,
+		"destination": {"type": "SQS", "queueUrl": "https://example.com/queue", "region": "eu-west-1"},
+		"format": {"type": "Platform"},
+		"messages": [],
+		"changes": [],
+		"events": [{"resourceTypeId": "checkout", "types": ["CheckoutOrderCreationFailed"]}]
+	}`), &remote)
+	require.NoError(t, err)
+	assert.Equal(t, "1", remote.ID)
+	assert.IsType(t, platform.SqsDestination{}, remote.Destination)
+
+	current := newSubscriptionFromRemote(&remote)
+	assert.Equal(t, []Event{{
+		ResourceTypeID: types.StringValue("checkout"),
+		Types:          []types.String{types.StringValue("CheckoutOrderCreationFailed")},
+	}}, current.Events)
+}
+
+func TestSubscriptionSetEventsAction(t *testing.T) {
+	data, err := json.Marshal(SubscriptionSetEventsAction{Events: []EventSubscription{}})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"action": "setEvents", "events": []}`, string(data))
+}
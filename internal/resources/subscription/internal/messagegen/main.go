@@ -0,0 +1,187 @@
+// Command messagegen generates the catalogue of message types per resource
+// type from the message structs of the commercetools SDK. It is invoked via
+// go generate from the subscription package:
+//
+//	go generate ./internal/resources/subscription/
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"log"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const sdkModule = "github.com/labd/commercetools-go-sdk"
+
+// resourceTypes are the resource type ids which can be used in a message
+// subscription, the message type is matched on the longest prefix.
+var resourceTypes = []string{
+	"approval-flow",
+	"approval-rule",
+	"associate-role",
+	"business-unit",
+	"cart-discount",
+	"category",
+	"customer",
+	"customer-group",
+	"discount-code",
+	"inventory-entry",
+	"order",
+	"payment",
+	"product",
+	"product-selection",
+	"product-tailoring",
+	"quote",
+	"quote-request",
+	"review",
+	"staged-quote",
+	"standalone-price",
+	"store",
+}
+
+// overrides contains the message types which don't start with the name of
+// the resource type they are published for.
+var overrides = map[string]string{
+	"CustomerGroupSet": "customer",
+}
+
+func main() {
+	output := "message_types.go"
+	if len(os.Args) > 1 {
+		output = os.Args[1]
+	}
+
+	dir, version, err := sdkDir()
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, filepath.Join(dir, "platform", "types_message.go"), nil, 0)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	catalogue := map[string][]string{}
+	for _, decl := range file.Decls {
+		fn, ok := decl.(*ast.FuncDecl)
+		if !ok {
+			continue
+		}
+		switch fn.Name.Name {
+		case "mapDiscriminatorMessage":
+			for _, name := range discriminators(fn) {
+				rt, err := resourceTypeOf(name)
+				if err != nil {
+					log.Fatal(err)
+				}
+				catalogue[rt] = append(catalogue[rt], name)
+			}
+		case "mapDiscriminatorOrderMessage":
+			catalogue["order"] = append(catalogue["order"], discriminators(fn)...)
+		}
+	}
+
+	src, err := render(catalogue, version)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile(output, src, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// sdkDir returns the location and version of the SDK module used by the
+// provider.
+func sdkDir() (string, string, error) {
+	out, err := exec.Command("go", "list", "-m", "-f", "{{.Dir}} {{.Version}}", sdkModule).Output()
+	if err != nil {
+		return "", "", fmt.Errorf("unable to locate %s: %w", sdkModule, err)
+	}
+	dir, version, _ := strings.Cut(strings.TrimSpace(string(out)), " ")
+	return dir, version, nil
+}
+
+// discriminators returns the string values of the case clauses in the
+// discriminator switch.
+func discriminators(fn *ast.FuncDecl) []string {
+	var result []string
+	ast.Inspect(fn, func(n ast.Node) bool {
+		clause, ok := n.(*ast.CaseClause)
+		if !ok {
+			return true
+		}
+		for _, expr := range clause.List {
+			lit, ok := expr.(*ast.BasicLit)
+			if !ok || lit.Kind != token.STRING {
+				continue
+			}
+			if value, err := strconv.Unquote(lit.Value); err == nil {
+				result = append(result, value)
+			}
+		}
+		return true
+	})
+	return result
+}
+
+func resourceTypeOf(message string) (string, error) {
+	if rt, ok := overrides[message]; ok {
+		return rt, nil
+	}
+	match := ""
+	for _, rt := range resourceTypes {
+		if strings.HasPrefix(message, camelCase(rt)) && len(rt) > len(match) {
+			match = rt
+		}
+	}
+	if match == "" {
+		return "", fmt.Errorf("unable to determine the resource type of message %s", message)
+	}
+	return match, nil
+}
+
+// camelCase converts a resource type id such as cart-discount to CartDiscount
+func camelCase(s string) string {
+	parts := strings.Split(s, "-")
+	for i, p := range parts {
+		parts[i] = strings.ToUpper(p[:1]) + p[1:]
+	}
+	return strings.Join(parts, "")
+}
+
+func render(catalogue map[string][]string, version string) ([]byte, error) {
+	keys := make([]string, 0, len(catalogue))
+	for k := range catalogue {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "// Code generated by messagegen from %s@%s; DO NOT EDIT.\n\n", sdkModule, version)
+	buf.WriteString("package subscription\n\n")
+	buf.WriteString("// messageTypes contains the message types per resource type which can be\n")
+	buf.WriteString("// used in a message subscription.\n")
+	buf.WriteString("var messageTypes = map[string][]string{\n")
+	for _, k := range keys {
+		values := catalogue[k]
+		sort.Strings(values)
+		fmt.Fprintf(&buf, "%q: {\n", k)
+		for _, v := range values {
+			fmt.Fprintf(&buf, "%q,\n", v)
+		}
+		buf.WriteString("},\n")
+	}
+	buf.WriteString("}\n")
+	return format.Source(buf.Bytes())
+}
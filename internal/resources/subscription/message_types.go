@@ -0,0 +1,278 @@
+// Code generated by messagegen from github.com/labd/commercetools-go-sdk@v1.5.1; DO NOT EDIT.
+
+package subscription
+
+// messageTypes contains the message types per resource type which can be
+// used in a message subscription.
+var messageTypes = map[string][]string{
+	"approval-flow": {
+		"ApprovalFlowApproved",
+		"ApprovalFlowCompleted",
+		"ApprovalFlowCreated",
+		"ApprovalFlowRejected",
+	},
+	"approval-rule": {
+		"ApprovalRuleApproversSet",
+		"ApprovalRuleCreated",
+		"ApprovalRuleDescriptionSet",
+		"ApprovalRuleKeySet",
+		"ApprovalRuleNameSet",
+		"ApprovalRulePredicateSet",
+		"ApprovalRuleRequestersSet",
+		"ApprovalRuleStatusSet",
+	},
+	"associate-role": {
+		"AssociateRoleBuyerAssignableChanged",
+		"AssociateRoleCreated",
+		"AssociateRoleDeleted",
+		"AssociateRoleNameSet",
+		"AssociateRolePermissionAdded",
+		"AssociateRolePermissionRemoved",
+		"AssociateRolePermissionsSet",
+	},
+	"business-unit": {
+		"BusinessUnitAddressAdded",
+		"BusinessUnitAddressChanged",
+		"BusinessUnitAddressCustomFieldAdded",
+		"BusinessUnitAddressCustomFieldChanged",
+		"BusinessUnitAddressCustomFieldRemoved",
+		"BusinessUnitAddressCustomTypeRemoved",
+		"BusinessUnitAddressCustomTypeSet",
+		"BusinessUnitAddressRemoved",
+		"BusinessUnitAssociateAdded",
+		"BusinessUnitAssociateChanged",
+		"BusinessUnitAssociateModeChanged",
+		"BusinessUnitAssociateRemoved",
+		"BusinessUnitAssociatesSet",
+		"BusinessUnitBillingAddressAdded",
+		"BusinessUnitBillingAddressRemoved",
+		"BusinessUnitContactEmailSet",
+		"BusinessUnitCreated",
+		"BusinessUnitCustomFieldAdded",
+		"BusinessUnitCustomFieldChanged",
+		"BusinessUnitCustomFieldRemoved",
+		"BusinessUnitCustomTypeRemoved",
+		"BusinessUnitCustomTypeSet",
+		"BusinessUnitDefaultBillingAddressSet",
+		"BusinessUnitDefaultShippingAddressSet",
+		"BusinessUnitDeleted",
+		"BusinessUnitNameChanged",
+		"BusinessUnitParentChanged",
+		"BusinessUnitShippingAddressAdded",
+		"BusinessUnitShippingAddressRemoved",
+		"BusinessUnitStatusChanged",
+		"BusinessUnitStoreAdded",
+		"BusinessUnitStoreModeChanged",
+		"BusinessUnitStoreRemoved",
+		"BusinessUnitStoresSet",
+	},
+	"cart-discount": {
+		"CartDiscountCreated",
+		"CartDiscountDeleted",
+		"CartDiscountStoreAdded",
+		"CartDiscountStoreRemoved",
+		"CartDiscountStoresSet",
+	},
+	"category": {
+		"CategoryCreated",
+		"CategorySlugChanged",
+	},
+	"customer": {
+		"CustomerAddressAdded",
+		"CustomerAddressChanged",
+		"CustomerAddressCustomFieldAdded",
+		"CustomerAddressCustomFieldChanged",
+		"CustomerAddressCustomFieldRemoved",
+		"CustomerAddressCustomTypeRemoved",
+		"CustomerAddressCustomTypeSet",
+		"CustomerAddressRemoved",
+		"CustomerCompanyNameSet",
+		"CustomerCreated",
+		"CustomerCustomFieldAdded",
+		"CustomerCustomFieldChanged",
+		"CustomerCustomFieldRemoved",
+		"CustomerCustomTypeRemoved",
+		"CustomerCustomTypeSet",
+		"CustomerDateOfBirthSet",
+		"CustomerDeleted",
+		"CustomerEmailChanged",
+		"CustomerEmailTokenCreated",
+		"CustomerEmailVerified",
+		"CustomerFirstNameSet",
+		"CustomerGroupSet",
+		"CustomerLastNameSet",
+		"CustomerPasswordTokenCreated",
+		"CustomerPasswordUpdated",
+		"CustomerTitleSet",
+	},
+	"customer-group": {
+		"CustomerGroupCustomFieldAdded",
+		"CustomerGroupCustomFieldChanged",
+		"CustomerGroupCustomFieldRemoved",
+		"CustomerGroupCustomTypeRemoved",
+		"CustomerGroupCustomTypeSet",
+	},
+	"discount-code": {
+		"DiscountCodeCreated",
+		"DiscountCodeDeleted",
+		"DiscountCodeKeySet",
+	},
+	"inventory-entry": {
+		"InventoryEntryCreated",
+		"InventoryEntryDeleted",
+		"InventoryEntryQuantitySet",
+	},
+	"order": {
+		"CustomLineItemStateTransition",
+		"DeliveryAdded",
+		"DeliveryAddressSet",
+		"DeliveryItemsUpdated",
+		"DeliveryRemoved",
+		"LineItemStateTransition",
+		"OrderBillingAddressSet",
+		"OrderCreated",
+		"OrderCustomFieldAdded",
+		"OrderCustomFieldChanged",
+		"OrderCustomFieldRemoved",
+		"OrderCustomLineItemAdded",
+		"OrderCustomLineItemDiscountSet",
+		"OrderCustomLineItemQuantityChanged",
+		"OrderCustomLineItemRemoved",
+		"OrderCustomTypeRemoved",
+		"OrderCustomTypeSet",
+		"OrderCustomerEmailSet",
+		"OrderCustomerGroupSet",
+		"OrderCustomerSet",
+		"OrderDeleted",
+		"OrderDiscountCodeAdded",
+		"OrderDiscountCodeRemoved",
+		"OrderDiscountCodeStateSet",
+		"OrderEditApplied",
+		"OrderImported",
+		"OrderLineItemAdded",
+		"OrderLineItemDiscountSet",
+		"OrderLineItemDistributionChannelSet",
+		"OrderLineItemRemoved",
+		"OrderPaymentAdded",
+		"OrderPaymentStateChanged",
+		"OrderPurchaseOrderNumberSet",
+		"OrderReturnShipmentStateChanged",
+		"OrderShipmentStateChanged",
+		"OrderShippingAddressSet",
+		"OrderShippingInfoSet",
+		"OrderShippingRateInputSet",
+		"OrderStateChanged",
+		"OrderStateTransition",
+		"OrderStoreSet",
+		"ParcelAddedToDelivery",
+		"ParcelItemsUpdated",
+		"ParcelMeasurementsUpdated",
+		"ParcelRemovedFromDelivery",
+		"ParcelTrackingDataUpdated",
+		"ReturnInfoAdded",
+		"ReturnInfoSet",
+	},
+	"payment": {
+		"PaymentCreated",
+		"PaymentInteractionAdded",
+		"PaymentStatusInterfaceCodeSet",
+		"PaymentStatusStateTransition",
+		"PaymentTransactionAdded",
+		"PaymentTransactionStateChanged",
+	},
+	"product": {
+		"ProductAddedToCategory",
+		"ProductCreated",
+		"ProductDeleted",
+		"ProductImageAdded",
+		"ProductPriceAdded",
+		"ProductPriceChanged",
+		"ProductPriceDiscountsSet",
+		"ProductPriceExternalDiscountSet",
+		"ProductPriceKeySet",
+		"ProductPriceModeSet",
+		"ProductPriceRemoved",
+		"ProductPricesSet",
+		"ProductPublished",
+		"ProductRemovedFromCategory",
+		"ProductRevertedStagedChanges",
+		"ProductSlugChanged",
+		"ProductStateTransition",
+		"ProductUnpublished",
+		"ProductVariantAdded",
+		"ProductVariantDeleted",
+	},
+	"product-selection": {
+		"ProductSelectionCreated",
+		"ProductSelectionDeleted",
+		"ProductSelectionProductAdded",
+		"ProductSelectionProductExcluded",
+		"ProductSelectionProductRemoved",
+		"ProductSelectionVariantExclusionChanged",
+		"ProductSelectionVariantSelectionChanged",
+	},
+	"product-tailoring": {
+		"ProductTailoringCreated",
+		"ProductTailoringDeleted",
+		"ProductTailoringDescriptionSet",
+		"ProductTailoringNameSet",
+		"ProductTailoringPublished",
+		"ProductTailoringSlugSet",
+		"ProductTailoringUnpublished",
+	},
+	"quote": {
+		"QuoteCreated",
+		"QuoteCustomerChanged",
+		"QuoteDeleted",
+		"QuoteRenegotiationRequested",
+		"QuoteStateChanged",
+		"QuoteStateTransition",
+	},
+	"quote-request": {
+		"QuoteRequestCreated",
+		"QuoteRequestCustomerChanged",
+		"QuoteRequestDeleted",
+		"QuoteRequestStateChanged",
+		"QuoteRequestStateTransition",
+	},
+	"review": {
+		"ReviewCreated",
+		"ReviewRatingSet",
+		"ReviewStateTransition",
+	},
+	"staged-quote": {
+		"StagedQuoteCreated",
+		"StagedQuoteDeleted",
+		"StagedQuoteSellerCommentSet",
+		"StagedQuoteStateChanged",
+		"StagedQuoteStateTransition",
+		"StagedQuoteValidToSet",
+	},
+	"standalone-price": {
+		"StandalonePriceActiveChanged",
+		"StandalonePriceCreated",
+		"StandalonePriceDeleted",
+		"StandalonePriceDiscountSet",
+		"StandalonePriceExternalDiscountSet",
+		"StandalonePriceKeySet",
+		"StandalonePriceStagedChangesApplied",
+		"StandalonePriceStagedChangesRemoved",
+		"StandalonePriceTierAdded",
+		"StandalonePriceTierRemoved",
+		"StandalonePriceTiersSet",
+		"StandalonePriceValidFromAndUntilSet",
+		"StandalonePriceValidFromSet",
+		"StandalonePriceValidUntilSet",
+		"StandalonePriceValueChanged",
+	},
+	"store": {
+		"StoreCountriesChanged",
+		"StoreCreated",
+		"StoreDeleted",
+		"StoreDistributionChannelsChanged",
+		"StoreLanguagesChanged",
+		"StoreNameSet",
+		"StoreProductSelectionsChanged",
+		"StoreSupplyChannelsChanged",
+	},
+}
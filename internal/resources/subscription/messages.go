@@ -0,0 +1,93 @@
+package subscription
+
+//go:generate go run ./internal/messagegen
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/labd/terraform-provider-commercetools/internal/utils"
+)
+
+// validateMessageType checks if the message type is published for the
+// resource type. Resource types which are not in the catalogue are not
+// validated, since the catalogue can lag behind the API.
+func validateMessageType(resourceTypeID, messageType string) error {
+	known, ok := messageTypes[resourceTypeID]
+	if !ok || slices.Contains(known, messageType) {
+		return nil
+	}
+
+	for _, other := range messageResourceTypes() {
+		if slices.Contains(messageTypes[other], messageType) {
+			return fmt.Errorf("message type %s is published for resource type %s, not %s",
+				messageType, other, resourceTypeID)
+		}
+	}
+
+	if suggestion := utils.ClosestMatch(messageType, known); suggestion != "" {
+		return fmt.Errorf("unknown message type %s for resource type %s, did you mean %s?",
+			messageType, resourceTypeID, suggestion)
+	}
+	return fmt.Errorf("unknown message type %s for resource type %s", messageType, resourceTypeID)
+}
+
+// messageResourceTypes returns the resource types of the catalogue in a
+// stable order.
+func messageResourceTypes() []string {
+	result := make([]string, 0, len(messageTypes))
+	for k := range messageTypes {
+		result = append(result, k)
+	}
+	sort.Strings(result)
+	return result
+}
+
+var _ validator.List = messageTypesValidator{}
+
+// messageTypesValidator validates the types of a message block against the
+// resource_type_id of the same block.
+type messageTypesValidator struct{}
+
+// Description describes the validation in plain text formatting.
+func (v messageTypesValidator) Description(_ context.Context) string {
+	return "types must be message types of the resource type"
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (v messageTypesValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// ValidateList performs the validation.
+func (v messageTypesValidator) ValidateList(ctx context.Context, req validator.ListRequest, resp *validator.ListResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	var resourceTypeID types.String
+	diags := req.Config.GetAttribute(ctx, req.Path.ParentPath().AtName("resource_type_id"), &resourceTypeID)
+	resp.Diagnostics.Append(diags...)
+	if diags.HasError() || resourceTypeID.IsNull() || resourceTypeID.IsUnknown() {
+		return
+	}
+
+	for i, element := range req.ConfigValue.Elements() {
+		value, ok := element.(types.String)
+		if !ok || value.IsNull() || value.IsUnknown() {
+			continue
+		}
+		if err := validateMessageType(resourceTypeID.ValueString(), value.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				req.Path.AtListIndex(i),
+				"Invalid message type",
+				err.Error(),
+			)
+		}
+	}
+}
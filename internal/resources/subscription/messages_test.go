@@ -0,0 +1,34 @@
+package subscription
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateMessageType(t *testing.T) {
+	testCases := []struct {
+		resourceTypeID string
+		messageType    string
+		expected       string
+	}{
+		{"order", "OrderCreated", ""},
+		{"order", "DeliveryAdded", ""},
+		{"customer", "CustomerGroupSet", ""},
+		{"unknown-resource", "AnythingGoes", ""},
+		{"order", "OrderCreate", "unknown message type OrderCreate for resource type order, did you mean OrderCreated?"},
+		{"order", "Foo", "unknown message type Foo for resource type order"},
+		{"order", "ProductPublished", "message type ProductPublished is published for resource type product, not order"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.messageType, func(t *testing.T) {
+			err := validateMessageType(tc.resourceTypeID, tc.messageType)
+			if tc.expected == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.EqualError(t, err, tc.expected)
+		})
+	}
+}
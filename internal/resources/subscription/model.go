@@ -23,24 +23,28 @@ const (
 
 // Subscription is the main resource schema data
 type Subscription struct {
-	ID          types.String  `tfsdk:"id"`
-	Key         types.String  `tfsdk:"key"`
-	Version     types.Int64   `tfsdk:"version"`
-	Destination []Destination `tfsdk:"destination"`
-	Format      []Format      `tfsdk:"format"`
-	Messages    []Message     `tfsdk:"message"`
-	Changes     []Changes     `tfsdk:"changes"`
+	ID           types.String   `tfsdk:"id"`
+	Key          types.String   `tfsdk:"key"`
+	Version      types.Int64    `tfsdk:"version"`
+	Destination  []Destination  `tfsdk:"destination"`
+	Format       []Format       `tfsdk:"format"`
+	Messages     []Message      `tfsdk:"message"`
+	Changes      []Changes      `tfsdk:"changes"`
+	Events       []Event        `tfsdk:"event"`
+	TestDelivery []TestDelivery `tfsdk:"test_delivery"`
 }
 
 func NewSubscriptionFromNative(n *platform.Subscription) Subscription {
 	res := Subscription{
-		ID:          types.StringValue(n.ID),
-		Version:     types.Int64Value(int64(n.Version)),
-		Key:         utils.FromOptionalString(n.Key),
-		Format:      []Format{},
-		Destination: []Destination{},
-		Messages:    make([]Message, len(n.Messages)),
-		Changes:     []Changes{},
+		ID:           types.StringValue(n.ID),
+		Version:      types.Int64Value(int64(n.Version)),
+		Key:          utils.FromOptionalString(n.Key),
+		Format:       []Format{},
+		Destination:  []Destination{},
+		Messages:     make([]Message, len(n.Messages)),
+		Changes:      []Changes{},
+		Events:       []Event{},
+		TestDelivery: []TestDelivery{},
 	}
 
 	format := NewFormatFromNative(n.Format)
@@ -68,19 +72,48 @@ func NewSubscriptionFromNative(n *platform.Subscription) Subscription {
 	return res
 }
 
+// newSubscriptionFromRemote also sets the events, which are not part of the
+// SDK type.
+func newSubscriptionFromRemote(n *remoteSubscription) Subscription {
+	res := NewSubscriptionFromNative(&n.Subscription)
+	res.Events = pie.Map(n.Events, NewEventFromNative)
+	return res
+}
+
 func (s *Subscription) matchDefaults(state Subscription) {
 	if len(state.Format) == 0 {
 		if len(s.Format) == 1 && s.Format[0].Type.ValueString() == "Platform" {
 			s.Format = []Format{}
 		}
 	}
+
+	// The test delivery is not stored in commercetools
+	if state.TestDelivery != nil {
+		s.TestDelivery = state.TestDelivery
+	}
 }
 
 func (s *Subscription) setSecretValues(state Subscription) {
 	s.Destination[0].setSecretValues(&state.Destination[0])
 }
 
-func (s *Subscription) draft() platform.SubscriptionDraft {
+// withSecrets returns a copy of the subscription with the secrets of the
+// destination from the configuration. The plan and the state contain a hash
+// of the secrets when the secret version is set, which should never be sent to
+// commercetools.
+func (s Subscription) withSecrets(config Subscription) Subscription {
+	if len(s.Destination) == 0 || len(config.Destination) == 0 {
+		return s
+	}
+	d := s.Destination[0]
+	d.AccessSecret = config.Destination[0].AccessSecret
+	d.ConnectionString = config.Destination[0].ConnectionString
+	d.ApiSecret = config.Destination[0].ApiSecret
+	s.Destination = []Destination{d}
+	return s
+}
+
+func (s *Subscription) draft() subscriptionDraft {
 	var changes []platform.ChangeSubscription
 	for _, c := range s.Changes {
 		changes = append(changes, c.toNative()...)
@@ -99,7 +132,12 @@ func (s *Subscription) draft() platform.SubscriptionDraft {
 		draft.Format = s.Format[0].toNative()
 	}
 
-	return draft
+	return subscriptionDraft{
+		SubscriptionDraft: draft,
+		Events: pie.Map(s.Events, func(e Event) EventSubscription {
+			return e.toNative()
+		}),
+	}
 }
 
 func (s *Subscription) updateActions(plan Subscription) platform.SubscriptionUpdate {
@@ -119,8 +157,10 @@ func (s *Subscription) updateActions(plan Subscription) platform.SubscriptionUpd
 			platform.SubscriptionSetKeyAction{Key: value})
 	}
 
-	// changeDestination
-	if !reflect.DeepEqual(s.Destination, plan.Destination) {
+	// changeDestination, the plan contains the configured secrets so these
+	// are hashed like in the state
+	hashed := pie.Map(plan.Destination, Destination.hashSecrets)
+	if !reflect.DeepEqual(s.Destination, hashed) {
 		result.Actions = append(
 			result.Actions,
 			platform.SubscriptionChangeDestinationAction{
@@ -154,6 +194,18 @@ func (s *Subscription) updateActions(plan Subscription) platform.SubscriptionUpd
 			platform.SubscriptionSetMessagesAction{Messages: messages})
 	}
 
+	// setEvents
+	if !reflect.DeepEqual(s.Events, plan.Events) {
+		var events = make([]EventSubscription, 0, len(plan.Events))
+		for _, e := range plan.Events {
+			events = append(events, e.toNative())
+		}
+
+		result.Actions = append(
+			result.Actions,
+			SubscriptionSetEventsAction{Events: events})
+	}
+
 	return result
 }
 
@@ -190,12 +242,41 @@ type Destination struct {
 	ApiSecret       types.String `tfsdk:"api_secret"`
 	Acks            types.String `tfsdk:"acks"`
 	Key             types.String `tfsdk:"key"`
+
+	// SecretVersion is not stored in commercetools, changing it results in
+	// a changeDestination action which sends the secrets again.
+	SecretVersion types.Int64 `tfsdk:"secret_version"`
+}
+
+// hashSecrets returns a copy of the destination with the hashes of the
+// secrets, as stored in the state, when the secret version is set.
+func (d Destination) hashSecrets() Destination {
+	if d.SecretVersion.IsNull() || d.SecretVersion.ValueInt64() == 0 {
+		return d
+	}
+	hash := func(v types.String) types.String {
+		if v.IsNull() || v.IsUnknown() {
+			return v
+		}
+		return types.StringValue(utils.HashSecret(v.ValueString()))
+	}
+	d.AccessSecret = hash(d.AccessSecret)
+	d.ConnectionString = hash(d.ConnectionString)
+	d.ApiSecret = hash(d.ApiSecret)
+	return d
 }
 
 func (d *Destination) setSecretValues(state *Destination) {
 	if state == nil {
 		return
 	}
+	d.SecretVersion = state.SecretVersion
+
+	// commercetools masks the secrets, so hashed secrets can't be compared
+	if utils.IsHashedSecret(state.ConnectionString.ValueString()) && d.Type.ValueString() == AzureServiceBus {
+		d.ConnectionString = state.ConnectionString
+		return
+	}
 
 	switch d.Type.ValueString() {
 	case AzureServiceBus:
@@ -397,3 +478,22 @@ func (m Message) toNative() platform.MessageSubscription {
 		}),
 	}
 }
+
+type Event struct {
+	ResourceTypeID types.String   `tfsdk:"resource_type_id"`
+	Types          []types.String `tfsdk:"types"`
+}
+
+func (e Event) toNative() EventSubscription {
+	return EventSubscription{
+		ResourceTypeId: e.ResourceTypeID.ValueString(),
+		Types:          pie.Map(e.Types, func(t types.String) string { return t.ValueString() }),
+	}
+}
+
+func NewEventFromNative(n EventSubscription) Event {
+	return Event{
+		ResourceTypeID: types.StringValue(n.ResourceTypeId),
+		Types:          pie.Map(n.Types, types.StringValue),
+	}
+}
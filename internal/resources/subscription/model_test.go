@@ -136,6 +136,21 @@ func TestImport(t *testing.T) {
 				AccessSecret: types.StringValue("secret"),
 			},
 		},
+		{
+			name: "AzureServiceBusDestination (hashed state)",
+			n: platform.AzureServiceBusDestination{
+				ConnectionString: "Endpoint=sb://other.servicebus.windows.net/;SharedAccessKey=****",
+			},
+			state: &Destination{
+				ConnectionString: types.StringValue(utils.HashSecret("Endpoint=sb://my-bus.servicebus.windows.net/;SharedAccessKey=secret")),
+				SecretVersion:    types.Int64Value(1),
+			},
+			wantDest: Destination{
+				Type:             types.StringValue("AzureServiceBus"),
+				ConnectionString: types.StringValue(utils.HashSecret("Endpoint=sb://my-bus.servicebus.windows.net/;SharedAccessKey=secret")),
+				SecretVersion:    types.Int64Value(1),
+			},
+		},
 		{
 			name: "SqsDestination",
 			n: platform.SqsDestination{
@@ -198,6 +213,34 @@ func TestImport(t *testing.T) {
 	}
 }
 
+func TestWithSecrets(t *testing.T) {
+	plan := Subscription{
+		Key: types.StringValue("my-subscription"),
+		Destination: []Destination{{
+			Type:          types.StringValue(SQS),
+			AccessKey:     types.StringValue("AKID"),
+			AccessSecret:  types.StringValue(utils.HashSecret("secret")),
+			SecretVersion: types.Int64Value(1),
+		}},
+	}
+	config := Subscription{
+		Destination: []Destination{{
+			Type:          types.StringValue(SQS),
+			AccessKey:     types.StringValue("AKID"),
+			AccessSecret:  types.StringValue("secret"),
+			SecretVersion: types.Int64Value(1),
+		}},
+	}
+
+	result := plan.withSecrets(config)
+	assert.Equal(t, types.StringValue("secret"), result.Destination[0].AccessSecret)
+	assert.Equal(t, types.StringValue("my-subscription"), result.Key)
+
+	// The plan itself is not modified
+	assert.Equal(t, types.StringValue(utils.HashSecret("secret")), plan.Destination[0].AccessSecret)
+	assert.Equal(t, plan.Destination[0], result.Destination[0].hashSecrets())
+}
+
 func TestUpdateActions(t *testing.T) {
 	testCases := []struct {
 		name     string
@@ -297,6 +340,116 @@ func TestUpdateActions(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "hashed secrets unchanged",
+			state: Subscription{
+				Version: types.Int64Value(10),
+				Destination: []Destination{{
+					Type:          types.StringValue(SQS),
+					AccessKey:     types.StringValue("AKID"),
+					AccessSecret:  types.StringValue(utils.HashSecret("secret")),
+					SecretVersion: types.Int64Value(1),
+				}},
+			},
+			plan: Subscription{
+				Destination: []Destination{{
+					Type:          types.StringValue(SQS),
+					AccessKey:     types.StringValue("AKID"),
+					AccessSecret:  types.StringValue("secret"),
+					SecretVersion: types.Int64Value(1),
+				}},
+			},
+			expected: platform.SubscriptionUpdate{
+				Version: 10,
+				Actions: []platform.SubscriptionUpdateAction{},
+			},
+		},
+		{
+			name: "hashed secrets changed",
+			state: Subscription{
+				Version: types.Int64Value(10),
+				Destination: []Destination{{
+					Type:          types.StringValue(SQS),
+					AccessKey:     types.StringValue("AKID"),
+					AccessSecret:  types.StringValue(utils.HashSecret("secret")),
+					SecretVersion: types.Int64Value(1),
+				}},
+			},
+			plan: Subscription{
+				Destination: []Destination{{
+					Type:          types.StringValue(SQS),
+					AccessKey:     types.StringValue("AKID"),
+					AccessSecret:  types.StringValue("new-secret"),
+					SecretVersion: types.Int64Value(1),
+				}},
+			},
+			expected: platform.SubscriptionUpdate{
+				Version: 10,
+				Actions: []platform.SubscriptionUpdateAction{
+					platform.SubscriptionChangeDestinationAction{
+						Destination: platform.SqsDestination{
+							AccessKey:    utils.StringRef("AKID"),
+							AccessSecret: utils.StringRef("new-secret"),
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "rotate secrets",
+			state: Subscription{
+				Version: types.Int64Value(10),
+				Destination: []Destination{{
+					Type:             types.StringValue(AzureServiceBus),
+					ConnectionString: types.StringValue(utils.HashSecret("Endpoint=sb://my-bus.servicebus.windows.net/;SharedAccessKey=secret")),
+					SecretVersion:    types.Int64Value(1),
+				}},
+			},
+			plan: Subscription{
+				Destination: []Destination{{
+					Type:             types.StringValue(AzureServiceBus),
+					ConnectionString: types.StringValue("Endpoint=sb://my-bus.servicebus.windows.net/;SharedAccessKey=secret"),
+					SecretVersion:    types.Int64Value(2),
+				}},
+			},
+			expected: platform.SubscriptionUpdate{
+				Version: 10,
+				Actions: []platform.SubscriptionUpdateAction{
+					platform.SubscriptionChangeDestinationAction{
+						Destination: platform.AzureServiceBusDestination{
+							ConnectionString: "Endpoint=sb://my-bus.servicebus.windows.net/;SharedAccessKey=secret",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "set events",
+			state: Subscription{
+				Version: types.Int64Value(10),
+			},
+			plan: Subscription{
+				Events: []Event{
+					{
+						ResourceTypeID: types.StringValue("import-api"),
+						Types:          []types.String{types.StringValue("ImportContainerCreated")},
+					},
+				},
+			},
+			expected: platform.SubscriptionUpdate{
+				Version: 10,
+				Actions: []platform.SubscriptionUpdateAction{
+					SubscriptionSetEventsAction{
+						Events: []EventSubscription{
+							{
+								ResourceTypeId: "import-api",
+								Types:          []string{"ImportContainerCreated"},
+							},
+						},
+					},
+				},
+			},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -2,11 +2,16 @@ package subscription
 
 import (
 	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"reflect"
 	"regexp"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -17,6 +22,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/labd/commercetools-go-sdk/platform"
 
+	"github.com/labd/terraform-provider-commercetools/internal/custommodifiers"
 	"github.com/labd/terraform-provider-commercetools/internal/customvalidator"
 	"github.com/labd/terraform-provider-commercetools/internal/utils"
 )
@@ -35,7 +41,12 @@ func NewSubscriptionResource() resource.Resource {
 
 // orderResource is the resource implementation.
 type subscriptionResource struct {
-	client *platform.ByProjectKeyRequestBuilder
+	client     *platform.ByProjectKeyRequestBuilder
+	projectKey string
+
+	// raw is used to read and write the events, which are not supported by
+	// the SDK yet.
+	raw *utils.RawClient
 }
 
 // Metadata returns the data source type name.
@@ -171,10 +182,14 @@ func (r *subscriptionResource) Schema(_ context.Context, _ resource.SchemaReques
 						"access_secret": schema.StringAttribute{
 							Description: "The access secret of the SQS queue, SNS topic or EventBridge topic",
 							Optional:    true,
+							Computed:    true,
 							Validators:  []validator.String{
 								// TODO Require value if access_key is set and
 								// type is SNS, SQS
 							},
+							PlanModifiers: []planmodifier.String{
+								custommodifiers.HashedSecret("secret_version"),
+							},
 							Sensitive: true,
 						},
 						"uri": schema.StringAttribute{
@@ -187,6 +202,11 @@ func (r *subscriptionResource) Schema(_ context.Context, _ resource.SchemaReques
 						"connection_string": schema.StringAttribute{
 							Description: "The connection string of the Azure Service Bus",
 							Optional:    true,
+							Computed:    true,
+							Sensitive:   true,
+							PlanModifiers: []planmodifier.String{
+								custommodifiers.HashedSecret("secret_version"),
+							},
 							Validators: []validator.String{
 								stringvalidator.RegexMatches(
 									regexp.MustCompilePOSIX("^Endpoint=sb://"),
@@ -225,6 +245,11 @@ func (r *subscriptionResource) Schema(_ context.Context, _ resource.SchemaReques
 						"api_secret": schema.StringAttribute{
 							Description: "The API secret of the Confluent Cloud topic",
 							Optional:    true,
+							Computed:    true,
+							Sensitive:   true,
+							PlanModifiers: []planmodifier.String{
+								custommodifiers.HashedSecret("secret_version"),
+							},
 							Validators: []validator.String{
 								stringvalidator.LengthAtLeast(1),
 							},
@@ -243,6 +268,13 @@ func (r *subscriptionResource) Schema(_ context.Context, _ resource.SchemaReques
 								stringvalidator.LengthAtLeast(1),
 							},
 						},
+						"secret_version": schema.Int64Attribute{
+							Description: "Version of the secrets of the destination. When set, the " +
+								"`access_secret`, `connection_string` and `api_secret` are stored in the state as " +
+								"a hash, and are sent to commercetools again when the version is changed, for " +
+								"example after rotating them",
+							Optional: true,
+						},
 					},
 				},
 				Validators: []validator.List{
@@ -284,6 +316,45 @@ func (r *subscriptionResource) Schema(_ context.Context, _ resource.SchemaReques
 					listvalidator.SizeAtMost(1),
 				},
 			},
+			"test_delivery": schema.ListNestedBlock{
+				Description: "Publish a test message to the destination before the subscription is created " +
+					"or the destination is changed, using the protocol of the destination, and check that " +
+					"the status of the subscription is `Healthy` afterwards. SQS and SNS destinations " +
+					"without access keys use the credentials of the AWS environment variables, Google Cloud " +
+					"Pub/Sub uses `GOOGLE_OAUTH_ACCESS_TOKEN` or the emulator of `PUBSUB_EMULATOR_HOST` and " +
+					"EventBridge destinations use the credentials of the AWS environment variables to put " +
+					"an event on the partner event bus of the subscription, and Confluent Cloud uses the " +
+					"Kafka REST API",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"endpoint": schema.StringAttribute{
+							Description: "Endpoint to publish the test message to instead of the endpoint " +
+								"of the destination, for example LocalStack or the Pub/Sub emulator. For Confluent " +
+								"Cloud destinations this must be a Kafka REST proxy (API v3), like the " +
+								"confluentinc/cp-kafka-rest container, since Kafka brokers have no HTTP API",
+							Optional: true,
+							Validators: []validator.String{
+								stringvalidator.RegexMatches(
+									regexp.MustCompile(`^https?://`),
+									"must be an http or https URL",
+								),
+							},
+						},
+						"event_bus": schema.StringAttribute{
+							Description: "Name or ARN of the event bus to put the test event on for EventBridge " +
+								"destinations. Defaults to the partner event bus of the subscription, " +
+								"aws.partner/commercetools.com/<project key>/<subscription key>",
+							Optional: true,
+							Validators: []validator.String{
+								stringvalidator.LengthAtLeast(1),
+							},
+						},
+					},
+				},
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+			},
 			"message": schema.SetNestedBlock{
 				Description: "The messages subscribed to",
 				NestedObject: schema.NestedBlockObject{
@@ -298,6 +369,29 @@ func (r *subscriptionResource) Schema(_ context.Context, _ resource.SchemaReques
 								"messages are given, the subscription is valid for all messages of this resource",
 							ElementType: types.StringType,
 							Required:    true,
+							Validators: []validator.List{
+								messageTypesValidator{},
+							},
+						},
+					},
+				},
+			},
+			"event": schema.SetNestedBlock{
+				MarkdownDescription: "The [events](https://docs.commercetools.com/api/projects/subscriptions#eventsubscription) " +
+					"subscribed to, for example the events of the Import API or Checkout",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"resource_type_id": schema.StringAttribute{
+							Description: "The resource type of the events, for example `import-api` or `checkout`",
+							Required:    true,
+						},
+						"types": schema.ListAttribute{
+							Description: "The types of events subscribed to, for example `ImportContainerCreated`",
+							ElementType: types.StringType,
+							Required:    true,
+							Validators: []validator.List{
+								listvalidator.SizeAtLeast(1),
+							},
 						},
 					},
 				},
@@ -313,6 +407,8 @@ func (r *subscriptionResource) Configure(_ context.Context, req resource.Configu
 	}
 	data := req.ProviderData.(*utils.ProviderData)
 	r.client = data.Client
+	r.projectKey = data.ProjectKey
+	r.raw = data.RawClient
 }
 
 func (r *subscriptionResource) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
@@ -336,11 +432,27 @@ func (r *subscriptionResource) Create(ctx context.Context, req resource.CreateRe
 		return
 	}
 
-	draft := plan.draft()
-	var subscription *platform.Subscription
+	// The plan contains the hashes of the secrets when the secret version is
+	// set, the secrets themselves are only available in the configuration
+	var config Subscription
+	diags = req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	withSecrets := plan.withSecrets(config)
+
+	if len(plan.TestDelivery) > 0 {
+		resp.Diagnostics.Append(r.testDelivery(ctx, withSecrets)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	draft := withSecrets.draft()
+	var subscription remoteSubscription
 	err := retry.RetryContext(ctx, 20*time.Second, func() *retry.RetryError {
-		var err error
-		subscription, err = r.client.Subscriptions().Post(draft).Execute(ctx)
+		err := r.raw.Do(ctx, http.MethodPost, "/subscriptions", draft, &subscription)
 		return utils.ProcessRemoteError(err)
 	})
 	if err != nil {
@@ -351,7 +463,7 @@ func (r *subscriptionResource) Create(ctx context.Context, req resource.CreateRe
 		return
 	}
 
-	current := NewSubscriptionFromNative(subscription)
+	current := newSubscriptionFromRemote(&subscription)
 	current.matchDefaults(plan)
 	current.setSecretValues(plan)
 
@@ -361,6 +473,10 @@ func (r *subscriptionResource) Create(ctx context.Context, req resource.CreateRe
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	if len(plan.TestDelivery) > 0 {
+		resp.Diagnostics.Append(r.checkHealthy(ctx, subscription.ID)...)
+	}
 }
 
 // Read refreshes the Terraform state with the latest data.
@@ -373,7 +489,7 @@ func (r *subscriptionResource) Read(ctx context.Context, req resource.ReadReques
 		return
 	}
 
-	subscription, err := r.client.Subscriptions().WithId(state.ID.ValueString()).Get().Execute(ctx)
+	subscription, err := r.get(ctx, state.ID.ValueString())
 	if err != nil {
 		if utils.IsResourceNotFoundError(err) {
 			resp.State.RemoveResource(ctx)
@@ -386,7 +502,7 @@ func (r *subscriptionResource) Read(ctx context.Context, req resource.ReadReques
 		return
 	}
 
-	current := NewSubscriptionFromNative(subscription)
+	current := newSubscriptionFromRemote(subscription)
 	current.matchDefaults(state)
 	current.setSecretValues(state)
 
@@ -416,11 +532,29 @@ func (r *subscriptionResource) Update(ctx context.Context, req resource.UpdateRe
 		return
 	}
 
-	input := state.updateActions(plan)
-	var subscription *platform.Subscription
+	// See Create
+	var config Subscription
+	diags = req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	withSecrets := plan.withSecrets(config)
+
+	testDestination := len(plan.TestDelivery) > 0 &&
+		(!reflect.DeepEqual(state.Destination, plan.Destination) ||
+			!reflect.DeepEqual(state.TestDelivery, plan.TestDelivery))
+	if testDestination {
+		resp.Diagnostics.Append(r.testDelivery(ctx, withSecrets)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	input := state.updateActions(withSecrets)
+	var subscription remoteSubscription
 	err := retry.RetryContext(ctx, 5*time.Second, func() *retry.RetryError {
-		var err error
-		subscription, err = r.client.Subscriptions().WithId(state.ID.ValueString()).Post(input).Execute(ctx)
+		err := r.raw.Do(ctx, http.MethodPost, "/subscriptions/"+url.PathEscape(state.ID.ValueString()), input, &subscription)
 		return utils.ProcessRemoteError(err)
 	})
 	if err != nil {
@@ -434,7 +568,7 @@ func (r *subscriptionResource) Update(ctx context.Context, req resource.UpdateRe
 	// Transform response to terraform value and call `setPlanData` with the
 	// plan to copy the secrets from the plan since those are returned by
 	// commercetools as masked values.
-	current := NewSubscriptionFromNative(subscription)
+	current := newSubscriptionFromRemote(&subscription)
 	current.matchDefaults(plan)
 	current.setSecretValues(plan)
 
@@ -443,6 +577,10 @@ func (r *subscriptionResource) Update(ctx context.Context, req resource.UpdateRe
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	if testDestination {
+		resp.Diagnostics.Append(r.checkHealthy(ctx, subscription.ID)...)
+	}
 }
 
 // Delete deletes the resource and removes the Terraform state on success.
@@ -470,7 +608,7 @@ func (r *subscriptionResource) Delete(ctx context.Context, req resource.DeleteRe
 }
 
 func (r *subscriptionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	subscription, err := r.client.Subscriptions().WithId(req.ID).Get().Execute(ctx)
+	subscription, err := r.get(ctx, req.ID)
 	if err != nil {
 		if utils.IsResourceNotFoundError(err) {
 			resp.State.RemoveResource(ctx)
@@ -483,7 +621,7 @@ func (r *subscriptionResource) ImportState(ctx context.Context, req resource.Imp
 		return
 	}
 
-	current := NewSubscriptionFromNative(subscription)
+	current := newSubscriptionFromRemote(subscription)
 
 	// Set refreshed state
 	diags := resp.State.Set(ctx, &current)
@@ -492,3 +630,50 @@ func (r *subscriptionResource) ImportState(ctx context.Context, req resource.Imp
 		return
 	}
 }
+
+// get returns the subscription including the events.
+func (r *subscriptionResource) get(ctx context.Context, id string) (*remoteSubscription, error) {
+	var subscription remoteSubscription
+	if err := r.raw.Do(ctx, http.MethodGet, "/subscriptions/"+url.PathEscape(id), nil, &subscription); err != nil {
+		return nil, err
+	}
+	return &subscription, nil
+}
+
+// testDelivery publishes a test message to the destination of the subscription.
+func (r *subscriptionResource) testDelivery(ctx context.Context, plan Subscription) diag.Diagnostics {
+	var diags diag.Diagnostics
+	tester := newDeliveryTester(plan.TestDelivery[0], r.projectKey)
+	if err := tester.deliver(ctx, plan); err != nil {
+		diags.AddAttributeError(
+			path.Root("destination"),
+			"Test delivery failed",
+			"Could not publish a test message to the destination: "+err.Error(),
+		)
+	}
+	return diags
+}
+
+// checkHealthy waits until the status of the subscription is Healthy.
+func (r *subscriptionResource) checkHealthy(ctx context.Context, id string) diag.Diagnostics {
+	var diags diag.Diagnostics
+	var status platform.SubscriptionHealthStatus
+	err := retry.RetryContext(ctx, 20*time.Second, func() *retry.RetryError {
+		subscription, err := r.client.Subscriptions().WithId(id).Get().Execute(ctx)
+		if err != nil {
+			return utils.ProcessRemoteError(err)
+		}
+		status = subscription.Status
+		if status != platform.SubscriptionHealthStatusHealthy {
+			return retry.RetryableError(fmt.Errorf("subscription status is %s", status))
+		}
+		return nil
+	})
+	if err != nil {
+		diags.AddError(
+			"Subscription is not healthy",
+			fmt.Sprintf("The status of subscription %s is %s instead of Healthy: %s", id, status, err.Error()),
+		)
+	}
+	return diags
+}
@@ -0,0 +1,493 @@
+package subscription
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// TestDelivery configures the delivery test which is done before the
+// subscription is created or its destination is changed.
+type TestDelivery struct {
+	Endpoint types.String `tfsdk:"endpoint"`
+	EventBus types.String `tfsdk:"event_bus"`
+}
+
+// testMessageID is the ID of the resource in the test message, so consumers
+// are able to recognize and skip it.
+const testMessageID = "00000000-0000-0000-0000-000000000000"
+
+// deliveryTester publishes a test message to a subscription destination using
+// the protocol of the destination.
+type deliveryTester struct {
+	client     *http.Client
+	endpoint   string
+	eventBus   string
+	projectKey string
+	now        func() time.Time
+}
+
+func newDeliveryTester(t TestDelivery, projectKey string) *deliveryTester {
+	return &deliveryTester{
+		client:     &http.Client{Timeout: 30 * time.Second},
+		endpoint:   strings.TrimSuffix(t.Endpoint.ValueString(), "/"),
+		eventBus:   t.EventBus.ValueString(),
+		projectKey: projectKey,
+		now:        time.Now,
+	}
+}
+
+// testMessage returns the message which is published. It has the same
+// structure as the message commercetools sends when a subscription is created.
+func (t *deliveryTester) testMessage(s Subscription) []byte {
+	message := map[string]any{
+		"notificationType": "ResourceCreated",
+		"resource": map[string]any{
+			"typeId": "subscription",
+			"id":     testMessageID,
+		},
+		"version":    1,
+		"modifiedAt": t.now().UTC().Format(time.RFC3339),
+	}
+	if key := s.Key.ValueString(); key != "" {
+		message["resourceUserProvidedIdentifiers"] = map[string]any{"key": key}
+	}
+	data, _ := json.Marshal(message)
+	return data
+}
+
+// deliver publishes a test message to the destination of the subscription.
+func (t *deliveryTester) deliver(ctx context.Context, s Subscription) error {
+	d := s.Destination[0]
+	message := t.testMessage(s)
+
+	switch d.Type.ValueString() {
+	case SQS:
+		return t.deliverSQS(ctx, d, message)
+	case SNS:
+		return t.deliverSNS(ctx, d, message)
+	case EventBridge:
+		return t.deliverEventBridge(ctx, s, message)
+	case EventGrid:
+		cloudEvents := len(s.Format) > 0 && s.Format[0].Type.ValueString() == "CloudEvents"
+		return t.deliverEventGrid(ctx, d, message, cloudEvents)
+	case AzureServiceBus:
+		return t.deliverServiceBus(ctx, d, message)
+	case GoogleCloudPubSub:
+		return t.deliverPubSub(ctx, d, message)
+	case ConfluentCloud:
+		return t.deliverConfluent(ctx, d, message)
+	}
+	return fmt.Errorf("test delivery is not supported for %s destinations", d.Type.ValueString())
+}
+
+func (t *deliveryTester) awsCredentials(d Destination) (awsCredentials, error) {
+	if d.AccessKey.ValueString() != "" {
+		return awsCredentials{
+			AccessKey: d.AccessKey.ValueString(),
+			SecretKey: d.AccessSecret.ValueString(),
+		}, nil
+	}
+	return awsCredentialsFromEnv()
+}
+
+func (t *deliveryTester) deliverSQS(ctx context.Context, d Destination, message []byte) error {
+	creds, err := t.awsCredentials(d)
+	if err != nil {
+		return err
+	}
+
+	queueURL, err := t.overrideEndpoint(d.QueueURL.ValueString())
+	if err != nil {
+		return err
+	}
+
+	body := []byte(url.Values{
+		"Action":      {"SendMessage"},
+		"Version":     {"2012-11-05"},
+		"MessageBody": {string(message)},
+	}.Encode())
+	return t.postAWSForm(ctx, queueURL, body, creds, d.Region.ValueString(), "sqs")
+}
+
+func (t *deliveryTester) deliverSNS(ctx context.Context, d Destination, message []byte) error {
+	creds, err := t.awsCredentials(d)
+	if err != nil {
+		return err
+	}
+
+	// arn:aws:sns:<region>:<account>:<topic>
+	parts := strings.Split(d.TopicARN.ValueString(), ":")
+	if len(parts) != 6 {
+		return fmt.Errorf("invalid topic_arn %q", d.TopicARN.ValueString())
+	}
+	region := parts[3]
+
+	endpoint := t.endpoint
+	if endpoint == "" {
+		endpoint = fmt.Sprintf("https://sns.%s.amazonaws.com", region)
+	}
+
+	body := []byte(url.Values{
+		"Action":   {"Publish"},
+		"Version":  {"2010-03-31"},
+		"TopicArn": {d.TopicARN.ValueString()},
+		"Message":  {string(message)},
+	}.Encode())
+	return t.postAWSForm(ctx, endpoint+"/", body, creds, region, "sns")
+}
+
+// deliverEventBridge puts the message on the event bus of the partner event
+// source of the subscription with PutEvents. The source of the event is
+// terraform-provider-commercetools, since the aws.partner prefix is reserved
+// for the partner itself.
+func (t *deliveryTester) deliverEventBridge(ctx context.Context, s Subscription, message []byte) error {
+	d := s.Destination[0]
+	creds, err := awsCredentialsFromEnv()
+	if err != nil {
+		return err
+	}
+
+	eventBus := t.eventBus
+	if eventBus == "" {
+		if s.Key.ValueString() == "" {
+			return fmt.Errorf("set the key of the subscription, or the event_bus of the test_delivery, " +
+				"to test the delivery to EventBridge")
+		}
+		eventBus = fmt.Sprintf("aws.partner/commercetools.com/%s/%s", t.projectKey, s.Key.ValueString())
+	}
+
+	endpoint := t.endpoint
+	if endpoint == "" {
+		endpoint = fmt.Sprintf("https://events.%s.amazonaws.com", d.Region.ValueString())
+	}
+
+	body, err := json.Marshal(map[string]any{
+		"Entries": []any{
+			map[string]any{
+				"EventBusName": eventBus,
+				"Source":       "terraform-provider-commercetools",
+				"DetailType":   "ResourceCreated",
+				"Detail":       string(message),
+			},
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint+"/", bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/x-amz-json-1.1")
+	req.Header.Set("X-Amz-Target", "AWSEvents.PutEvents")
+	signAWSRequest(req, body, creds, d.Region.ValueString(), "events", t.now())
+	data, err := t.do(req)
+	if err != nil {
+		return err
+	}
+
+	// PutEvents reports failures per entry, with a successful status code
+	var result struct {
+		FailedEntryCount int `json:"FailedEntryCount"`
+		Entries          []struct {
+			ErrorCode    string `json:"ErrorCode"`
+			ErrorMessage string `json:"ErrorMessage"`
+		} `json:"Entries"`
+	}
+	if err := json.Unmarshal(data, &result); err == nil && result.FailedEntryCount > 0 && len(result.Entries) > 0 {
+		return fmt.Errorf("failed to put the event on %s: %s: %s",
+			eventBus, result.Entries[0].ErrorCode, result.Entries[0].ErrorMessage)
+	}
+	return nil
+}
+
+func (t *deliveryTester) postAWSForm(ctx context.Context, endpoint string, body []byte, creds awsCredentials, region, service string) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded; charset=utf-8")
+	signAWSRequest(req, body, creds, region, service, t.now())
+	_, err = t.do(req)
+	return err
+}
+
+func (t *deliveryTester) deliverEventGrid(ctx context.Context, d Destination, message []byte, cloudEvents bool) error {
+	uri, err := t.overrideEndpoint(d.URI.ValueString())
+	if err != nil {
+		return err
+	}
+
+	contentType := "application/json"
+	event := map[string]any{
+		"id":          testMessageID,
+		"eventType":   "ResourceCreated",
+		"subject":     "subscription/" + testMessageID,
+		"eventTime":   t.now().UTC().Format(time.RFC3339),
+		"dataVersion": "1",
+		"data":        json.RawMessage(message),
+	}
+	if cloudEvents {
+		contentType = "application/cloudevents-batch+json; charset=utf-8"
+		event = map[string]any{
+			"specversion": "1.0",
+			"id":          testMessageID,
+			"type":        "com.commercetools.subscription.change.ResourceCreated",
+			"source":      "/terraform-provider-commercetools",
+			"subject":     testMessageID,
+			"time":        t.now().UTC().Format(time.RFC3339),
+			"data":        json.RawMessage(message),
+		}
+	}
+
+	body, err := json.Marshal([]any{event})
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, uri, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", contentType)
+	req.Header.Set("aeg-sas-key", d.AccessKey.ValueString())
+	_, err = t.do(req)
+	return err
+}
+
+func (t *deliveryTester) deliverServiceBus(ctx context.Context, d Destination, message []byte) error {
+	values := map[string]string{}
+	for _, part := range strings.Split(d.ConnectionString.ValueString(), ";") {
+		if key, value, ok := strings.Cut(part, "="); ok {
+			values[key] = value
+		}
+	}
+
+	namespace, err := url.Parse(values["Endpoint"])
+	if err != nil || namespace.Host == "" {
+		return fmt.Errorf("invalid Endpoint in connection_string")
+	}
+	if values["EntityPath"] == "" || values["SharedAccessKeyName"] == "" || values["SharedAccessKey"] == "" {
+		return fmt.Errorf("the connection_string must contain the EntityPath, SharedAccessKeyName and SharedAccessKey")
+	}
+
+	// The token is signed for the resource URI of the queue or topic, also
+	// when an endpoint is used
+	resource := "https://" + namespace.Host + "/" + values["EntityPath"]
+	endpoint := resource
+	if t.endpoint != "" {
+		endpoint = t.endpoint + "/" + values["EntityPath"]
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint+"/messages", bytes.NewReader(message))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", serviceBusToken(
+		resource, values["SharedAccessKeyName"], values["SharedAccessKey"], t.now().Add(time.Hour)))
+	_, err = t.do(req)
+	return err
+}
+
+// serviceBusToken returns a shared access signature token for Azure Service
+// Bus.
+//
+// See https://learn.microsoft.com/en-us/azure/service-bus-messaging/service-bus-sas
+func serviceBusToken(resource, keyName, key string, expiry time.Time) string {
+	encoded := url.QueryEscape(resource)
+	se := strconv.FormatInt(expiry.Unix(), 10)
+	sig := base64.StdEncoding.EncodeToString(hmacSHA256([]byte(key), encoded+"\n"+se))
+	return fmt.Sprintf("SharedAccessSignature sr=%s&sig=%s&se=%s&skn=%s",
+		encoded, url.QueryEscape(sig), se, keyName)
+}
+
+func (t *deliveryTester) deliverPubSub(ctx context.Context, d Destination, message []byte) error {
+	endpoint := t.endpoint
+	token := os.Getenv("GOOGLE_OAUTH_ACCESS_TOKEN")
+	if endpoint == "" {
+		if host := os.Getenv("PUBSUB_EMULATOR_HOST"); host != "" {
+			endpoint = "http://" + host
+		} else if token == "" {
+			return fmt.Errorf(
+				"set GOOGLE_OAUTH_ACCESS_TOKEN to test the delivery to Google Cloud Pub/Sub, " +
+					"or set PUBSUB_EMULATOR_HOST or the endpoint to use the Pub/Sub emulator")
+		} else {
+			endpoint = "https://pubsub.googleapis.com"
+		}
+	}
+
+	body, err := json.Marshal(map[string]any{
+		"messages": []any{
+			map[string]any{"data": base64.StdEncoding.EncodeToString(message)},
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	uri := fmt.Sprintf("%s/v1/projects/%s/topics/%s:publish",
+		endpoint, url.PathEscape(d.ProjectID.ValueString()), url.PathEscape(d.Topic.ValueString()))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, uri, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	_, err = t.do(req)
+	return err
+}
+
+// deliverConfluent produces the message with the Kafka REST API (v3), which is
+// available in Confluent Cloud under /kafka/v3 and in the Confluent REST proxy
+// under /v3. Kafka brokers themselves don't offer an HTTP API, so the endpoint
+// must be a REST proxy when testing against a local Kafka.
+func (t *deliveryTester) deliverConfluent(ctx context.Context, d Destination, message []byte) error {
+	endpoint := t.endpoint
+	if endpoint == "" {
+		host, _, _ := strings.Cut(d.BootstrapServer.ValueString(), ":")
+		endpoint = "https://" + strings.TrimPrefix(host, "SASL_SSL://")
+	}
+
+	newRequest := func(method, uri string, body []byte) (*http.Request, error) {
+		req, err := http.NewRequestWithContext(ctx, method, endpoint+uri, bytes.NewReader(body))
+		if err != nil {
+			return nil, err
+		}
+		req.Header.Set("Content-Type", "application/json")
+		if d.ApiKey.ValueString() != "" {
+			req.SetBasicAuth(d.ApiKey.ValueString(), d.ApiSecret.ValueString())
+		}
+		return req, nil
+	}
+
+	var clusters struct {
+		Data []struct {
+			ClusterID string `json:"cluster_id"`
+		} `json:"data"`
+	}
+	var prefix string
+	for _, prefix = range []string{"/kafka/v3", "/v3"} {
+		req, err := newRequest(http.MethodGet, prefix+"/clusters", nil)
+		if err != nil {
+			return err
+		}
+		data, err := t.do(req)
+		var statusErr *statusError
+		if errors.As(err, &statusErr) && statusErr.status == http.StatusNotFound {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		if err := json.Unmarshal(data, &clusters); err != nil {
+			return fmt.Errorf("unable to determine the Kafka cluster of %s: %w", endpoint, err)
+		}
+		break
+	}
+	if len(clusters.Data) == 0 {
+		return fmt.Errorf("unable to determine the Kafka cluster of %s, the endpoint must be a Kafka "+
+			"REST proxy (API v3)", endpoint)
+	}
+
+	record := map[string]any{
+		"value": map[string]any{"type": "JSON", "data": json.RawMessage(message)},
+	}
+	if key := d.Key.ValueString(); key != "" {
+		record["key"] = map[string]any{"type": "STRING", "data": key}
+	}
+	body, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+
+	uri := fmt.Sprintf("%s/clusters/%s/topics/%s/records",
+		prefix, url.PathEscape(clusters.Data[0].ClusterID), url.PathEscape(d.Topic.ValueString()))
+	req, err := newRequest(http.MethodPost, uri, body)
+	if err != nil {
+		return err
+	}
+	data, err := t.do(req)
+	if err != nil {
+		return err
+	}
+
+	var result struct {
+		ErrorCode int    `json:"error_code"`
+		Message   string `json:"message"`
+	}
+	if err := json.Unmarshal(data, &result); err == nil && result.ErrorCode >= 300 {
+		return fmt.Errorf("failed to produce record: %s", result.Message)
+	}
+	return nil
+}
+
+// overrideEndpoint replaces the scheme and host of the URL with the
+// configured endpoint.
+func (t *deliveryTester) overrideEndpoint(value string) (string, error) {
+	if t.endpoint == "" {
+		return value, nil
+	}
+	u, err := url.Parse(value)
+	if err != nil {
+		return "", err
+	}
+	endpoint, err := url.Parse(t.endpoint)
+	if err != nil {
+		return "", err
+	}
+	u.Scheme = endpoint.Scheme
+	u.Host = endpoint.Host
+	return u.String(), nil
+}
+
+// statusError is returned by do for non 2xx responses.
+type statusError struct {
+	method string
+	url    string
+	status int
+	body   string
+}
+
+func (e *statusError) Error() string {
+	return fmt.Sprintf("%s %s returned status %d: %s", e.method, e.url, e.status, e.body)
+}
+
+// do executes the request and returns the response body. A statusError is
+// returned for non 2xx responses.
+func (t *deliveryTester) do(req *http.Request) ([]byte, error) {
+	resp, err := t.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return nil, &statusError{
+			method: req.Method,
+			url:    req.URL.Redacted(),
+			status: resp.StatusCode,
+			body:   strings.TrimSpace(string(body)),
+		}
+	}
+	return body, nil
+}
@@ -0,0 +1,383 @@
+package subscription
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type recordedRequest struct {
+	Method string
+	Path   string
+	Header http.Header
+	Body   string
+}
+
+// newStandIn returns a server which records the requests and responds with
+// the given status and body.
+func newStandIn(t *testing.T, status int, body string) (*httptest.Server, *[]recordedRequest) {
+	var requests []recordedRequest
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		data, _ := io.ReadAll(r.Body)
+		requests = append(requests, recordedRequest{
+			Method: r.Method,
+			Path:   r.URL.Path,
+			Header: r.Header,
+			Body:   string(data),
+		})
+		w.WriteHeader(status)
+		_, _ = w.Write([]byte(body))
+	}))
+	t.Cleanup(server.Close)
+	return server, &requests
+}
+
+func newTestTester(endpoint string) *deliveryTester {
+	tester := newDeliveryTester(TestDelivery{Endpoint: types.StringValue(endpoint)}, "my-project")
+	tester.now = func() time.Time {
+		return time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	}
+	return tester
+}
+
+func testSubscription(d Destination) Subscription {
+	return Subscription{
+		Key:         types.StringValue("my-subscription"),
+		Destination: []Destination{d},
+	}
+}
+
+func TestDeliverSQS(t *testing.T) {
+	server, requests := newStandIn(t, http.StatusOK, "<SendMessageResponse/>")
+	tester := newTestTester(server.URL)
+
+	err := tester.deliver(context.Background(), testSubscription(Destination{
+		Type:         types.StringValue(SQS),
+		QueueURL:     types.StringValue("https://sqs.eu-west-1.amazonaws.com/000000000000/my-queue"),
+		Region:       types.StringValue("eu-west-1"),
+		AccessKey:    types.StringValue("AKID"),
+		AccessSecret: types.StringValue("secret"),
+	}))
+	require.NoError(t, err)
+	require.Len(t, *requests, 1)
+
+	req := (*requests)[0]
+	assert.Equal(t, "/000000000000/my-queue", req.Path)
+	assert.Contains(t, req.Header.Get("Authorization"), "Credential=AKID/20240102/eu-west-1/sqs/aws4_request")
+
+	form, err := url.ParseQuery(req.Body)
+	require.NoError(t, err)
+	assert.Equal(t, "SendMessage", form.Get("Action"))
+	assert.JSONEq(t, `{
+		"notificationType": "ResourceCreated",
+		"resource": {"typeId": "subscription", "id": "00000000-0000-0000-0000-000000000000"},
+		"resourceUserProvidedIdentifiers": {"key": "my-subscription"},
+		"version": 1,
+		"modifiedAt": "2024-01-02T03:04:05Z"
+	}`, form.Get("MessageBody"))
+}
+
+func TestDeliverSNS(t *testing.T) {
+	server, requests := newStandIn(t, http.StatusOK, "<PublishResponse/>")
+	tester := newTestTester(server.URL)
+
+	t.Setenv("AWS_ACCESS_KEY_ID", "test")
+	t.Setenv("AWS_SECRET_ACCESS_KEY", "test")
+	t.Setenv("AWS_SESSION_TOKEN", "")
+	err := tester.deliver(context.Background(), testSubscription(Destination{
+		Type:     types.StringValue(SNS),
+		TopicARN: types.StringValue("arn:aws:sns:us-east-1:000000000000:my-topic"),
+	}))
+	require.NoError(t, err)
+	require.Len(t, *requests, 1)
+
+	req := (*requests)[0]
+	assert.Contains(t, req.Header.Get("Authorization"), "Credential=test/20240102/us-east-1/sns/aws4_request")
+	form, err := url.ParseQuery(req.Body)
+	require.NoError(t, err)
+	assert.Equal(t, "Publish", form.Get("Action"))
+	assert.Equal(t, "arn:aws:sns:us-east-1:000000000000:my-topic", form.Get("TopicArn"))
+
+	err = tester.deliver(context.Background(), testSubscription(Destination{
+		Type:     types.StringValue(SNS),
+		TopicARN: types.StringValue("my-topic"),
+	}))
+	assert.EqualError(t, err, `invalid topic_arn "my-topic"`)
+}
+
+func TestDeliverEventGrid(t *testing.T) {
+	server, requests := newStandIn(t, http.StatusOK, "")
+	tester := newTestTester(server.URL)
+
+	s := testSubscription(Destination{
+		Type:      types.StringValue(EventGrid),
+		URI:       types.StringValue("https://my-topic.westeurope-1.eventgrid.azure.net/api/events"),
+		AccessKey: types.StringValue("grid-key"),
+	})
+	require.NoError(t, tester.deliver(context.Background(), s))
+
+	s.Format = []Format{{Type: types.StringValue("CloudEvents")}}
+	require.NoError(t, tester.deliver(context.Background(), s))
+	require.Len(t, *requests, 2)
+
+	var events []map[string]any
+	req := (*requests)[0]
+	assert.Equal(t, "/api/events", req.Path)
+	assert.Equal(t, "grid-key", req.Header.Get("aeg-sas-key"))
+	require.NoError(t, json.Unmarshal([]byte(req.Body), &events))
+	assert.Equal(t, "ResourceCreated", events[0]["eventType"])
+
+	req = (*requests)[1]
+	assert.True(t, strings.HasPrefix(req.Header.Get("Content-Type"), "application/cloudevents-batch+json"))
+	require.NoError(t, json.Unmarshal([]byte(req.Body), &events))
+	assert.Equal(t, "1.0", events[0]["specversion"])
+}
+
+func TestDeliverServiceBus(t *testing.T) {
+	server, requests := newStandIn(t, http.StatusCreated, "")
+	tester := newTestTester(server.URL)
+
+	err := tester.deliver(context.Background(), testSubscription(Destination{
+		Type: types.StringValue(AzureServiceBus),
+		ConnectionString: types.StringValue(
+			"Endpoint=sb://my-bus.servicebus.windows.net/;SharedAccessKeyName=send;" +
+				"SharedAccessKey=c2VjcmV0=;EntityPath=my-queue"),
+	}))
+	require.NoError(t, err)
+	require.Len(t, *requests, 1)
+
+	req := (*requests)[0]
+	assert.Equal(t, "/my-queue/messages", req.Path)
+	assert.Equal(t,
+		serviceBusToken("https://my-bus.servicebus.windows.net/my-queue", "send", "c2VjcmV0=", tester.now().Add(time.Hour)),
+		req.Header.Get("Authorization"))
+
+	err = tester.deliver(context.Background(), testSubscription(Destination{
+		Type:             types.StringValue(AzureServiceBus),
+		ConnectionString: types.StringValue("Endpoint=sb://my-bus.servicebus.windows.net/"),
+	}))
+	assert.ErrorContains(t, err, "must contain the EntityPath")
+}
+
+func TestServiceBusToken(t *testing.T) {
+	token := serviceBusToken("https://my-bus.servicebus.windows.net/my-queue", "send", "secret", time.Unix(1700000000, 0))
+	assert.Equal(t,
+		"SharedAccessSignature sr=https%3A%2F%2Fmy-bus.servicebus.windows.net%2Fmy-queue"+
+			"&sig=BhZ3MS5dZAqjcqavcfEbF7CDCaiWiFC5IQosIAUIb4s%3D&se=1700000000&skn=send",
+		token)
+}
+
+func TestDeliverPubSub(t *testing.T) {
+	server, requests := newStandIn(t, http.StatusOK, `{"messageIds": ["1"]}`)
+
+	t.Setenv("GOOGLE_OAUTH_ACCESS_TOKEN", "")
+	t.Setenv("PUBSUB_EMULATOR_HOST", strings.TrimPrefix(server.URL, "http://"))
+	tester := newTestTester("")
+
+	err := tester.deliver(context.Background(), testSubscription(Destination{
+		Type:      types.StringValue(GoogleCloudPubSub),
+		ProjectID: types.StringValue("my-project"),
+		Topic:     types.StringValue("my-topic"),
+	}))
+	require.NoError(t, err)
+	require.Len(t, *requests, 1)
+
+	req := (*requests)[0]
+	assert.Equal(t, "/v1/projects/my-project/topics/my-topic:publish", req.Path)
+	assert.Empty(t, req.Header.Get("Authorization"))
+
+	var body struct {
+		Messages []struct {
+			Data string `json:"data"`
+		} `json:"messages"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(req.Body), &body))
+	data, err := base64.StdEncoding.DecodeString(body.Messages[0].Data)
+	require.NoError(t, err)
+	assert.Contains(t, string(data), `"notificationType":"ResourceCreated"`)
+
+	t.Setenv("PUBSUB_EMULATOR_HOST", "")
+	err = tester.deliver(context.Background(), testSubscription(Destination{
+		Type: types.StringValue(GoogleCloudPubSub),
+	}))
+	assert.ErrorContains(t, err, "set GOOGLE_OAUTH_ACCESS_TOKEN")
+}
+
+func TestDeliverConfluent(t *testing.T) {
+	var requests []recordedRequest
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		data, _ := io.ReadAll(r.Body)
+		requests = append(requests, recordedRequest{Method: r.Method, Path: r.URL.Path, Header: r.Header, Body: string(data)})
+
+		user, password, _ := r.BasicAuth()
+		if user != "key" || password != "secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		if r.Method == http.MethodGet {
+			_, _ = w.Write([]byte(`{"data": [{"cluster_id": "lkc-123"}]}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"error_code": 200, "topic_name": "orders"}`))
+	}))
+	defer server.Close()
+	tester := newTestTester(server.URL)
+
+	d := Destination{
+		Type:            types.StringValue(ConfluentCloud),
+		BootstrapServer: types.StringValue("pkc-123.europe-west1.gcp.confluent.cloud:9092"),
+		ApiKey:          types.StringValue("key"),
+		ApiSecret:       types.StringValue("secret"),
+		Topic:           types.StringValue("orders"),
+		Key:             types.StringValue("id"),
+	}
+	require.NoError(t, tester.deliver(context.Background(), testSubscription(d)))
+	require.Len(t, requests, 2)
+	assert.Equal(t, "/kafka/v3/clusters", requests[0].Path)
+	assert.Equal(t, "/kafka/v3/clusters/lkc-123/topics/orders/records", requests[1].Path)
+
+	var record map[string]map[string]any
+	require.NoError(t, json.Unmarshal([]byte(requests[1].Body), &record))
+	assert.Equal(t, "id", record["key"]["data"])
+	assert.Equal(t, "JSON", record["value"]["type"])
+
+	d.ApiSecret = types.StringValue("wrong")
+	err := tester.deliver(context.Background(), testSubscription(d))
+	assert.ErrorContains(t, err, "returned status 401")
+}
+
+// The Confluent REST proxy, which is used to test against a local Kafka, serves
+// the API under /v3 instead of /kafka/v3 and has no authentication.
+func TestDeliverConfluentRESTProxy(t *testing.T) {
+	var requests []recordedRequest
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		data, _ := io.ReadAll(r.Body)
+		requests = append(requests, recordedRequest{Method: r.Method, Path: r.URL.Path, Header: r.Header, Body: string(data)})
+
+		switch r.URL.Path {
+		case "/v3/clusters":
+			_, _ = w.Write([]byte(`{"data": [{"cluster_id": "local"}]}`))
+		case "/v3/clusters/local/topics/orders/records":
+			_, _ = w.Write([]byte(`{"error_code": 200, "topic_name": "orders"}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+	tester := newTestTester(server.URL)
+
+	d := Destination{
+		Type:            types.StringValue(ConfluentCloud),
+		BootstrapServer: types.StringValue("localhost:9092"),
+		Topic:           types.StringValue("orders"),
+	}
+	require.NoError(t, tester.deliver(context.Background(), testSubscription(d)))
+	require.Len(t, requests, 3)
+	assert.Equal(t, "/kafka/v3/clusters", requests[0].Path)
+	assert.Equal(t, "/v3/clusters", requests[1].Path)
+	assert.Equal(t, "/v3/clusters/local/topics/orders/records", requests[2].Path)
+	assert.Empty(t, requests[2].Header.Get("Authorization"))
+
+	// Anything else than a REST proxy
+	notFound, _ := newStandIn(t, http.StatusNotFound, "")
+	tester.endpoint = notFound.URL
+	err := tester.deliver(context.Background(), testSubscription(d))
+	assert.ErrorContains(t, err, "the endpoint must be a Kafka REST proxy (API v3)")
+}
+
+// TestDeliverConfluentKafka produces a message to a Kafka REST proxy in front of
+// a Kafka container, for example started with the docker-compose setup of the
+// confluentinc/cp-kafka-rest image. It is skipped unless KAFKA_REST_PROXY_URL
+// and KAFKA_TOPIC are set.
+func TestDeliverConfluentKafka(t *testing.T) {
+	endpoint := os.Getenv("KAFKA_REST_PROXY_URL")
+	topic := os.Getenv("KAFKA_TOPIC")
+	if endpoint == "" || topic == "" {
+		t.Skip("KAFKA_REST_PROXY_URL and KAFKA_TOPIC must be set to test against a Kafka REST proxy")
+	}
+
+	tester := newTestTester(endpoint)
+	require.NoError(t, tester.deliver(context.Background(), testSubscription(Destination{
+		Type:            types.StringValue(ConfluentCloud),
+		BootstrapServer: types.StringValue("localhost:9092"),
+		Topic:           types.StringValue(topic),
+	})))
+}
+
+func TestDeliverEventBridge(t *testing.T) {
+	t.Setenv("AWS_ACCESS_KEY_ID", "AKID")
+	t.Setenv("AWS_SECRET_ACCESS_KEY", "secret")
+	t.Setenv("AWS_SESSION_TOKEN", "")
+
+	server, requests := newStandIn(t, http.StatusOK, `{"FailedEntryCount": 0, "Entries": [{"EventId": "1"}]}`)
+	tester := newTestTester(server.URL)
+
+	d := Destination{
+		Type:      types.StringValue(EventBridge),
+		Region:    types.StringValue("eu-west-1"),
+		AccountID: types.StringValue("000000000000"),
+	}
+	require.NoError(t, tester.deliver(context.Background(), testSubscription(d)))
+	require.Len(t, *requests, 1)
+	r := (*requests)[0]
+	assert.Equal(t, "AWSEvents.PutEvents", r.Header.Get("X-Amz-Target"))
+	assert.Contains(t, r.Header.Get("Authorization"), "/eu-west-1/events/aws4_request")
+
+	var body struct {
+		Entries []struct {
+			EventBusName string
+			Source       string
+			DetailType   string
+			Detail       string
+		}
+	}
+	require.NoError(t, json.Unmarshal([]byte(r.Body), &body))
+	require.Len(t, body.Entries, 1)
+	assert.Equal(t, "aws.partner/commercetools.com/my-project/my-subscription", body.Entries[0].EventBusName)
+	assert.Equal(t, "ResourceCreated", body.Entries[0].DetailType)
+	assert.Contains(t, body.Entries[0].Detail, testMessageID)
+
+	// The event bus can be set explicitly
+	tester.eventBus = "my-bus"
+	require.NoError(t, tester.deliver(context.Background(), testSubscription(d)))
+	assert.Contains(t, (*requests)[1].Body, `"EventBusName":"my-bus"`)
+
+	// Failures are reported per entry
+	server, _ = newStandIn(t, http.StatusOK, `{"FailedEntryCount": 1, "Entries": [
+		{"ErrorCode": "ResourceNotFoundException", "ErrorMessage": "Event bus my-bus does not exist."}
+	]}`)
+	tester.endpoint = server.URL
+	err := tester.deliver(context.Background(), testSubscription(d))
+	assert.EqualError(t, err, "failed to put the event on my-bus: ResourceNotFoundException: Event bus my-bus does not exist.")
+
+	// Without a subscription key the event bus is not known
+	tester.eventBus = ""
+	err = tester.deliver(context.Background(), Subscription{Destination: []Destination{d}})
+	assert.ErrorContains(t, err, "set the key of the subscription, or the event_bus of the test_delivery")
+}
+
+func TestDeliverError(t *testing.T) {
+	server, _ := newStandIn(t, http.StatusForbidden, "<Error>AccessDenied</Error>")
+	tester := newTestTester(server.URL)
+
+	err := tester.deliver(context.Background(), testSubscription(Destination{
+		Type:         types.StringValue(SQS),
+		QueueURL:     types.StringValue("https://sqs.eu-west-1.amazonaws.com/000000000000/my-queue"),
+		Region:       types.StringValue("eu-west-1"),
+		AccessKey:    types.StringValue("AKID"),
+		AccessSecret: types.StringValue("secret"),
+	}))
+	assert.ErrorContains(t, err, "returned status 403: <Error>AccessDenied</Error>")
+}
@@ -38,6 +38,14 @@ func upgradeStateV0(_ context.Context, req resource.UpgradeStateRequest, resp *r
 			"destination": valueDestinationV1(rawState, "destination"),
 			"format":      valueToFormatV1(rawState, "format"),
 			"message":     rawState["message"],
+			"event": tftypes.NewValue(
+				SubscriptionResourceV1.AttributeTypes["event"],
+				[]tftypes.Value{},
+			),
+			"test_delivery": tftypes.NewValue(
+				SubscriptionResourceV1.AttributeTypes["test_delivery"],
+				[]tftypes.Value{},
+			),
 		}),
 	)
 
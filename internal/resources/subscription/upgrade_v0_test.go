@@ -55,9 +55,11 @@ func Test_UpgradeStateV0(t *testing.T) {
 	`)
 
 	expected := Subscription{
-		Version: types.Int64Value(4),
-		ID:      types.StringValue("447b287d-e196-433c-b8ef-b858511b61ff"),
-		Key:     types.StringValue("my-subscription-key"),
+		Version:      types.Int64Value(4),
+		ID:           types.StringValue("447b287d-e196-433c-b8ef-b858511b61ff"),
+		Key:          types.StringValue("my-subscription-key"),
+		Events:       []Event{},
+		TestDelivery: []TestDelivery{},
 		Changes: []Changes{
 			{
 				ResourceTypeIds: []types.String{
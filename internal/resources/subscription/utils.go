@@ -36,6 +36,26 @@ var SubscriptionResourceV1 = tftypes.Object{
 				},
 			},
 		},
+		"event": tftypes.Set{
+			ElementType: tftypes.Object{
+				AttributeTypes: map[string]tftypes.Type{
+					"resource_type_id": tftypes.String,
+					"types": tftypes.List{
+						ElementType: tftypes.String,
+					},
+				},
+			},
+		},
+		"test_delivery": tftypes.List{
+			ElementType: testDeliveryType,
+		},
+	},
+}
+
+var testDeliveryType = tftypes.Object{
+	AttributeTypes: map[string]tftypes.Type{
+		"endpoint":  tftypes.String,
+		"event_bus": tftypes.String,
 	},
 }
 
@@ -64,6 +84,7 @@ var destinationType = tftypes.Object{
 		"api_secret":        tftypes.String,
 		"acks":              tftypes.String,
 		"key":               tftypes.String,
+		"secret_version":    tftypes.Number,
 	},
 }
 
@@ -106,6 +127,7 @@ func valueDestinationV1(state map[string]tftypes.Value, key string) tftypes.Valu
 		newVal["api_secret"] = tftypes.NewValue(tftypes.String, nil)
 		newVal["acks"] = tftypes.NewValue(tftypes.String, nil)
 		newVal["key"] = tftypes.NewValue(tftypes.String, nil)
+		newVal["secret_version"] = tftypes.NewValue(tftypes.Number, nil)
 
 		val = tftypes.NewValue(destinationType, newVal)
 
@@ -1,10 +1,40 @@
 package utils
 
 import (
+	"github.com/labd/commercetools-go-sdk/history"
+	"github.com/labd/commercetools-go-sdk/importapi"
 	"github.com/labd/commercetools-go-sdk/platform"
 )
 
 type ProviderData struct {
-	Client *platform.ByProjectKeyRequestBuilder
-	Mutex  *MutexKV
+	ProjectKey   string
+	Client       *platform.ByProjectKeyRequestBuilder
+	ImportClient *importapi.ByProjectKeyRequestBuilder
+	Mutex        *MutexKV
+
+	// RawClient is used for fields of the API which the SDK does not
+	// support yet.
+	RawClient *RawClient
+
+	// HistoryClient is used by the change history data source, and to
+	// report drift when HistoryDriftWarnings is enabled.
+	HistoryClient        *history.ByProjectKeyRequestBuilder
+	HistoryDriftWarnings bool
+
+	// Locales validates localized strings against the project languages. It
+	// is nil unless validate_locales is enabled.
+	Locales *LocaleValidator
 }
+
+// DriftReporter returns the history client when drift warnings are enabled.
+func (p *ProviderData) DriftReporter() *history.ByProjectKeyRequestBuilder {
+	if !p.HistoryDriftWarnings {
+		return nil
+	}
+	return p.HistoryClient
+}
+
+// ImportClientMissingError is the error detail reported by resources that use
+// the Import API when the provider was not able to create a client for it.
+const ImportClientMissingError = "The URL of the Import API could not be determined from the api_url. " +
+	"Set the import_api_url provider setting or the CTP_IMPORT_API_URL environment variable."
@@ -7,6 +7,7 @@ import (
 	"reflect"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/labd/commercetools-go-sdk/importapi"
 	"github.com/labd/commercetools-go-sdk/platform"
 )
 
@@ -29,6 +30,14 @@ func ProcessRemoteError(err error) *resource.RetryError {
 			}
 			return resource.NonRetryableError(e)
 		}
+
+	case importapi.GenericRequestError:
+		{
+			if err := extractRawDetailedError(e.Content); err != nil {
+				return resource.NonRetryableError(err)
+			}
+			return resource.NonRetryableError(e)
+		}
 	}
 
 	return resource.RetryableError(err)
@@ -91,7 +100,7 @@ func extractRawDetailedError(content []byte) error {
 func IsResourceNotFoundError(err error) bool {
 	//Occasionally the SDK returns a sentinel value instead of the parsed error response for 404.
 	//This is a workaround to handle that case.
-	if errors.Is(err, platform.ErrNotFound) {
+	if errors.Is(err, platform.ErrNotFound) || errors.Is(err, importapi.ErrNotFound) {
 		return true
 	}
 
@@ -104,6 +113,9 @@ func IsResourceNotFoundError(err error) bool {
 
 	case platform.GenericRequestError:
 		return e.StatusCode == 404
+
+	case importapi.GenericRequestError:
+		return e.StatusCode == 404
 	}
 	return false
 }
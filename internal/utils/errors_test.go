@@ -1,6 +1,7 @@
 package utils
 
 import (
+	"github.com/labd/commercetools-go-sdk/importapi"
 	"github.com/labd/commercetools-go-sdk/platform"
 	"github.com/stretchr/testify/assert"
 	"testing"
@@ -17,6 +18,9 @@ func TestIsResourceNotFoundError(t *testing.T) {
 		{platform.ErrorResponse{StatusCode: 500}, false},
 		{platform.GenericRequestError{StatusCode: 404}, true},
 		{platform.GenericRequestError{StatusCode: 500}, false},
+		{importapi.ErrNotFound, true},
+		{importapi.GenericRequestError{StatusCode: 404}, true},
+		{importapi.GenericRequestError{StatusCode: 500}, false},
 	}
 
 	for _, tt := range cases {
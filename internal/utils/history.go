@@ -0,0 +1,95 @@
+package utils
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/labd/commercetools-go-sdk/history"
+)
+
+// LastModifiedByWarning reports who last modified a resource when the version
+// in commercetools is newer than the version in the state, which means the
+// resource was modified outside of Terraform. The warning is best effort: no
+// diagnostics are returned when the client is nil or the History API has no
+// record of the change (yet).
+func LastModifiedByWarning(
+	ctx context.Context,
+	client *history.ByProjectKeyRequestBuilder,
+	resourceType history.ChangeHistoryResourceType,
+	id string,
+	stateVersion, remoteVersion types.Int64,
+) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if stateVersion.IsNull() || stateVersion.IsUnknown() {
+		return diags
+	}
+	summary, detail := LastModifiedBy(ctx, client, resourceType, id, stateVersion.ValueInt64(), remoteVersion.ValueInt64())
+	if summary != "" {
+		diags.AddWarning(summary, detail)
+	}
+	return diags
+}
+
+// LastModifiedBy returns the summary and detail of the warning reported by
+// LastModifiedByWarning, or empty strings when there is nothing to report. It
+// is used by the resources of the SDK provider, which have their own
+// diagnostics type.
+func LastModifiedBy(
+	ctx context.Context,
+	client *history.ByProjectKeyRequestBuilder,
+	resourceType history.ChangeHistoryResourceType,
+	id string,
+	stateVersion, remoteVersion int64,
+) (string, string) {
+	if client == nil || stateVersion == 0 || remoteVersion <= stateVersion {
+		return "", ""
+	}
+
+	result, err := client.
+		WithResourceTypeValue(string(resourceType)).
+		WithIdValue(id).
+		Get().
+		Limit(1).
+		Execute(ctx)
+	if err != nil || len(result.Results) == 0 {
+		return "", ""
+	}
+
+	record := result.Results[0]
+	return fmt.Sprintf("The %s was modified outside of Terraform", resourceType),
+		fmt.Sprintf(
+			"The %s %s was last modified by %s at %s (version %d, the state has version %d).",
+			resourceType, id, DescribeModifiedBy(record.ModifiedBy), record.ModifiedAt,
+			record.Version, stateVersion,
+		)
+}
+
+// DescribeModifiedBy returns a human-readable description of the user or API
+// client which performed a change.
+func DescribeModifiedBy(m history.ModifiedBy) string {
+	var parts []string
+	switch {
+	case m.IsPlatformClient && m.ID != "":
+		parts = append(parts, fmt.Sprintf("Merchant Center user %s", m.ID))
+	case m.IsPlatformClient:
+		parts = append(parts, "the Merchant Center")
+	case m.ID != "":
+		parts = append(parts, fmt.Sprintf("%s %s", m.Type, m.ID))
+	}
+	if m.ClientId != nil && !m.IsPlatformClient {
+		parts = append(parts, fmt.Sprintf("API client %s", *m.ClientId))
+	}
+	if m.Associate != nil {
+		parts = append(parts, fmt.Sprintf("associate %s", m.Associate.ID))
+	}
+	if m.Customer != nil {
+		parts = append(parts, fmt.Sprintf("customer %s", m.Customer.ID))
+	}
+	if len(parts) == 0 {
+		return "an unknown " + m.Type
+	}
+	return strings.Join(parts, " using ")
+}
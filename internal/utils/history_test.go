@@ -0,0 +1,73 @@
+package utils
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/labd/commercetools-go-sdk/history"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDescribeModifiedBy(t *testing.T) {
+	var cases = []struct {
+		modifiedBy history.ModifiedBy
+		expected   string
+	}{
+		{history.ModifiedBy{ID: "user-1", Type: "user", IsPlatformClient: true}, "Merchant Center user user-1"},
+		{history.ModifiedBy{Type: "external-user", ClientId: StringRef("client-1")}, "API client client-1"},
+		{
+			history.ModifiedBy{Type: "associate", ClientId: StringRef("client-1"), Associate: &history.Reference{ID: "customer-1"}},
+			"API client client-1 using associate customer-1",
+		},
+		{history.ModifiedBy{Type: "external-user"}, "an unknown external-user"},
+	}
+
+	for _, tt := range cases {
+		assert.Equal(t, tt.expected, DescribeModifiedBy(tt.modifiedBy))
+	}
+}
+
+func TestLastModifiedByWarning(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/my-project/state/state-id", r.URL.Path)
+		assert.Equal(t, "1", r.URL.Query().Get("limit"))
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{
+			"limit": 1, "count": 1, "total": 1, "offset": 0,
+			"results": [{
+				"version": 3,
+				"previousVersion": 2,
+				"type": "ResourceUpdated",
+				"modifiedAt": "2024-01-01T12:00:00.000Z",
+				"modifiedBy": {"id": "user-1", "type": "user", "isPlatformClient": true},
+				"changes": [],
+				"resource": {"id": "state-id", "typeId": "state"}
+			}]
+		}`))
+	}))
+	defer server.Close()
+
+	client, err := history.NewClient(&history.ClientConfig{URL: server.URL})
+	require.NoError(t, err)
+	projectClient := client.WithProjectKeyValue("my-project")
+
+	ctx := context.Background()
+
+	diags := LastModifiedByWarning(ctx, projectClient, history.ChangeHistoryResourceTypeState, "state-id", types.Int64Value(2), types.Int64Value(3))
+	require.Len(t, diags, 1)
+	assert.Equal(t, "The state was modified outside of Terraform", diags[0].Summary())
+	assert.Contains(t, diags[0].Detail(), "Merchant Center user user-1 at 2024-01-01T12:00:00.000Z")
+
+	// Same version, no warning
+	assert.Empty(t, LastModifiedByWarning(ctx, projectClient, history.ChangeHistoryResourceTypeState, "state-id", types.Int64Value(3), types.Int64Value(3)))
+
+	// No version in state, for example after an import
+	assert.Empty(t, LastModifiedByWarning(ctx, projectClient, history.ChangeHistoryResourceTypeState, "state-id", types.Int64Null(), types.Int64Value(3)))
+
+	// Disabled
+	assert.Empty(t, LastModifiedByWarning(ctx, nil, history.ChangeHistoryResourceTypeState, "state-id", types.Int64Value(2), types.Int64Value(3)))
+}
@@ -0,0 +1,434 @@
+package utils
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"regexp"
+	"sort"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/elliotchance/pie/v2"
+
+	"github.com/labd/terraform-provider-commercetools/internal/customtypes"
+)
+
+// jsonSchemaKeywords are the JSON Schema keywords which are supported by
+// ValidateJSONSchema. The value is the kind of value the keyword expects.
+var jsonSchemaKeywords = map[string]string{
+	"type":                 "type name",
+	"enum":                 "array",
+	"const":                "any",
+	"properties":           "object of schemas",
+	"required":             "array of strings",
+	"additionalProperties": "schema",
+	"minProperties":        "integer",
+	"maxProperties":        "integer",
+	"items":                "schema",
+	"minItems":             "integer",
+	"maxItems":             "integer",
+	"uniqueItems":          "boolean",
+	"minLength":            "integer",
+	"maxLength":            "integer",
+	"pattern":              "pattern",
+	"minimum":              "number",
+	"maximum":              "number",
+	"exclusiveMinimum":     "number",
+	"exclusiveMaximum":     "number",
+	"multipleOf":           "number",
+	"allOf":                "array of schemas",
+	"anyOf":                "array of schemas",
+	"oneOf":                "array of schemas",
+	"not":                  "schema",
+
+	// Annotations, these do not affect the validation
+	"$schema":     "any",
+	"$id":         "any",
+	"$comment":    "any",
+	"title":       "any",
+	"description": "any",
+	"default":     "any",
+	"examples":    "any",
+	"format":      "any",
+	"deprecated":  "any",
+	"readOnly":    "any",
+	"writeOnly":   "any",
+}
+
+var jsonSchemaTypes = []string{"array", "boolean", "integer", "null", "number", "object", "string"}
+
+// ParseJSONSchema decodes and checks a JSON schema. Only the keywords which
+// are supported by ValidateJSONSchema are accepted, so a schema is never
+// silently ignored. References ($ref) are not supported.
+func ParseJSONSchema(value string) (any, error) {
+	decoder := json.NewDecoder(strings.NewReader(value))
+	decoder.UseNumber()
+
+	var schema any
+	if err := decoder.Decode(&schema); err != nil {
+		return nil, fmt.Errorf("invalid JSON: %w", err)
+	}
+	if err := checkJSONSchema(schema, ""); err != nil {
+		return nil, err
+	}
+	return schema, nil
+}
+
+func checkJSONSchema(schema any, path string) error {
+	if _, ok := schema.(bool); ok {
+		return nil
+	}
+	s, ok := schema.(map[string]any)
+	if !ok {
+		return fmt.Errorf("%s: a schema must be an object or a boolean", jsonPointer(path))
+	}
+
+	for _, keyword := range sortedMapKeys(s) {
+		kind, ok := jsonSchemaKeywords[keyword]
+		if !ok {
+			return fmt.Errorf("%s: unsupported keyword %q", jsonPointer(path), keyword)
+		}
+
+		value := s[keyword]
+		location := path + "/" + keyword
+		invalid := fmt.Errorf("%s: invalid value for %s, expected %s", jsonPointer(path), keyword, kind)
+		switch kind {
+		case "type name":
+			names, ok := stringList(value)
+			if !ok {
+				return invalid
+			}
+			for _, name := range names {
+				if !pie.Contains(jsonSchemaTypes, name) {
+					return fmt.Errorf("%s: unknown type %q, expected one of %s",
+						jsonPointer(path), name, strings.Join(jsonSchemaTypes, ", "))
+				}
+			}
+		case "array":
+			if _, ok := value.([]any); !ok {
+				return invalid
+			}
+		case "array of strings":
+			if _, ok := value.([]any); !ok {
+				return invalid
+			}
+			if _, ok := stringList(value); !ok {
+				return invalid
+			}
+		case "boolean":
+			if _, ok := value.(bool); !ok {
+				return invalid
+			}
+		case "integer":
+			f := numberValue(value)
+			if f == nil || !f.IsInt() || f.Sign() < 0 {
+				return fmt.Errorf("%s: %s must be a non-negative integer", jsonPointer(path), keyword)
+			}
+		case "number":
+			if numberValue(value) == nil {
+				return invalid
+			}
+		case "pattern":
+			p, ok := value.(string)
+			if !ok {
+				return invalid
+			}
+			if _, err := regexp.Compile(p); err != nil {
+				return fmt.Errorf("%s: invalid pattern: %w", jsonPointer(path), err)
+			}
+		case "schema":
+			if err := checkJSONSchema(value, location); err != nil {
+				return err
+			}
+		case "object of schemas":
+			properties, ok := value.(map[string]any)
+			if !ok {
+				return invalid
+			}
+			for _, name := range sortedMapKeys(properties) {
+				if err := checkJSONSchema(properties[name], location+"/"+escapeJSONPointer(name)); err != nil {
+					return err
+				}
+			}
+		case "array of schemas":
+			schemas, ok := value.([]any)
+			if !ok || len(schemas) == 0 {
+				return fmt.Errorf("%s: %s must be a non-empty array of schemas", jsonPointer(path), keyword)
+			}
+			for i, item := range schemas {
+				if err := checkJSONSchema(item, fmt.Sprintf("%s/%d", location, i)); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// ValidateJSONSchema validates the decoded JSON document against a schema
+// returned by ParseJSONSchema. It returns the problems found, each prefixed
+// with the JSON pointer of the invalid value.
+func ValidateJSONSchema(schema, data any) []string {
+	return validateJSONSchema(schema, data, "")
+}
+
+func validateJSONSchema(schema, data any, path string) []string {
+	if allowed, ok := schema.(bool); ok {
+		if !allowed {
+			return []string{fmt.Sprintf("%s: no value is allowed", jsonPointer(path))}
+		}
+		return nil
+	}
+	s, _ := schema.(map[string]any)
+
+	var result []string
+	fail := func(format string, args ...any) {
+		result = append(result, jsonPointer(path)+": "+fmt.Sprintf(format, args...))
+	}
+
+	if value, ok := s["type"]; ok {
+		names, _ := stringList(value)
+		if !pie.Any(names, func(name string) bool { return isJSONType(data, name) }) {
+			fail("expected %s, got %s", strings.Join(names, " or "), jsonTypeOf(data))
+			// The other keywords are not meaningful for a value of another
+			// type
+			return result
+		}
+	}
+	if value, ok := s["enum"]; ok {
+		options, _ := value.([]any)
+		if !pie.Any(options, func(o any) bool { return customtypes.JSONEqual(o, data) }) {
+			fail("value must be one of %s", mustMarshal(options))
+		}
+	}
+	if value, ok := s["const"]; ok && !customtypes.JSONEqual(value, data) {
+		fail("value must be %s", mustMarshal(value))
+	}
+
+	switch v := data.(type) {
+	case map[string]any:
+		properties, _ := s["properties"].(map[string]any)
+		if required, ok := stringList(s["required"]); ok {
+			for _, name := range required {
+				if _, ok := v[name]; !ok {
+					fail("missing required property %q", name)
+				}
+			}
+		}
+		for _, name := range sortedMapKeys(v) {
+			location := path + "/" + escapeJSONPointer(name)
+			if propertySchema, ok := properties[name]; ok {
+				result = append(result, validateJSONSchema(propertySchema, v[name], location)...)
+			} else if additional, ok := s["additionalProperties"]; ok {
+				if allowed, ok := additional.(bool); ok && !allowed {
+					fail("property %q is not allowed", name)
+				} else {
+					result = append(result, validateJSONSchema(additional, v[name], location)...)
+				}
+			}
+		}
+		if limit := intKeyword(s, "minProperties"); limit >= 0 && len(v) < limit {
+			fail("expected at least %d properties, got %d", limit, len(v))
+		}
+		if limit := intKeyword(s, "maxProperties"); limit >= 0 && len(v) > limit {
+			fail("expected at most %d properties, got %d", limit, len(v))
+		}
+	case []any:
+		if items, ok := s["items"]; ok {
+			for i, item := range v {
+				result = append(result, validateJSONSchema(items, item, fmt.Sprintf("%s/%d", path, i))...)
+			}
+		}
+		if limit := intKeyword(s, "minItems"); limit >= 0 && len(v) < limit {
+			fail("expected at least %d items, got %d", limit, len(v))
+		}
+		if limit := intKeyword(s, "maxItems"); limit >= 0 && len(v) > limit {
+			fail("expected at most %d items, got %d", limit, len(v))
+		}
+		if unique, _ := s["uniqueItems"].(bool); unique {
+			for i := range v {
+				for j := i + 1; j < len(v); j++ {
+					if customtypes.JSONEqual(v[i], v[j]) {
+						fail("items %d and %d are equal, items must be unique", i, j)
+					}
+				}
+			}
+		}
+	case string:
+		length := utf8.RuneCountInString(v)
+		if limit := intKeyword(s, "minLength"); limit >= 0 && length < limit {
+			fail("expected at least %d characters, got %d", limit, length)
+		}
+		if limit := intKeyword(s, "maxLength"); limit >= 0 && length > limit {
+			fail("expected at most %d characters, got %d", limit, length)
+		}
+		if pattern, ok := s["pattern"].(string); ok {
+			if !regexp.MustCompile(pattern).MatchString(v) {
+				fail("value %q does not match pattern %q", v, pattern)
+			}
+		}
+	case json.Number, float64:
+		n := numberValue(v)
+		if limit := numberValue(s["minimum"]); limit != nil && n.Cmp(limit) < 0 {
+			fail("value must be at least %s", limit.Text('g', -1))
+		}
+		if limit := numberValue(s["maximum"]); limit != nil && n.Cmp(limit) > 0 {
+			fail("value must be at most %s", limit.Text('g', -1))
+		}
+		if limit := numberValue(s["exclusiveMinimum"]); limit != nil && n.Cmp(limit) <= 0 {
+			fail("value must be greater than %s", limit.Text('g', -1))
+		}
+		if limit := numberValue(s["exclusiveMaximum"]); limit != nil && n.Cmp(limit) >= 0 {
+			fail("value must be less than %s", limit.Text('g', -1))
+		}
+		// Decimals are compared as fractions, so 0.3 is a multiple of 0.1
+		if factor := ratValue(s["multipleOf"]); factor != nil && factor.Sign() != 0 {
+			if value := ratValue(v); value != nil && !new(big.Rat).Quo(value, factor).IsInt() {
+				fail("value must be a multiple of %s", factor.FloatString(decimals(s["multipleOf"])))
+			}
+		}
+	}
+
+	if schemas, ok := s["allOf"].([]any); ok {
+		for _, sub := range schemas {
+			result = append(result, validateJSONSchema(sub, data, path)...)
+		}
+	}
+	if schemas, ok := s["anyOf"].([]any); ok {
+		if !pie.Any(schemas, func(sub any) bool { return len(validateJSONSchema(sub, data, path)) == 0 }) {
+			fail("value does not match any of the schemas in anyOf")
+		}
+	}
+	if schemas, ok := s["oneOf"].([]any); ok {
+		matches := 0
+		for _, sub := range schemas {
+			if len(validateJSONSchema(sub, data, path)) == 0 {
+				matches++
+			}
+		}
+		if matches != 1 {
+			fail("value must match exactly one of the schemas in oneOf, matches %d", matches)
+		}
+	}
+	if sub, ok := s["not"]; ok && len(validateJSONSchema(sub, data, path)) == 0 {
+		fail("value must not match the schema in not")
+	}
+
+	return result
+}
+
+func isJSONType(data any, name string) bool {
+	switch name {
+	case "integer":
+		n := numberValue(data)
+		return n != nil && n.IsInt()
+	case "number":
+		return numberValue(data) != nil
+	default:
+		return jsonTypeOf(data) == name
+	}
+}
+
+func jsonTypeOf(data any) string {
+	switch data.(type) {
+	case nil:
+		return "null"
+	case bool:
+		return "boolean"
+	case string:
+		return "string"
+	case json.Number, float64:
+		return "number"
+	case []any:
+		return "array"
+	case map[string]any:
+		return "object"
+	}
+	return fmt.Sprintf("%T", data)
+}
+
+func numberValue(v any) *big.Float {
+	switch v := v.(type) {
+	case json.Number:
+		f, _, err := big.ParseFloat(v.String(), 10, 256, big.ToNearestEven)
+		if err != nil {
+			return nil
+		}
+		return f
+	case float64:
+		return big.NewFloat(v)
+	}
+	return nil
+}
+
+func ratValue(v any) *big.Rat {
+	switch v := v.(type) {
+	case json.Number:
+		r, ok := new(big.Rat).SetString(v.String())
+		if !ok {
+			return nil
+		}
+		return r
+	case float64:
+		return new(big.Rat).SetFloat64(v)
+	}
+	return nil
+}
+
+func decimals(v any) int {
+	_, fraction, _ := strings.Cut(fmt.Sprint(v), ".")
+	return len(fraction)
+}
+
+func intKeyword(schema map[string]any, keyword string) int {
+	n := numberValue(schema[keyword])
+	if n == nil {
+		return -1
+	}
+	i, _ := n.Int64()
+	return int(i)
+}
+
+func stringList(v any) ([]string, bool) {
+	switch v := v.(type) {
+	case string:
+		return []string{v}, true
+	case []any:
+		result := make([]string, 0, len(v))
+		for _, item := range v {
+			s, ok := item.(string)
+			if !ok {
+				return nil, false
+			}
+			result = append(result, s)
+		}
+		return result, true
+	}
+	return nil, false
+}
+
+func sortedMapKeys(m map[string]any) []string {
+	result := make([]string, 0, len(m))
+	for k := range m {
+		result = append(result, k)
+	}
+	sort.Strings(result)
+	return result
+}
+
+func mustMarshal(v any) string {
+	data, _ := json.Marshal(v)
+	return string(data)
+}
+
+func jsonPointer(path string) string {
+	if path == "" {
+		return "value"
+	}
+	return "value" + path
+}
+
+func escapeJSONPointer(name string) string {
+	return strings.NewReplacer("~", "~0", "/", "~1").Replace(name)
+}
@@ -0,0 +1,164 @@
+package utils
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func decodeTestJSON(t *testing.T, value string) any {
+	decoder := json.NewDecoder(strings.NewReader(value))
+	decoder.UseNumber()
+
+	var data any
+	require.NoError(t, decoder.Decode(&data))
+	return data
+}
+
+func TestParseJSONSchema(t *testing.T) {
+	testCases := []struct {
+		name   string
+		schema string
+		err    string
+	}{
+		{
+			name:   "valid",
+			schema: `{"type": "object", "properties": {"enabled": {"type": "boolean"}}, "required": ["enabled"]}`,
+		},
+		{
+			name:   "boolean schema",
+			schema: `true`,
+		},
+		{
+			name:   "invalid JSON",
+			schema: `{"type": `,
+			err:    "invalid JSON",
+		},
+		{
+			name:   "unsupported keyword",
+			schema: `{"properties": {"address": {"$ref": "#/$defs/address"}}}`,
+			err:    `value/properties/address: unsupported keyword "$ref"`,
+		},
+		{
+			name:   "unknown type",
+			schema: `{"type": "float"}`,
+			err:    `value: unknown type "float"`,
+		},
+		{
+			name:   "invalid keyword value",
+			schema: `{"minItems": -1}`,
+			err:    "value: minItems must be a non-negative integer",
+		},
+		{
+			name:   "invalid pattern",
+			schema: `{"pattern": "("}`,
+			err:    "value: invalid pattern",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := ParseJSONSchema(tc.schema)
+			if tc.err == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorContains(t, err, tc.err)
+			}
+		})
+	}
+}
+
+func TestValidateJSONSchema(t *testing.T) {
+	schema, err := ParseJSONSchema(`{
+		"type": "object",
+		"required": ["enabled", "provider"],
+		"additionalProperties": false,
+		"properties": {
+			"enabled": {"type": "boolean"},
+			"provider": {"enum": ["algolia", "commercetools"]},
+			"percentage": {"type": "integer", "minimum": 0, "maximum": 100},
+			"ratio": {"type": "number", "exclusiveMaximum": 1, "multipleOf": 0.25},
+			"tags": {"type": "array", "items": {"type": "string", "pattern": "^[a-z]+$"}, "uniqueItems": true, "maxItems": 3},
+			"name": {"type": ["string", "null"], "minLength": 2}
+		}
+	}`)
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name     string
+		value    string
+		expected []string
+	}{
+		{
+			name:  "valid",
+			value: `{"enabled": true, "provider": "algolia", "percentage": 25, "ratio": 0.5, "tags": ["a", "b"], "name": null}`,
+		},
+		{
+			name:     "wrong type",
+			value:    `[]`,
+			expected: []string{"value: expected object, got array"},
+		},
+		{
+			name:  "invalid properties",
+			value: `{"enabled": "yes", "provider": "solr", "percentage": 25.5, "ratio": 1, "tags": ["a", "B", "a", "c"], "name": "x", "extra": 1}`,
+			expected: []string{
+				"value/enabled: expected boolean, got string",
+				`value: property "extra" is not allowed`,
+				"value/name: expected at least 2 characters, got 1",
+				"value/percentage: expected integer, got number",
+				`value/provider: value must be one of ["algolia","commercetools"]`,
+				"value/ratio: value must be less than 1",
+				`value/tags/1: value "B" does not match pattern "^[a-z]+$"`,
+				"value/tags: expected at most 3 items, got 4",
+				"value/tags: items 0 and 2 are equal, items must be unique",
+			},
+		},
+		{
+			name:  "missing required",
+			value: `{"ratio": 0.3}`,
+			expected: []string{
+				`value: missing required property "enabled"`,
+				`value: missing required property "provider"`,
+				"value/ratio: value must be a multiple of 0.25",
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			result := ValidateJSONSchema(schema, decodeTestJSON(t, tc.value))
+			assert.Equal(t, tc.expected, result)
+		})
+	}
+}
+
+func TestValidateJSONSchemaCombinators(t *testing.T) {
+	schema, err := ParseJSONSchema(`{
+		"oneOf": [{"type": "string"}, {"type": "integer"}],
+		"not": {"const": "forbidden"}
+	}`)
+	require.NoError(t, err)
+
+	assert.Empty(t, ValidateJSONSchema(schema, "allowed"))
+	assert.Empty(t, ValidateJSONSchema(schema, json.Number("10")))
+	assert.Equal(t,
+		[]string{"value: value must match exactly one of the schemas in oneOf, matches 0"},
+		ValidateJSONSchema(schema, true))
+	assert.Equal(t,
+		[]string{"value: value must not match the schema in not"},
+		ValidateJSONSchema(schema, "forbidden"))
+}
+
+func TestValidateJSONSchemaMultipleOf(t *testing.T) {
+	schema, err := ParseJSONSchema(`{"multipleOf": 0.1}`)
+	require.NoError(t, err)
+
+	assert.Empty(t, ValidateJSONSchema(schema, json.Number("0.3")))
+	assert.Empty(t, ValidateJSONSchema(schema, json.Number("12")))
+	assert.Equal(t,
+		[]string{"value: value must be a multiple of 0.1"},
+		ValidateJSONSchema(schema, json.Number("0.35")))
+}
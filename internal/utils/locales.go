@@ -0,0 +1,201 @@
+package utils
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/labd/commercetools-go-sdk/platform"
+	"golang.org/x/text/language"
+
+	"github.com/labd/terraform-provider-commercetools/internal/customtypes"
+)
+
+// LocaleValidator validates the locales of localized strings against the
+// languages of the project. The languages are fetched once.
+type LocaleValidator struct {
+	client *platform.ByProjectKeyRequestBuilder
+
+	once      sync.Once
+	languages []string
+	err       error
+}
+
+var (
+	localeValidators   = map[string]*LocaleValidator{}
+	localeValidatorsMu sync.Mutex
+)
+
+// NewLocaleValidator returns the locale validator for the project. Both the
+// SDK and the framework provider are configured in the same process, so the
+// validator is shared to fetch the languages only once per run.
+func NewLocaleValidator(client *platform.ByProjectKeyRequestBuilder, apiURL, projectKey string) *LocaleValidator {
+	localeValidatorsMu.Lock()
+	defer localeValidatorsMu.Unlock()
+
+	key := apiURL + "/" + projectKey
+	if v, ok := localeValidators[key]; ok {
+		return v
+	}
+	v := &LocaleValidator{client: client}
+	localeValidators[key] = v
+	return v
+}
+
+// Languages returns the languages of the project.
+func (v *LocaleValidator) Languages(ctx context.Context) ([]string, error) {
+	v.once.Do(func() {
+		project, err := v.client.Get().Execute(ctx)
+		if err != nil {
+			v.err = fmt.Errorf("unable to fetch the languages of the project for validate_locales: %w", err)
+			return
+		}
+		v.languages = project.Languages
+	})
+	return v.languages, v.err
+}
+
+// ValidatePlan validates the keys of all localized string attributes in the
+// plan, including those in nested blocks. The validator may be nil, in which
+// case nothing is validated.
+func (v *LocaleValidator) ValidatePlan(ctx context.Context, plan tfsdk.Plan) diag.Diagnostics {
+	if v == nil || plan.Raw.IsNull() {
+		return nil
+	}
+	languages, err := v.Languages(ctx)
+	if err != nil {
+		var diags diag.Diagnostics
+		diags.AddError("Unable to validate locales", err.Error())
+		return diags
+	}
+	return ValidatePlanLocales(ctx, plan, languages)
+}
+
+// ValidatePlanLocales validates the keys of all localized string attributes
+// in the plan against the given languages.
+func ValidatePlanLocales(ctx context.Context, plan tfsdk.Plan, languages []string) diag.Diagnostics {
+	var diags diag.Diagnostics
+	_ = tftypes.Walk(plan.Raw, func(p *tftypes.AttributePath, value tftypes.Value) (bool, error) {
+		if len(p.Steps()) == 0 {
+			return true, nil
+		}
+		attribute, err := plan.Schema.AttributeAtTerraformPath(ctx, p)
+		if err != nil {
+			// Not an attribute, but a block or an element of a block
+			return true, nil
+		}
+		if _, ok := attribute.GetType().(customtypes.LocalizedStringType); !ok {
+			return true, nil
+		}
+		if !value.IsKnown() || value.IsNull() {
+			return false, nil
+		}
+
+		var elements map[string]tftypes.Value
+		if err := value.As(&elements); err != nil {
+			return false, nil
+		}
+		keys := make([]string, 0, len(elements))
+		for key := range elements {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+
+		attrPath, ok := pathFromTerraform(p)
+		for _, key := range keys {
+			problem := CheckLocale(key, languages)
+			if problem == "" {
+				continue
+			}
+			if ok {
+				diags.AddAttributeError(attrPath, "Invalid locale", problem)
+			} else {
+				diags.AddError("Invalid locale", problem)
+			}
+		}
+		return false, nil
+	})
+	return diags
+}
+
+// CheckLocale returns a description of the problem with the locale, or an
+// empty string when the locale is one of the languages. The description
+// suggests the normalized BCP 47 form or the closest project language.
+func CheckLocale(locale string, languages []string) string {
+	if slices.Contains(languages, locale) {
+		return ""
+	}
+
+	tag, err := language.Parse(strings.ReplaceAll(locale, "_", "-"))
+	if err != nil {
+		return fmt.Sprintf("%q is not a valid BCP 47 language tag", locale)
+	}
+	normalized := tag.String()
+	if normalized != locale && slices.Contains(languages, normalized) {
+		return fmt.Sprintf("%q is not a valid locale, did you mean %q?", locale, normalized)
+	}
+
+	message := fmt.Sprintf("%q is not one of the languages of the project (%s)",
+		locale, strings.Join(languages, ", "))
+	if normalized != locale {
+		message += fmt.Sprintf(", the normalized BCP 47 form is %q", normalized)
+	}
+	if suggestion := closestLanguage(tag, languages); suggestion != "" {
+		message += fmt.Sprintf(", did you mean %q?", suggestion)
+	}
+	return message
+}
+
+// closestLanguage returns the project language which best matches the tag, or
+// an empty string when none of them is a reasonable match.
+func closestLanguage(tag language.Tag, languages []string) string {
+	var tags []language.Tag
+	var names []string
+	for _, l := range languages {
+		t, err := language.Parse(l)
+		if err != nil {
+			continue
+		}
+		tags = append(tags, t)
+		names = append(names, l)
+	}
+	if len(tags) == 0 {
+		return ""
+	}
+
+	_, index, confidence := language.NewMatcher(tags).Match(tag)
+	if confidence == language.No {
+		return ""
+	}
+	return names[index]
+}
+
+// pathFromTerraform converts the attribute path to a framework path. Paths
+// into sets are not supported.
+func pathFromTerraform(p *tftypes.AttributePath) (path.Path, bool) {
+	var result path.Path
+	for i, step := range p.Steps() {
+		switch s := step.(type) {
+		case tftypes.AttributeName:
+			if i == 0 {
+				result = path.Root(string(s))
+			} else {
+				result = result.AtName(string(s))
+			}
+		case tftypes.ElementKeyInt:
+			result = result.AtListIndex(int(s))
+		case tftypes.ElementKeyString:
+			result = result.AtMapKey(string(s))
+		default:
+			return path.Empty(), false
+		}
+	}
+	return result, true
+}
@@ -0,0 +1,94 @@
+package utils
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/labd/terraform-provider-commercetools/internal/customtypes"
+)
+
+func TestCheckLocale(t *testing.T) {
+	languages := []string{"en", "nl-NL", "de-DE"}
+
+	testCases := []struct {
+		locale   string
+		expected string
+	}{
+		{"en", ""},
+		{"nl-NL", ""},
+		{"nl-nl", `"nl-nl" is not a valid locale, did you mean "nl-NL"?`},
+		{"EN", `"EN" is not a valid locale, did you mean "en"?`},
+		{"en_US", `"en_US" is not one of the languages of the project (en, nl-NL, de-DE), ` +
+			`the normalized BCP 47 form is "en-US", did you mean "en"?`},
+		{"de-AT", `"de-AT" is not one of the languages of the project (en, nl-NL, de-DE), did you mean "de-DE"?`},
+		{"fr", `"fr" is not one of the languages of the project (en, nl-NL, de-DE)`},
+		{"xx", `"xx" is not a valid BCP 47 language tag`},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.locale, func(t *testing.T) {
+			assert.Equal(t, tc.expected, CheckLocale(tc.locale, languages))
+		})
+	}
+}
+
+func TestValidatePlanLocales(t *testing.T) {
+	ctx := context.Background()
+	s := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"key":  schema.StringAttribute{Optional: true},
+			"name": schema.MapAttribute{Optional: true, CustomType: customtypes.NewLocalizedStringType()},
+		},
+		Blocks: map[string]schema.Block{
+			"value": schema.ListNestedBlock{
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"label": schema.MapAttribute{Optional: true, CustomType: customtypes.NewLocalizedStringType()},
+					},
+				},
+			},
+		},
+	}
+
+	localized := func(values map[string]string) tftypes.Value {
+		elements := map[string]tftypes.Value{}
+		for k, v := range values {
+			elements[k] = tftypes.NewValue(tftypes.String, v)
+		}
+		return tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, elements)
+	}
+	valueType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		"label": tftypes.Map{ElementType: tftypes.String},
+	}}
+	raw := tftypes.NewValue(s.Type().TerraformType(ctx), map[string]tftypes.Value{
+		"key":  tftypes.NewValue(tftypes.String, "en_US"),
+		"name": localized(map[string]string{"en": "Name", "nl-nl": "Naam"}),
+		"value": tftypes.NewValue(tftypes.List{ElementType: valueType}, []tftypes.Value{
+			tftypes.NewValue(valueType, map[string]tftypes.Value{
+				"label": localized(map[string]string{"de": "Wert"}),
+			}),
+			tftypes.NewValue(valueType, map[string]tftypes.Value{
+				"label": tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, tftypes.UnknownValue),
+			}),
+		}),
+	})
+
+	diags := ValidatePlanLocales(ctx, tfsdk.Plan{Schema: s, Raw: raw}, []string{"en", "nl-NL"})
+	require.Len(t, diags, 2)
+
+	paths := map[string]string{}
+	for _, d := range diags {
+		p := d.(diag.DiagnosticWithPath).Path()
+		paths[p.String()] = d.Detail()
+	}
+	assert.Equal(t, map[string]string{
+		"name":           `"nl-nl" is not a valid locale, did you mean "nl-NL"?`,
+		"value[0].label": `"de" is not one of the languages of the project (en, nl-NL)`,
+	}, paths)
+}
@@ -0,0 +1,84 @@
+package utils
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/labd/commercetools-go-sdk/platform"
+)
+
+// RawClient sends requests to the commercetools API for fields which are not
+// supported by the SDK yet. Errors are returned as the SDK would, so they can
+// be handled with ProcessRemoteError and IsResourceNotFoundError.
+type RawClient struct {
+	httpClient *http.Client
+	baseURL    string
+	userAgent  string
+}
+
+// NewRawClient returns a client for the project. The http client is expected
+// to take care of the authentication.
+func NewRawClient(httpClient *http.Client, apiURL, projectKey, userAgent string) *RawClient {
+	return &RawClient{
+		httpClient: httpClient,
+		baseURL:    fmt.Sprintf("%s/%s", strings.TrimSuffix(apiURL, "/"), projectKey),
+		userAgent:  userAgent,
+	}
+}
+
+// Do sends the body as JSON to the path, which is relative to the project,
+// and decodes the response into result.
+func (c *RawClient) Do(ctx context.Context, method, path string, body, result any) error {
+	var reader io.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		reader = bytes.NewReader(data)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, c.baseURL+path, reader)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/json")
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if c.userAgent != "" {
+		req.Header.Set("User-Agent", c.userAgent)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	content, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case resp.StatusCode == http.StatusNotFound:
+		return platform.ErrNotFound
+	case resp.StatusCode >= 400:
+		return platform.GenericRequestError{
+			Content:    content,
+			StatusCode: resp.StatusCode,
+			Response:   resp,
+		}
+	}
+
+	if result == nil {
+		return nil
+	}
+	return json.Unmarshal(content, result)
+}
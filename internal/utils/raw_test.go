@@ -0,0 +1,48 @@
+package utils
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labd/commercetools-go-sdk/platform"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRawClient(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/my-project/subscriptions":
+			body, _ := io.ReadAll(r.Body)
+			assert.Equal(t, http.MethodPost, r.Method)
+			assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+			assert.Equal(t, "test-agent", r.Header.Get("User-Agent"))
+			assert.JSONEq(t, `{"key": "value"}`, string(body))
+			_, _ = w.Write([]byte(`{"id": "1"}`))
+		case "/my-project/subscriptions/invalid":
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"statusCode": 400, "message": "Invalid"}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	client := NewRawClient(server.Client(), server.URL+"/", "my-project", "test-agent")
+
+	var result map[string]string
+	err := client.Do(context.Background(), http.MethodPost, "/subscriptions", map[string]string{"key": "value"}, &result)
+	require.NoError(t, err)
+	assert.Equal(t, "1", result["id"])
+
+	err = client.Do(context.Background(), http.MethodGet, "/subscriptions/unknown", nil, nil)
+	assert.True(t, IsResourceNotFoundError(err))
+
+	err = client.Do(context.Background(), http.MethodGet, "/subscriptions/invalid", nil, nil)
+	var requestErr platform.GenericRequestError
+	require.ErrorAs(t, err, &requestErr)
+	assert.Equal(t, http.StatusBadRequest, requestErr.StatusCode)
+}
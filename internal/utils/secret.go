@@ -0,0 +1,27 @@
+package utils
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"regexp"
+)
+
+const secretHashPrefix = "sha256:"
+
+var secretHashRe = regexp.MustCompile(`^sha256:[0-9a-f]{64}$`)
+
+// HashSecret returns the hash of the secret which is stored in the state
+// instead of the secret itself. Empty values and values which are already
+// hashed are returned as is.
+func HashSecret(value string) string {
+	if value == "" || IsHashedSecret(value) {
+		return value
+	}
+	sum := sha256.Sum256([]byte(value))
+	return secretHashPrefix + hex.EncodeToString(sum[:])
+}
+
+// IsHashedSecret returns true if the value is a hash returned by HashSecret.
+func IsHashedSecret(value string) bool {
+	return secretHashRe.MatchString(value)
+}
@@ -0,0 +1,17 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHashSecret(t *testing.T) {
+	hash := HashSecret("secret")
+	assert.Equal(t, "sha256:2bb80d537b1da3e38bd30361aa855686bde0eacd7162fef6a25fe97bf527a25b", hash)
+	assert.True(t, IsHashedSecret(hash))
+	assert.Equal(t, hash, HashSecret(hash))
+
+	assert.Equal(t, "", HashSecret(""))
+	assert.False(t, IsHashedSecret("secret"))
+}
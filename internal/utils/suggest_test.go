@@ -0,0 +1,17 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClosestMatch(t *testing.T) {
+	candidates := []string{"lineItems", "customLineItems", "totalPrice", "country"}
+
+	assert.Equal(t, "lineItems", ClosestMatch("lineItem", candidates))
+	assert.Equal(t, "totalPrice", ClosestMatch("TotalPrice", candidates))
+	assert.Equal(t, "country", ClosestMatch("contry", candidates))
+	assert.Equal(t, "", ClosestMatch("shippingAddress", candidates))
+	assert.Equal(t, "", ClosestMatch("country", nil))
+}
@@ -0,0 +1,31 @@
+package utils
+
+import (
+	"net/url"
+	"strings"
+)
+
+// ImportApiURL derives the URL of the Import API from the URL of the HTTP
+// API. Both APIs are hosted in the same region, for example
+// https://api.europe-west1.gcp.commercetools.com is served by
+// https://import.europe-west1.gcp.commercetools.com. An empty string is
+// returned if the URL doesn't follow this convention.
+func ImportApiURL(apiURL string) string {
+	return regionalApiURL(apiURL, "import")
+}
+
+// HistoryApiURL derives the URL of the History API from the URL of the HTTP
+// API, see ImportApiURL.
+func HistoryApiURL(apiURL string) string {
+	return regionalApiURL(apiURL, "history")
+}
+
+func regionalApiURL(apiURL, service string) string {
+	u, err := url.Parse(apiURL)
+	if err != nil || !strings.HasPrefix(u.Host, "api.") {
+		return ""
+	}
+	u.Host = service + "." + strings.TrimPrefix(u.Host, "api.")
+	u.Path = ""
+	return u.String()
+}
@@ -0,0 +1,28 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestImportApiURL(t *testing.T) {
+	var cases = []struct {
+		apiURL   string
+		expected string
+	}{
+		{"https://api.europe-west1.gcp.commercetools.com", "https://import.europe-west1.gcp.commercetools.com"},
+		{"https://api.us-central1.gcp.commercetools.com/", "https://import.us-central1.gcp.commercetools.com"},
+		{"http://localhost:8989", ""},
+		{"", ""},
+	}
+
+	for _, tt := range cases {
+		assert.Equal(t, tt.expected, ImportApiURL(tt.apiURL))
+	}
+}
+
+func TestHistoryApiURL(t *testing.T) {
+	assert.Equal(t, "https://history.europe-west1.gcp.commercetools.com", HistoryApiURL("https://api.europe-west1.gcp.commercetools.com"))
+	assert.Equal(t, "", HistoryApiURL("http://localhost:8989"))
+}
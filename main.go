@@ -43,7 +43,7 @@ func main() {
 	ctx := context.Background()
 	providers := []func() tfprotov5.ProviderServer{
 		providerserver.NewProtocol5(provider.New(fullVersion)),
-		sdkProvider().GRPCProvider,
+		commercetools.NewProviderServer(sdkProvider()),
 	}
 
 	muxServer, err := tf5muxserver.NewMuxServer(ctx, providers...)